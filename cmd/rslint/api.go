@@ -210,6 +210,9 @@ func (h *IPCHandler) HandleLint(req api.LintRequest) (*api.LintResponse, error)
 				}
 			})
 		},
+		func(sourceFile *ast.SourceFile) rule.DiagnosticSeverity {
+			return rslintConfig.GetReportUnusedDisableDirectivesForFile(sourceFile.FileName())
+		},
 		diagnosticCollector,
 	)
 	if err != nil {
@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"maps"
 	"math"
 	"os"
 	"runtime"
 	"runtime/pprof"
 	"runtime/trace"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -369,12 +371,15 @@ Usage:
 Options:
   --init				Initialize a default config in the current directory.
   --config PATH         Which rslint config file to use. Defaults to rslint.json.
-  --format FORMAT       Output format: default | jsonline | github
+  --format FORMAT       Output format: default | jsonline | github | sarif | json
   --fix                 Automatically fix problems
   --no-color            Disable colored output
   --force-color         Force colored output
   --quiet               Report errors only 
   --max-warnings Int    Number of warnings to trigger nonzero exit code
+  --strict-config       Treat unknown rule names in config as errors
+  --stats               Print a summary of problems per rule
+  --timing              Print the rules that took the most time
   -h, --help            Show help
 `
 
@@ -395,6 +400,9 @@ func runCMD() int {
 		forceColor     bool
 		quiet          bool
 		maxWarnings    int
+		strictConfig   bool
+		stats          bool
+		timing         bool
 	)
 	flag.StringVar(&format, "format", "default", "output format")
 	flag.StringVar(&config, "config", "", "which rslint config to use")
@@ -406,6 +414,9 @@ func runCMD() int {
 	flag.BoolVar(&forceColor, "force-color", false, "force colored output")
 	flag.BoolVar(&quiet, "quiet", false, "report errors only")
 	flag.IntVar(&maxWarnings, "max-warnings", -1, "Number of warnings to trigger nonzero exit code")
+	flag.BoolVar(&strictConfig, "strict-config", false, "treat unknown rule names in config as errors")
+	flag.BoolVar(&stats, "stats", false, "print a summary of problems per rule")
+	flag.BoolVar(&timing, "timing", false, "print the rules that took the most time")
 
 	flag.StringVar(&traceOut, "trace", "", "file to put trace to")
 	flag.StringVar(&cpuprofOut, "cpuprof", "", "file to put cpu profiling to")
@@ -478,6 +489,19 @@ func runCMD() int {
 	// Load rslint configuration and determine which rules to enable
 	rslintConfig, tsConfigs, currentDirectory = rslintconfig.LoadConfigurationWithFallback(config, currentDirectory, fs)
 
+	// Report rules referenced in config that aren't registered (typos or removed rules)
+	if unknownRules := rslintconfig.GlobalRuleRegistry.GetUnknownRules(rslintConfig); len(unknownRules) > 0 {
+		if strictConfig {
+			fmt.Fprintf(os.Stderr, "error: unknown rules in config: %s\n", strings.Join(unknownRules, ", "))
+			return 1
+		}
+		fmt.Fprintf(os.Stderr, "warning: unknown rules in config will be ignored: %s\n", strings.Join(unknownRules, ", "))
+	}
+	for _, deprecatedRule := range rslintconfig.GlobalRuleRegistry.GetDeprecatedRules(rslintConfig) {
+		replacement, _ := rslintconfig.GlobalRuleRegistry.GetReplacement(deprecatedRule)
+		fmt.Fprintf(os.Stderr, "warning: rule %q is deprecated, use %q instead\n", deprecatedRule, replacement)
+	}
+
 	host := utils.CreateCompilerHost(currentDirectory, fs)
 
 	comparePathOptions := tspath.ComparePathsOptions{
@@ -501,6 +525,7 @@ func runCMD() int {
 	errorsCount := 0
 	warningsCount := 0
 	fixedCount := 0
+	diagnosticStats := ruleStats{}
 
 	// Store diagnostics by file for fixing
 	var diagnosticsByFile map[string][]rule.RuleDiagnostic
@@ -508,6 +533,14 @@ func runCMD() int {
 		diagnosticsByFile = make(map[string][]rule.RuleDiagnostic)
 	}
 
+	// SARIF logs and ESLint json reports are single documents, so they're written once all diagnostics are collected
+	collectDiagnostics := format == "sarif" || format == "json"
+	var collectedDiagnostics []rule.RuleDiagnostic
+
+	// ESLint json reports list every linted file, including those without diagnostics
+	var lintedFiles []string
+	var lintedFilesLock sync.Mutex
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
@@ -527,13 +560,20 @@ func runCMD() int {
 				diagnosticsByFile[fileName] = append(diagnosticsByFile[fileName], d)
 			}
 
-			if errorsCount+warningsCount == 1 {
+			if errorsCount+warningsCount == 1 && !collectDiagnostics {
 				w.WriteByte('\n')
 			}
 			// Only print Error message when quiet is true
 			if quiet && d.Severity != rule.SeverityError {
 				continue
 			}
+			if stats {
+				diagnosticStats[d.RuleName]++
+			}
+			if collectDiagnostics {
+				collectedDiagnostics = append(collectedDiagnostics, d)
+				continue
+			}
 			printDiagnostic(d, w, comparePathOptions, format)
 			if w.Available() < 4096 {
 				w.Flush()
@@ -541,6 +581,11 @@ func runCMD() int {
 		}
 	}()
 
+	var ruleTimings *linter.RuleTimings
+	if timing {
+		ruleTimings = linter.NewRuleTimings()
+	}
+
 	lintedfileCount, err := linter.RunLinter(
 		programs,
 		singleThreaded,
@@ -548,9 +593,22 @@ func runCMD() int {
 		utils.ExcludePaths,
 
 		func(sourceFile *ast.SourceFile) []linter.ConfiguredRule {
+			if format == "json" {
+				lintedFilesLock.Lock()
+				lintedFiles = append(lintedFiles, sourceFile.FileName())
+				lintedFilesLock.Unlock()
+			}
 			activeRules := rslintconfig.GlobalRuleRegistry.GetEnabledRules(rslintConfig, sourceFile.FileName())
+			if ruleTimings != nil {
+				for i, r := range activeRules {
+					activeRules[i] = ruleTimings.Wrap(r)
+				}
+			}
 			return activeRules
 		},
+		func(sourceFile *ast.SourceFile) rule.DiagnosticSeverity {
+			return rslintConfig.GetReportUnusedDisableDirectivesForFile(sourceFile.FileName())
+		},
 		func(d rule.RuleDiagnostic) {
 			diagnosticsChan <- d
 		},
@@ -564,6 +622,20 @@ func runCMD() int {
 
 	wg.Wait()
 
+	switch format {
+	case "sarif":
+		ruleNames := slices.Collect(maps.Keys(rslintconfig.GlobalRuleRegistry.GetAllRules()))
+		if err := printSarif(os.Stdout, collectedDiagnostics, ruleNames, comparePathOptions); err != nil {
+			fmt.Fprintf(os.Stderr, "error writing SARIF output: %v\n", err)
+			return 1
+		}
+	case "json":
+		if err := printESLintJSON(os.Stdout, lintedFiles, collectedDiagnostics); err != nil {
+			fmt.Fprintf(os.Stderr, "error writing JSON output: %v\n", err)
+			return 1
+		}
+	}
+
 	// Apply fixes if --fix flag is enabled
 	if fix && len(diagnosticsByFile) > 0 {
 		for fileName, fileDiagnostics := range diagnosticsByFile {
@@ -596,6 +668,19 @@ func runCMD() int {
 	}
 
 	colors := setupColors()
+	if stats {
+		// Keep machine-readable formats on stdout intact
+		statsOut := os.Stdout
+		if format != "default" {
+			statsOut = os.Stderr
+		}
+		printRuleStats(statsOut, diagnosticStats, lintedfileCount, time.Since(timeBefore), colors)
+	}
+	if timing {
+		// Like ESLint's TIMING, show the 10 slowest rules
+		printRuleTimings(os.Stderr, ruleTimings.Sorted(), 10)
+	}
+
 	var errorsColorFunc func(string, ...interface{}) string
 	if errorsCount == 0 {
 		errorsColorFunc = colors.SuccessText
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"cmp"
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// eslintResult is the report of a single file in [ESLint's json format](https://eslint.org/docs/latest/use/formatters/#json)
+type eslintResult struct {
+	FilePath            string          `json:"filePath"`
+	Messages            []eslintMessage `json:"messages"`
+	SuppressedMessages  []eslintMessage `json:"suppressedMessages"`
+	ErrorCount          int             `json:"errorCount"`
+	FatalErrorCount     int             `json:"fatalErrorCount"`
+	WarningCount        int             `json:"warningCount"`
+	FixableErrorCount   int             `json:"fixableErrorCount"`
+	FixableWarningCount int             `json:"fixableWarningCount"`
+	UsedDeprecatedRules []string        `json:"usedDeprecatedRules"`
+}
+
+type eslintMessage struct {
+	RuleID    string     `json:"ruleId"`
+	Severity  int        `json:"severity"`
+	Message   string     `json:"message"`
+	MessageID string     `json:"messageId"`
+	Line      int        `json:"line"`
+	Column    int        `json:"column"`
+	EndLine   int        `json:"endLine"`
+	EndColumn int        `json:"endColumn"`
+	Fix       *eslintFix `json:"fix,omitempty"`
+}
+
+type eslintFix struct {
+	Range [2]int `json:"range"`
+	Text  string `json:"text"`
+}
+
+// eslintSeverity maps a diagnostic severity to ESLint's numeric severity
+func eslintSeverity(severity rule.DiagnosticSeverity) int {
+	switch severity {
+	case rule.SeverityError:
+		return 2
+	case rule.SeverityWarning:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// eslintOffset converts a byte offset in text to an offset in UTF-16 code units, as JavaScript strings are indexed
+func eslintOffset(text string, pos int) int {
+	offset := 0
+	for _, r := range text[:pos] {
+		offset += utf16.RuneLen(r)
+	}
+	return offset
+}
+
+// buildESLintFix merges the fixes of a diagnostic into the single replacement ESLint reports, keeping the original
+// text between them
+func buildESLintFix(text string, fixes []rule.RuleFix) *eslintFix {
+	if len(fixes) == 0 {
+		return nil
+	}
+
+	fixes = slices.Clone(fixes)
+	slices.SortFunc(fixes, func(a, b rule.RuleFix) int {
+		return cmp.Compare(a.Range.Pos(), b.Range.Pos())
+	})
+
+	var replacement strings.Builder
+	pos := fixes[0].Range.Pos()
+	end := pos
+	for _, fix := range fixes {
+		replacement.WriteString(text[end:fix.Range.Pos()])
+		replacement.WriteString(fix.Text)
+		end = max(end, fix.Range.End())
+	}
+
+	return &eslintFix{
+		Range: [2]int{eslintOffset(text, pos), eslintOffset(text, end)},
+		Text:  replacement.String(),
+	}
+}
+
+// buildESLintResults returns one result per linted file, sorted by file path, with messages sorted by position.
+// Files without diagnostics get an empty result so consumers can tell which files were checked.
+func buildESLintResults(lintedFiles []string, diagnostics []rule.RuleDiagnostic) []eslintResult {
+	diagnostics = slices.Clone(diagnostics)
+	slices.SortFunc(diagnostics, func(a, b rule.RuleDiagnostic) int {
+		return cmp.Or(
+			cmp.Compare(a.SourceFile.FileName(), b.SourceFile.FileName()),
+			cmp.Compare(a.Range.Pos(), b.Range.Pos()),
+			cmp.Compare(a.Range.End(), b.Range.End()),
+			cmp.Compare(a.RuleName, b.RuleName),
+			cmp.Compare(a.Message.Description, b.Message.Description),
+		)
+	})
+
+	filePaths := slices.Clone(lintedFiles)
+	for _, d := range diagnostics {
+		filePaths = append(filePaths, d.SourceFile.FileName())
+	}
+	slices.Sort(filePaths)
+	filePaths = slices.Compact(filePaths)
+
+	results := make([]eslintResult, len(filePaths))
+	for i, filePath := range filePaths {
+		results[i] = eslintResult{
+			FilePath:            filePath,
+			Messages:            []eslintMessage{},
+			SuppressedMessages:  []eslintMessage{},
+			UsedDeprecatedRules: []string{},
+		}
+	}
+
+	for _, d := range diagnostics {
+		i, _ := slices.BinarySearch(filePaths, d.SourceFile.FileName())
+		result := &results[i]
+
+		startLine, startColumn := scanner.GetLineAndCharacterOfPosition(d.SourceFile, d.Range.Pos())
+		endLine, endColumn := scanner.GetLineAndCharacterOfPosition(d.SourceFile, d.Range.End())
+		fix := buildESLintFix(d.SourceFile.Text(), d.Fixes())
+
+		switch d.Severity {
+		case rule.SeverityError:
+			result.ErrorCount++
+			if fix != nil {
+				result.FixableErrorCount++
+			}
+		case rule.SeverityWarning:
+			result.WarningCount++
+			if fix != nil {
+				result.FixableWarningCount++
+			}
+		}
+
+		result.Messages = append(result.Messages, eslintMessage{
+			RuleID:    d.RuleName,
+			Severity:  eslintSeverity(d.Severity),
+			Message:   d.Message.Description,
+			MessageID: d.Message.Id,
+			Line:      startLine + 1, // Convert to 1-based indexing
+			Column:    startColumn + 1,
+			EndLine:   endLine + 1,
+			EndColumn: endColumn + 1,
+			Fix:       fix,
+		})
+	}
+	return results
+}
+
+// printESLintJSON writes diagnostics in ESLint's json format, with a result for every linted file
+func printESLintJSON(w io.Writer, lintedFiles []string, diagnostics []rule.RuleDiagnostic) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(buildESLintResults(lintedFiles, diagnostics))
+}
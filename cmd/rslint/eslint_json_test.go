@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+)
+
+func TestPrintESLintJSON(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	code := "const a = 1;\nlet b = 'ü' + a;\n"
+	fs := utils.NewOverlayVFSForFile(tspath.ResolvePath(rootDir, "file.ts"), code)
+	host := utils.CreateCompilerHost(rootDir, fs)
+	program, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.json", host)
+	assert.NilError(t, err, "couldn't create program")
+	sourceFile := program.GetSourceFile("file.ts")
+
+	// Diagnostics arrive in any order, the report sorts them by position
+	diagnostics := []rule.RuleDiagnostic{
+		{
+			RuleName: "@typescript-eslint/restrict-plus-operands",
+			Range:    core.NewTextRange(21, 29),
+			Message:  rule.RuleMessage{Id: "invalid", Description: "Operands of '+' operations must be a number or string."},
+			// Several fixes are merged into one, with offsets counted in UTF-16 code units
+			FixesPtr: &[]rule.RuleFix{
+				{Text: ")", Range: core.NewTextRange(29, 29)},
+				{Text: "String(", Range: core.NewTextRange(28, 28)},
+			},
+			SourceFile: sourceFile,
+			Severity:   rule.SeverityWarning,
+		},
+		{
+			RuleName: "prefer-const",
+			Range:    core.NewTextRange(17, 18),
+			Message:  rule.RuleMessage{Id: "useConst", Description: "'b' is never reassigned. Use 'const' instead."},
+			FixesPtr: &[]rule.RuleFix{
+				{Text: "const", Range: core.NewTextRange(13, 16)},
+			},
+			SourceFile: sourceFile,
+			Severity:   rule.SeverityError,
+		},
+		{
+			RuleName:   "no-magic-numbers",
+			Range:      core.NewTextRange(10, 11),
+			Message:    rule.RuleMessage{Id: "noMagic", Description: "No magic number: 1."},
+			SourceFile: sourceFile,
+			Severity:   rule.SeverityError,
+		},
+	}
+
+	var out bytes.Buffer
+	// Linted files without diagnostics are listed with empty results
+	lintedFiles := []string{sourceFile.FileName(), tspath.ResolvePath(rootDir, "clean.ts")}
+	err = printESLintJSON(&out, lintedFiles, diagnostics)
+	assert.NilError(t, err)
+	// File paths are absolute, like ESLint's
+	golden.Assert(t, strings.ReplaceAll(out.String(), rootDir, "<root>"), "eslint.golden.json")
+}
+
+func TestPrintESLintJSONWithoutDiagnostics(t *testing.T) {
+	var out bytes.Buffer
+	err := printESLintJSON(&out, nil, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, out.String(), "[]\n")
+}
+
+func TestPrintESLintJSONCleanFile(t *testing.T) {
+	var out bytes.Buffer
+	err := printESLintJSON(&out, []string{"/project/a.ts"}, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, out.String(), `[{"filePath":"/project/a.ts","messages":[],"suppressedMessages":[],"errorCount":0,"fatalErrorCount":0,"warningCount":0,"fixableErrorCount":0,"fixableWarningCount":0,"usedDeprecatedRules":[]}]`+"\n")
+}
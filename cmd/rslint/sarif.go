@@ -0,0 +1,174 @@
+package main
+
+import (
+	"cmp"
+	"encoding/json"
+	"io"
+	"net/url"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// sarifLog is a [SARIF 2.1.0](https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html) log, limited to
+// the properties rslint fills in
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool     `json:"tool"`
+	ColumnKind string        `json:"columnKind"`
+	Results    []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string                     `json:"name"`
+	InformationURI string                     `json:"informationUri"`
+	Rules          []sarifReportingDescriptor `json:"rules"`
+}
+
+type sarifReportingDescriptor struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine"`
+	EndColumn   int `json:"endColumn"`
+}
+
+// sarifLevel maps a diagnostic severity to the level of a SARIF result
+func sarifLevel(severity rule.DiagnosticSeverity) string {
+	switch severity {
+	case rule.SeverityError:
+		return "error"
+	case rule.SeverityWarning:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// sarifPosition converts a position to the 1-based line and column of a SARIF region
+func sarifPosition(lineStarts []core.TextPos, text string, pos int) (int, int) {
+	line := scanner.ComputeLineOfPosition(lineStarts, pos)
+	column := utf8.RuneCountInString(text[lineStarts[line]:pos])
+	return line + 1, column + 1
+}
+
+// buildSarifLog creates a SARIF log with a single run listing ruleNames, along with the rules of any diagnostic
+// not among them, and a result for each diagnostic sorted by file and position
+func buildSarifLog(diagnostics []rule.RuleDiagnostic, ruleNames []string, comparePathOptions tspath.ComparePathsOptions) sarifLog {
+	ruleNames = slices.Clone(ruleNames)
+	for _, d := range diagnostics {
+		ruleNames = append(ruleNames, d.RuleName)
+	}
+	slices.Sort(ruleNames)
+	ruleNames = slices.Compact(ruleNames)
+
+	rules := make([]sarifReportingDescriptor, len(ruleNames))
+	for i, ruleName := range ruleNames {
+		rules[i] = sarifReportingDescriptor{ID: ruleName}
+	}
+
+	diagnostics = slices.Clone(diagnostics)
+	slices.SortFunc(diagnostics, func(a, b rule.RuleDiagnostic) int {
+		return cmp.Or(
+			cmp.Compare(a.SourceFile.FileName(), b.SourceFile.FileName()),
+			cmp.Compare(a.Range.Pos(), b.Range.Pos()),
+			cmp.Compare(a.Range.End(), b.Range.End()),
+			cmp.Compare(a.RuleName, b.RuleName),
+			cmp.Compare(a.Message.Description, b.Message.Description),
+		)
+	})
+
+	results := make([]sarifResult, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		lineStarts := scanner.GetLineStarts(d.SourceFile)
+		startLine, startColumn := sarifPosition(lineStarts, d.SourceFile.Text(), d.Range.Pos())
+		endLine, endColumn := sarifPosition(lineStarts, d.SourceFile.Text(), d.Range.End())
+		ruleIndex, _ := slices.BinarySearch(ruleNames, d.RuleName)
+
+		// Relative paths are resolved against the repository root by code scanning
+		filePath := tspath.ConvertToRelativePath(d.SourceFile.FileName(), comparePathOptions)
+		results = append(results, sarifResult{
+			RuleID:    d.RuleName,
+			RuleIndex: ruleIndex,
+			Level:     sarifLevel(d.Severity),
+			Message:   sarifMessage{Text: d.Message.Description},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: (&url.URL{Path: filePath}).String()},
+					Region: sarifRegion{
+						StartLine:   startLine,
+						StartColumn: startColumn,
+						EndLine:     endLine,
+						EndColumn:   endColumn,
+					},
+				},
+			}},
+		})
+	}
+
+	return sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{
+				Driver: sarifDriver{
+					Name:           "rslint",
+					InformationURI: "https://rslint.rs",
+					Rules:          rules,
+				},
+			},
+			// Columns count characters, like the other output formats
+			ColumnKind: "unicodeCodePoints",
+			Results:    results,
+		}},
+	}
+}
+
+// printSarif writes diagnostics as an indented SARIF log
+func printSarif(w io.Writer, diagnostics []rule.RuleDiagnostic, ruleNames []string, comparePathOptions tspath.ComparePathsOptions) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(buildSarifLog(diagnostics, ruleNames, comparePathOptions))
+}
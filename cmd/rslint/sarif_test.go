@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+)
+
+func TestPrintSarif(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+	code := "const a = 1;\nlet b = 'ü' + a;\n"
+	fs := utils.NewOverlayVFSForFile(tspath.ResolvePath(rootDir, "file.ts"), code)
+	host := utils.CreateCompilerHost(rootDir, fs)
+	program, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.json", host)
+	assert.NilError(t, err, "couldn't create program")
+	sourceFile := program.GetSourceFile("file.ts")
+
+	// Diagnostics arrive in any order, the log sorts them by position
+	diagnostics := []rule.RuleDiagnostic{
+		{
+			RuleName:   "@typescript-eslint/restrict-plus-operands",
+			Range:      core.NewTextRange(21, 29),
+			Message:    rule.RuleMessage{Id: "invalid", Description: "Operands of '+' operations must be a number or string."},
+			SourceFile: sourceFile,
+			Severity:   rule.SeverityWarning,
+		},
+		{
+			RuleName:   "prefer-const",
+			Range:      core.NewTextRange(17, 18),
+			Message:    rule.RuleMessage{Id: "useConst", Description: "'b' is never reassigned. Use 'const' instead."},
+			SourceFile: sourceFile,
+			Severity:   rule.SeverityError,
+		},
+		{
+			RuleName:   "no-magic-numbers",
+			Range:      core.NewTextRange(10, 11),
+			Message:    rule.RuleMessage{Id: "noMagic", Description: "No magic number: 1."},
+			SourceFile: sourceFile,
+			Severity:   rule.SeverityError,
+		},
+	}
+	comparePathOptions := tspath.ComparePathsOptions{
+		CurrentDirectory:          rootDir,
+		UseCaseSensitiveFileNames: true,
+	}
+
+	var out bytes.Buffer
+	err = printSarif(&out, diagnostics, []string{"prefer-const", "no-magic-numbers", "no-console"}, comparePathOptions)
+	assert.NilError(t, err)
+	golden.Assert(t, out.String(), "sarif.golden.json")
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/web-infra-dev/rslint/internal/linter"
+)
+
+// ruleStats counts reported diagnostics per rule
+type ruleStats map[string]int
+
+type ruleCount struct {
+	ruleName string
+	count    int
+}
+
+// sorted returns the rule counts with the most frequent rules first
+func (s ruleStats) sorted() []ruleCount {
+	counts := make([]ruleCount, 0, len(s))
+	for ruleName, count := range s {
+		counts = append(counts, ruleCount{ruleName, count})
+	}
+	slices.SortFunc(counts, func(a, b ruleCount) int {
+		if c := cmp.Compare(b.count, a.count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ruleName, b.ruleName)
+	})
+	return counts
+}
+
+// printRuleStats prints a table of rule name → occurrence count
+func printRuleStats(w io.Writer, stats ruleStats, lintedFileCount int32, duration time.Duration, colors *ColorScheme) {
+	counts := stats.sorted()
+
+	total := 0
+	ruleWidth := len("Rule")
+	countWidth := len("Count")
+	for _, c := range counts {
+		total += c.count
+		ruleWidth = max(ruleWidth, len(c.ruleName))
+		countWidth = max(countWidth, len(strconv.Itoa(c.count)))
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "%s  %s\n", colors.BoldText("%-*s", ruleWidth, "Rule"), colors.BoldText("%*s", countWidth, "Count"))
+	for _, c := range counts {
+		fmt.Fprintf(w, "%s  %*d\n", colors.RuleName("%-*s", ruleWidth, c.ruleName), countWidth, c.count)
+	}
+
+	problemsText := "problems"
+	if total == 1 {
+		problemsText = "problem"
+	}
+	filesText := "files"
+	if lintedFileCount == 1 {
+		filesText = "file"
+	}
+	fmt.Fprintf(w, "%s\n", colors.DimText("%d %s in %d rules across %d %s (%v)", total, problemsText, len(counts), lintedFileCount, filesText, duration.Round(time.Millisecond)))
+	fmt.Fprintln(w)
+}
+
+// printRuleTimings prints the slowest rules in the same layout as ESLint's TIMING output
+func printRuleTimings(w io.Writer, timings []linter.RuleTiming, limit int) {
+	var total time.Duration
+	for _, t := range timings {
+		total += t.Duration
+	}
+	if limit > 0 && len(timings) > limit {
+		timings = timings[:limit]
+	}
+
+	ruleWidth := len("Rule")
+	for _, t := range timings {
+		ruleWidth = max(ruleWidth, len(t.RuleName))
+	}
+	timeHeader := "Time (ms)"
+	relativeHeader := "Relative"
+
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "%-*s | %s | %s\n", ruleWidth, "Rule", timeHeader, relativeHeader)
+	fmt.Fprintf(w, ":%s|%s:|%s:\n", strings.Repeat("-", ruleWidth), strings.Repeat("-", len(timeHeader)+1), strings.Repeat("-", len(relativeHeader)+1))
+	for _, t := range timings {
+		relative := 0.0
+		if total > 0 {
+			relative = float64(t.Duration) * 100 / float64(total)
+		}
+		ms := float64(t.Duration) / float64(time.Millisecond)
+		fmt.Fprintf(w, "%-*s | %*.3f | %*s\n", ruleWidth, t.RuleName, len(timeHeader), ms, len(relativeHeader), fmt.Sprintf("%.1f%%", relative))
+	}
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/web-infra-dev/rslint/internal/linter"
+	"gotest.tools/v3/assert"
+)
+
+// plainColors formats text without any color, for comparing output
+var plainColors = &ColorScheme{
+	RuleName:    fmt.Sprintf,
+	FileName:    fmt.Sprintf,
+	ErrorText:   fmt.Sprintf,
+	SuccessText: fmt.Sprintf,
+	DimText:     fmt.Sprintf,
+	BoldText:    fmt.Sprintf,
+	BorderText:  fmt.Sprintf,
+	WarnText:    fmt.Sprintf,
+}
+
+func TestRuleStatsSorted(t *testing.T) {
+	stats := ruleStats{
+		"no-debugger": 2,
+		"no-console":  5,
+		"eqeqeq":      2,
+		"radix":       1,
+	}
+
+	// The most frequent rules come first, rules with the same count by name
+	var sorted []string
+	for _, c := range stats.sorted() {
+		sorted = append(sorted, fmt.Sprintf("%s:%d", c.ruleName, c.count))
+	}
+	assert.DeepEqual(t, sorted, []string{"no-console:5", "eqeqeq:2", "no-debugger:2", "radix:1"})
+}
+
+func TestPrintRuleStats(t *testing.T) {
+	stats := ruleStats{
+		"no-debugger": 2,
+		"no-console":  5,
+		"eqeqeq":      2,
+	}
+
+	var out bytes.Buffer
+	printRuleStats(&out, stats, 3, 1234567*time.Microsecond, plainColors)
+
+	expected := "\n" +
+		"Rule         Count\n" +
+		"no-console       5\n" +
+		"eqeqeq           2\n" +
+		"no-debugger      2\n" +
+		"9 problems in 3 rules across 3 files (1.235s)\n" +
+		"\n"
+	assert.Equal(t, out.String(), expected)
+}
+
+func TestPrintRuleStatsSingular(t *testing.T) {
+	var out bytes.Buffer
+	printRuleStats(&out, ruleStats{"eqeqeq": 1}, 1, 0, plainColors)
+
+	expected := "\n" +
+		"Rule    Count\n" +
+		"eqeqeq      1\n" +
+		"1 problem in 1 rules across 1 file (0s)\n" +
+		"\n"
+	assert.Equal(t, out.String(), expected)
+}
+
+func TestPrintRuleTimings(t *testing.T) {
+	timings := []linter.RuleTiming{
+		{RuleName: "no-console", Duration: 6 * time.Millisecond},
+		{RuleName: "eqeqeq", Duration: 3 * time.Millisecond},
+		{RuleName: "radix", Duration: time.Millisecond},
+	}
+
+	// Only the slowest rules are listed, relative to the time of all rules
+	var out bytes.Buffer
+	printRuleTimings(&out, timings, 2)
+
+	expected := "\n" +
+		"Rule       | Time (ms) | Relative\n" +
+		":----------|----------:|---------:\n" +
+		"no-console |     6.000 |    60.0%\n" +
+		"eqeqeq     |     3.000 |    30.0%\n" +
+		"\n"
+	assert.Equal(t, out.String(), expected)
+}
+
+func TestPrintRuleTimingsWithoutLimit(t *testing.T) {
+	timings := []linter.RuleTiming{
+		{RuleName: "eqeqeq", Duration: 3 * time.Millisecond},
+		{RuleName: "radix", Duration: time.Millisecond},
+	}
+
+	var out bytes.Buffer
+	printRuleTimings(&out, timings, 0)
+
+	expected := "\n" +
+		"Rule   | Time (ms) | Relative\n" +
+		":------|----------:|---------:\n" +
+		"eqeqeq |     3.000 |    75.0%\n" +
+		"radix  |     1.000 |    25.0%\n" +
+		"\n"
+	assert.Equal(t, out.String(), expected)
+}
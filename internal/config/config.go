@@ -27,6 +27,8 @@ import (
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/consistent_type_imports"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/default_param_last"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/explicit_function_return_type"
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/max_params"
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_array_constructor"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_array_delete"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_base_to_string"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_confusing_void_expression"
@@ -34,6 +36,7 @@ import (
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_duplicate_type_constituents"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_empty_function"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_empty_interface"
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_empty_object_type"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_explicit_any"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_extra_non_null_assertion"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_extraneous_class"
@@ -41,12 +44,14 @@ import (
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_floating_promises"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_for_in_array"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_implied_eval"
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_invalid_this"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_meaningless_void_operator"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_misused_promises"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_misused_spread"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_mixed_enums"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_namespace"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_non_null_asserted_optional_chain"
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_non_null_assertion"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_redundant_type_constituents"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_this_alias"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_require_imports"
@@ -59,6 +64,7 @@ import (
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_unsafe_assignment"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_unsafe_call"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_unsafe_enum_comparison"
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_unsafe_function_type"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_unsafe_member_access"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_unsafe_return"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_unsafe_type_assertion"
@@ -66,9 +72,13 @@ import (
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_unused_vars"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_useless_empty_export"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_var_requires"
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/no_wrapper_object_types"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/non_nullable_type_assertion_style"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/only_throw_error"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/prefer_as_const"
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/prefer_at"
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/prefer_nullish_coalescing"
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/prefer_optional_chain"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/prefer_promise_reject_errors"
 	// "github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/prefer_readonly_parameter_types" // Temporarily disabled - incomplete implementation
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/prefer_reduce_type_parameter"
@@ -86,11 +96,18 @@ import (
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/unified_signatures"
 	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/use_unknown_in_catch_callback_variable"
 	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/rules/accessor_pairs"
 	"github.com/web-infra-dev/rslint/internal/rules/array_callback_return"
+	"github.com/web-infra-dev/rslint/internal/rules/capitalized_comments"
+	"github.com/web-infra-dev/rslint/internal/rules/consistent_this"
 	"github.com/web-infra-dev/rslint/internal/rules/constructor_super"
+	"github.com/web-infra-dev/rslint/internal/rules/dot_location"
 	"github.com/web-infra-dev/rslint/internal/rules/dot_notation"
+	"github.com/web-infra-dev/rslint/internal/rules/eqeqeq"
 	"github.com/web-infra-dev/rslint/internal/rules/for_direction"
 	"github.com/web-infra-dev/rslint/internal/rules/getter_return"
+	"github.com/web-infra-dev/rslint/internal/rules/grouped_accessor_pairs"
+	"github.com/web-infra-dev/rslint/internal/rules/max_lines_per_function"
 	"github.com/web-infra-dev/rslint/internal/rules/no_async_promise_executor"
 	"github.com/web-infra-dev/rslint/internal/rules/no_await_in_loop"
 	"github.com/web-infra-dev/rslint/internal/rules/no_class_assign"
@@ -100,6 +117,51 @@ import (
 	"github.com/web-infra-dev/rslint/internal/rules/no_constant_binary_expression"
 	"github.com/web-infra-dev/rslint/internal/rules/no_constant_condition"
 	"github.com/web-infra-dev/rslint/internal/rules/no_constructor_return"
+	"github.com/web-infra-dev/rslint/internal/rules/no_dupe_else_if"
+	"github.com/web-infra-dev/rslint/internal/rules/no_ex_assign"
+	"github.com/web-infra-dev/rslint/internal/rules/no_extra_bind"
+	"github.com/web-infra-dev/rslint/internal/rules/no_extra_boolean_cast"
+	"github.com/web-infra-dev/rslint/internal/rules/no_func_assign"
+	"github.com/web-infra-dev/rslint/internal/rules/no_implicit_coercion"
+	"github.com/web-infra-dev/rslint/internal/rules/no_import_assign"
+	"github.com/web-infra-dev/rslint/internal/rules/no_lonely_if"
+	"github.com/web-infra-dev/rslint/internal/rules/no_mixed_operators"
+	"github.com/web-infra-dev/rslint/internal/rules/no_nested_ternary"
+	"github.com/web-infra-dev/rslint/internal/rules/no_obj_calls"
+	"github.com/web-infra-dev/rslint/internal/rules/no_object_constructor"
+	"github.com/web-infra-dev/rslint/internal/rules/no_prototype_builtins"
+	"github.com/web-infra-dev/rslint/internal/rules/no_restricted_properties"
+	"github.com/web-infra-dev/rslint/internal/rules/no_return_assign"
+	"github.com/web-infra-dev/rslint/internal/rules/no_return_await"
+	"github.com/web-infra-dev/rslint/internal/rules/no_self_assign"
+	"github.com/web-infra-dev/rslint/internal/rules/no_sequences"
+	"github.com/web-infra-dev/rslint/internal/rules/no_setter_return"
+	"github.com/web-infra-dev/rslint/internal/rules/no_throw_literal"
+	"github.com/web-infra-dev/rslint/internal/rules/no_unexpected_multiline"
+	"github.com/web-infra-dev/rslint/internal/rules/no_unmodified_loop_condition"
+	"github.com/web-infra-dev/rslint/internal/rules/no_unneeded_ternary"
+	"github.com/web-infra-dev/rslint/internal/rules/no_unsafe_negation"
+	"github.com/web-infra-dev/rslint/internal/rules/no_useless_assignment"
+	"github.com/web-infra-dev/rslint/internal/rules/no_useless_backreference"
+	"github.com/web-infra-dev/rslint/internal/rules/no_useless_call"
+	"github.com/web-infra-dev/rslint/internal/rules/no_useless_catch"
+	"github.com/web-infra-dev/rslint/internal/rules/no_useless_computed_key"
+	"github.com/web-infra-dev/rslint/internal/rules/no_useless_rename"
+	"github.com/web-infra-dev/rslint/internal/rules/no_useless_return"
+	"github.com/web-infra-dev/rslint/internal/rules/no_warning_comments"
+	"github.com/web-infra-dev/rslint/internal/rules/no_whitespace_before_property"
+	"github.com/web-infra-dev/rslint/internal/rules/operator_assignment"
+	"github.com/web-infra-dev/rslint/internal/rules/prefer_destructuring"
+	"github.com/web-infra-dev/rslint/internal/rules/prefer_exponentiation_operator"
+	"github.com/web-infra-dev/rslint/internal/rules/prefer_named_capture_group"
+	"github.com/web-infra-dev/rslint/internal/rules/prefer_numeric_literals"
+	"github.com/web-infra-dev/rslint/internal/rules/prefer_object_spread"
+	"github.com/web-infra-dev/rslint/internal/rules/prefer_rest_params"
+	"github.com/web-infra-dev/rslint/internal/rules/radix"
+	"github.com/web-infra-dev/rslint/internal/rules/require_unicode_regexp"
+	"github.com/web-infra-dev/rslint/internal/rules/symbol_description"
+	"github.com/web-infra-dev/rslint/internal/rules/valid_typeof"
+	"github.com/web-infra-dev/rslint/internal/rules/yoda"
 )
 
 // RslintConfig represents the top-level configuration array
@@ -111,8 +173,10 @@ type ConfigEntry struct {
 	Files           []string         `json:"files"`
 	Ignores         []string         `json:"ignores,omitempty"` // List of file patterns to ignore
 	LanguageOptions *LanguageOptions `json:"languageOptions,omitempty"`
+	LinterOptions   *LinterOptions   `json:"linterOptions,omitempty"`
 	Rules           Rules            `json:"rules"`
 	Plugins         []string         `json:"plugins,omitempty"` // List of plugin names
+	Extends         []string         `json:"extends,omitempty"` // Paths of configs this entry extends, relative to the config file
 }
 
 // LanguageOptions contains language-specific configuration options
@@ -120,6 +184,13 @@ type LanguageOptions struct {
 	ParserOptions *ParserOptions `json:"parserOptions,omitempty"`
 }
 
+// LinterOptions contains options of the linting process itself
+type LinterOptions struct {
+	// ReportUnusedDisableDirectives is the level eslint-disable directives that suppress nothing are reported at:
+	// "off", "warn", "error", their numeric forms, or a boolean where true means "warn"
+	ReportUnusedDisableDirectives interface{} `json:"reportUnusedDisableDirectives,omitempty"`
+}
+
 // ProjectPaths represents project paths that can be either a single string or an array of strings
 type ProjectPaths []string
 
@@ -267,11 +338,52 @@ func GetAllRulesForPlugin(plugin string) []rule.Rule {
 	}
 }
 
+// GetDefaultRuleLevelsForPlugin returns the level each rule of a plugin is enabled at when the plugin is listed in a config entry
+func GetDefaultRuleLevelsForPlugin(plugin string) map[string]string {
+	switch plugin {
+	case "eslint-plugin-import/recommended":
+		return importPlugin.GetRecommendedRuleLevels()
+	default:
+		levels := make(map[string]string)
+		for _, rule := range GetAllRulesForPlugin(plugin) {
+			levels[rule.Name] = "error"
+		}
+		return levels
+	}
+}
+
+// parseRuleLevel normalizes a severity level, mapping ESLint's numeric levels 0, 1 and 2 to "off", "warn" and "error"
+func parseRuleLevel(value interface{}) (string, bool) {
+	var level float64
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		// JSON numbers unmarshal as float64
+		level = v
+	case int:
+		level = float64(v)
+	default:
+		return "", false
+	}
+
+	switch level {
+	case 0:
+		return "off", true
+	case 1:
+		return "warn", true
+	case 2:
+		return "error", true
+	default:
+		return "", false
+	}
+}
+
 // parseArrayRuleConfig parses array-style rule configuration like ["error", {...options}]
 // Supports ESLint-compatible formats:
-// - ["off"] -> disabled rule
-// - ["error"] -> enabled rule with error severity
-// - ["warn"] -> enabled rule with warning severity
+// - ["off"] or [0] -> disabled rule
+// - ["error"] or [2] -> enabled rule with error severity
+// - ["warn"] or [1] -> enabled rule with warning severity
 // - ["error", {...options}] -> enabled rule with error severity and options
 // - ["warn", {...options}] -> enabled rule with warning severity and options
 func parseArrayRuleConfig(ruleArray []interface{}) *RuleConfig {
@@ -280,7 +392,7 @@ func parseArrayRuleConfig(ruleArray []interface{}) *RuleConfig {
 	}
 
 	// First element should always be the severity level
-	level, ok := ruleArray[0].(string)
+	level, ok := parseRuleLevel(ruleArray[0])
 	if !ok {
 		return nil
 	}
@@ -316,28 +428,27 @@ func (config RslintConfig) GetRulesForFile(filePath string) map[string]*RuleConf
 		}
 
 		// Check if the file matches the files pattern
-		matches := true
+		if isFileMatched(filePath, entry.Files) {
 
-		if matches {
-
-			/// Merge rules from plugin
+			/// Merge rules from plugin, which explicit rules of this entry override below
 			for _, plugin := range entry.Plugins {
-
-				for _, rule := range GetAllRulesForPlugin(plugin) {
-					enabledRules[rule.Name] = &RuleConfig{Level: "error"} // Default level for plugin rules
+				for ruleName, level := range GetDefaultRuleLevelsForPlugin(plugin) {
+					enabledRules[ruleName] = &RuleConfig{Level: level}
 				}
 			}
 			// Merge rules from this entry
 			for ruleName, ruleValue := range entry.Rules {
 
 				switch v := ruleValue.(type) {
-				case string:
-					// Handle simple string values like "error", "warn", "off"
-					enabledRules[ruleName] = &RuleConfig{Level: v}
+				case string, float64, int:
+					// Handle simple values like "error", "warn", "off" or their numeric forms 2, 1, 0
+					if level, ok := parseRuleLevel(v); ok {
+						enabledRules[ruleName] = &RuleConfig{Level: level}
+					}
 				case map[string]interface{}:
 					// Handle object configuration
 					ruleConfig := &RuleConfig{}
-					if level, ok := v["level"].(string); ok {
+					if level, ok := parseRuleLevel(v["level"]); ok {
 						ruleConfig.Level = level
 					}
 					if options, ok := v["options"].(map[string]interface{}); ok {
@@ -347,7 +458,7 @@ func (config RslintConfig) GetRulesForFile(filePath string) map[string]*RuleConf
 						enabledRules[ruleName] = ruleConfig
 					}
 				case []interface{}:
-					// Handle array format like ["error", {...options}] or ["warn"] or ["off"]
+					// Handle array format like ["error", {...options}], [1, {...options}] or ["off"]
 					ruleConfig := parseArrayRuleConfig(v)
 					if ruleConfig != nil && ruleConfig.IsEnabled() {
 						enabledRules[ruleName] = ruleConfig
@@ -359,6 +470,34 @@ func (config RslintConfig) GetRulesForFile(filePath string) map[string]*RuleConf
 	return enabledRules
 }
 
+// GetReportUnusedDisableDirectivesForFile returns the severity unused eslint-disable directives are reported
+// with in a given file, later config entries override earlier ones
+func (config RslintConfig) GetReportUnusedDisableDirectivesForFile(filePath string) rule.DiagnosticSeverity {
+	severity := rule.SeverityOff
+
+	for _, entry := range config {
+		if entry.LinterOptions == nil || entry.LinterOptions.ReportUnusedDisableDirectives == nil {
+			continue
+		}
+		if isFileIgnored(filePath, entry.Ignores) || !isFileMatched(filePath, entry.Files) {
+			continue
+		}
+
+		switch v := entry.LinterOptions.ReportUnusedDisableDirectives.(type) {
+		case bool:
+			severity = rule.SeverityOff
+			if v {
+				severity = rule.SeverityWarning
+			}
+		default:
+			if level, ok := parseRuleLevel(v); ok {
+				severity = rule.ParseSeverity(level)
+			}
+		}
+	}
+	return severity
+}
+
 func RegisterAllRules() {
 	registerAllTypeScriptEslintPluginRules()
 	registerAllEslintImportPluginRules()
@@ -385,16 +524,20 @@ func registerAllTypeScriptEslintPluginRules() {
 	GlobalRuleRegistry.Register("@typescript-eslint/default-param-last", default_param_last.DefaultParamLastRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/dot-notation", dot_notation.DotNotationRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/explicit-function-return-type", explicit_function_return_type.ExplicitFunctionReturnTypeRule)
+	GlobalRuleRegistry.Register("@typescript-eslint/max-params", max_params.MaxParamsRule)
+	GlobalRuleRegistry.Register("@typescript-eslint/no-array-constructor", no_array_constructor.NoArrayConstructorRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-array-delete", no_array_delete.NoArrayDeleteRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-base-to-string", no_base_to_string.NoBaseToStringRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-confusing-void-expression", no_confusing_void_expression.NoConfusingVoidExpressionRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-duplicate-enum-values", no_duplicate_enum_values.NoDuplicateEnumValuesRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-duplicate-type-constituents", no_duplicate_type_constituents.NoDuplicateTypeConstituentsRule)
+	GlobalRuleRegistry.Register("@typescript-eslint/no-empty-object-type", no_empty_object_type.NoEmptyObjectTypeRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-explicit-any", no_explicit_any.NoExplicitAnyRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-extra-non-null-assertion", no_extra_non_null_assertion.NoExtraNonNullAssertionRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-empty-function", no_empty_function.NoEmptyFunctionRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-empty-interface", no_empty_interface.NoEmptyInterfaceRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-extraneous-class", no_extraneous_class.NoExtraneousClassRule)
+	GlobalRuleRegistry.Register("@typescript-eslint/no-invalid-this", no_invalid_this.NoInvalidThisRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-invalid-void-type", no_invalid_void_type.NoInvalidVoidTypeRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-floating-promises", no_floating_promises.NoFloatingPromisesRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-for-in-array", no_for_in_array.NoForInArrayRule)
@@ -405,6 +548,7 @@ func registerAllTypeScriptEslintPluginRules() {
 	GlobalRuleRegistry.Register("@typescript-eslint/no-mixed-enums", no_mixed_enums.NoMixedEnumsRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-namespace", no_namespace.NoNamespaceRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-non-null-asserted-optional-chain", no_non_null_asserted_optional_chain.NoNonNullAssertedOptionalChainRule)
+	GlobalRuleRegistry.Register("@typescript-eslint/no-non-null-assertion", no_non_null_assertion.NoNonNullAssertionRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-redundant-type-constituents", no_redundant_type_constituents.NoRedundantTypeConstituentsRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-this-alias", no_this_alias.NoThisAliasRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-require-imports", no_require_imports.NoRequireImportsRule)
@@ -417,6 +561,7 @@ func registerAllTypeScriptEslintPluginRules() {
 	GlobalRuleRegistry.Register("@typescript-eslint/no-unsafe-assignment", no_unsafe_assignment.NoUnsafeAssignmentRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-unsafe-call", no_unsafe_call.NoUnsafeCallRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-unsafe-enum-comparison", no_unsafe_enum_comparison.NoUnsafeEnumComparisonRule)
+	GlobalRuleRegistry.Register("@typescript-eslint/no-unsafe-function-type", no_unsafe_function_type.NoUnsafeFunctionTypeRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-unsafe-member-access", no_unsafe_member_access.NoUnsafeMemberAccessRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-unsafe-return", no_unsafe_return.NoUnsafeReturnRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-unsafe-type-assertion", no_unsafe_type_assertion.NoUnsafeTypeAssertionRule)
@@ -424,9 +569,13 @@ func registerAllTypeScriptEslintPluginRules() {
 	GlobalRuleRegistry.Register("@typescript-eslint/no-unused-vars", no_unused_vars.NoUnusedVarsRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-useless-empty-export", no_useless_empty_export.NoUselessEmptyExportRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/no-var-requires", no_var_requires.NoVarRequiresRule)
+	GlobalRuleRegistry.Register("@typescript-eslint/no-wrapper-object-types", no_wrapper_object_types.NoWrapperObjectTypesRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/non-nullable-type-assertion-style", non_nullable_type_assertion_style.NonNullableTypeAssertionStyleRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/only-throw-error", only_throw_error.OnlyThrowErrorRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/prefer-as-const", prefer_as_const.PreferAsConstRule)
+	GlobalRuleRegistry.Register("@typescript-eslint/prefer-at", prefer_at.PreferAtRule)
+	GlobalRuleRegistry.Register("@typescript-eslint/prefer-nullish-coalescing", prefer_nullish_coalescing.PreferNullishCoalescingRule)
+	GlobalRuleRegistry.Register("@typescript-eslint/prefer-optional-chain", prefer_optional_chain.PreferOptionalChainRule)
 	GlobalRuleRegistry.Register("@typescript-eslint/prefer-promise-reject-errors", prefer_promise_reject_errors.PreferPromiseRejectErrorsRule)
 	// TODO: prefer-readonly-parameter-types needs complete implementation for proper type checking
 	// Temporarily disabled until the isReadonlyType function is fully implemented with proper
@@ -469,6 +618,60 @@ func registerAllCoreEslintRules() {
 	GlobalRuleRegistry.Register("no-constant-binary-expression", no_constant_binary_expression.NoConstantBinaryExpressionRule)
 	GlobalRuleRegistry.Register("no-constant-condition", no_constant_condition.NoConstantConditionRule)
 	GlobalRuleRegistry.Register("no-constructor-return", no_constructor_return.NoConstructorReturnRule)
+	GlobalRuleRegistry.Register("no-extra-boolean-cast", no_extra_boolean_cast.NoExtraBooleanCastRule)
+	GlobalRuleRegistry.Register("no-sequences", no_sequences.NoSequencesRule)
+	GlobalRuleRegistry.Register("yoda", yoda.YodaRule)
+	GlobalRuleRegistry.Register("prefer-object-spread", prefer_object_spread.PreferObjectSpreadRule)
+	GlobalRuleRegistry.Register("no-useless-rename", no_useless_rename.NoUselessRenameRule)
+	GlobalRuleRegistry.Register("no-lonely-if", no_lonely_if.NoLonelyIfRule)
+	GlobalRuleRegistry.Register("operator-assignment", operator_assignment.OperatorAssignmentRule)
+	GlobalRuleRegistry.Register("prefer-exponentiation-operator", prefer_exponentiation_operator.PreferExponentiationOperatorRule)
+	GlobalRuleRegistry.Register("no-useless-call", no_useless_call.NoUselessCallRule)
+	GlobalRuleRegistry.Register("no-extra-bind", no_extra_bind.NoExtraBindRule)
+	GlobalRuleRegistry.Register("no-implicit-coercion", no_implicit_coercion.NoImplicitCoercionRule)
+	GlobalRuleRegistry.Register("no-obj-calls", no_obj_calls.NoObjCallsRule)
+	GlobalRuleRegistry.Register("valid-typeof", valid_typeof.ValidTypeofRule)
+	GlobalRuleRegistry.Register("prefer-rest-params", prefer_rest_params.PreferRestParamsRule)
+	GlobalRuleRegistry.Register("no-return-assign", no_return_assign.NoReturnAssignRule)
+	GlobalRuleRegistry.Register("no-self-assign", no_self_assign.NoSelfAssignRule)
+	GlobalRuleRegistry.Register("no-unmodified-loop-condition", no_unmodified_loop_condition.NoUnmodifiedLoopConditionRule)
+	GlobalRuleRegistry.Register("capitalized-comments", capitalized_comments.CapitalizedCommentsRule)
+	GlobalRuleRegistry.Register("no-warning-comments", no_warning_comments.NoWarningCommentsRule)
+	GlobalRuleRegistry.Register("max-lines-per-function", max_lines_per_function.MaxLinesPerFunctionRule)
+	GlobalRuleRegistry.Register("prefer-destructuring", prefer_destructuring.PreferDestructuringRule)
+	GlobalRuleRegistry.Register("no-useless-backreference", no_useless_backreference.NoUselessBackreferenceRule)
+	GlobalRuleRegistry.Register("prefer-named-capture-group", prefer_named_capture_group.PreferNamedCaptureGroupRule)
+	GlobalRuleRegistry.Register("require-unicode-regexp", require_unicode_regexp.RequireUnicodeRegexpRule)
+	GlobalRuleRegistry.Register("consistent-this", consistent_this.ConsistentThisRule)
+	GlobalRuleRegistry.Register("no-useless-computed-key", no_useless_computed_key.NoUselessComputedKeyRule)
+	GlobalRuleRegistry.Register("no-restricted-properties", no_restricted_properties.NoRestrictedPropertiesRule)
+	GlobalRuleRegistry.Register("prefer-numeric-literals", prefer_numeric_literals.PreferNumericLiteralsRule)
+	GlobalRuleRegistry.Register("radix", radix.RadixRule)
+	GlobalRuleRegistry.Register("no-useless-catch", no_useless_catch.NoUselessCatchRule)
+	GlobalRuleRegistry.Register("no-ex-assign", no_ex_assign.NoExAssignRule)
+	GlobalRuleRegistry.Register("no-unsafe-negation", no_unsafe_negation.NoUnsafeNegationRule)
+	GlobalRuleRegistry.Register("no-prototype-builtins", no_prototype_builtins.NoPrototypeBuiltinsRule)
+	GlobalRuleRegistry.Register("no-setter-return", no_setter_return.NoSetterReturnRule)
+	GlobalRuleRegistry.Register("no-import-assign", no_import_assign.NoImportAssignRule)
+	GlobalRuleRegistry.Register("no-func-assign", no_func_assign.NoFuncAssignRule)
+	GlobalRuleRegistry.Register("no-dupe-else-if", no_dupe_else_if.NoDupeElseIfRule)
+	GlobalRuleRegistry.Register("no-unexpected-multiline", no_unexpected_multiline.NoUnexpectedMultilineRule)
+	GlobalRuleRegistry.Register("grouped-accessor-pairs", grouped_accessor_pairs.GroupedAccessorPairsRule)
+	GlobalRuleRegistry.Register("accessor-pairs", accessor_pairs.AccessorPairsRule)
+	GlobalRuleRegistry.Register("no-useless-assignment", no_useless_assignment.NoUselessAssignmentRule)
+	GlobalRuleRegistry.Register("no-whitespace-before-property", no_whitespace_before_property.NoWhitespaceBeforePropertyRule)
+	GlobalRuleRegistry.Register("dot-location", dot_location.DotLocationRule)
+	GlobalRuleRegistry.Register("no-mixed-operators", no_mixed_operators.NoMixedOperatorsRule)
+	GlobalRuleRegistry.Register("no-nested-ternary", no_nested_ternary.NoNestedTernaryRule)
+	GlobalRuleRegistry.Register("no-unneeded-ternary", no_unneeded_ternary.NoUnneededTernaryRule)
+	GlobalRuleRegistry.Register("no-useless-return", no_useless_return.NoUselessReturnRule)
+	GlobalRuleRegistry.Register("no-object-constructor", no_object_constructor.NoObjectConstructorRule)
+	GlobalRuleRegistry.Register("eqeqeq", eqeqeq.EqeqeqRule)
+	GlobalRuleRegistry.Register("symbol-description", symbol_description.SymbolDescriptionRule)
+	GlobalRuleRegistry.Register("no-throw-literal", no_throw_literal.NoThrowLiteralRule)
+
+	// Deprecated core rules superseded by typescript-eslint rules
+	GlobalRuleRegistry.RegisterDeprecated("no-return-await", no_return_await.NoReturnAwaitRule, "@typescript-eslint/return-await")
 }
 
 // getAllTypeScriptEslintPluginRules returns all registered rules (for backward compatibility when no config is provided)
@@ -483,17 +686,28 @@ func getAllTypeScriptEslintPluginRules() []rule.Rule {
 
 // isFileIgnored checks if a file should be ignored based on ignore patterns
 func isFileIgnored(filePath string, ignorePatterns []string) bool {
+	return matchesAnyPattern(filePath, ignorePatterns)
+}
+
+// isFileMatched checks if a file is targeted by the files patterns of a config entry.
+// An entry without files patterns applies to every file.
+func isFileMatched(filePath string, filePatterns []string) bool {
+	return len(filePatterns) == 0 || matchesAnyPattern(filePath, filePatterns)
+}
+
+// matchesAnyPattern checks if a file matches any of the glob patterns
+func matchesAnyPattern(filePath string, patterns []string) bool {
 	// Get current working directory for relative path resolution
 	cwd, err := os.Getwd()
 	if err != nil {
 		// If we can't get cwd, fall back to simple matching
-		return isFileIgnoredSimple(filePath, ignorePatterns)
+		return matchesAnyPatternSimple(filePath, patterns)
 	}
 
 	// Normalize the file path relative to cwd
 	normalizedPath := normalizePath(filePath, cwd)
 
-	for _, pattern := range ignorePatterns {
+	for _, pattern := range patterns {
 		// Try matching against normalized path
 		if matched, err := doublestar.Match(pattern, normalizedPath); err == nil && matched {
 			return true
@@ -525,9 +739,9 @@ func normalizePath(filePath, cwd string) string {
 	}))
 }
 
-// isFileIgnoredSimple provides fallback matching when cwd is unavailable
-func isFileIgnoredSimple(filePath string, ignorePatterns []string) bool {
-	for _, pattern := range ignorePatterns {
+// matchesAnyPatternSimple provides fallback matching when cwd is unavailable
+func matchesAnyPatternSimple(filePath string, patterns []string) bool {
+	for _, pattern := range patterns {
 		if matched, err := doublestar.Match(pattern, filePath); err == nil && matched {
 			return true
 		}
@@ -541,13 +755,14 @@ func InitDefaultConfig(directory string) error {
 
 	// if the config exists
 	if _, err := os.Stat(configPath); err == nil {
-		return fmt.Errorf("rslint.json already exists in %s", directory)
+		return &ConfigError{Path: configPath, Offset: -1, Message: fmt.Sprintf("rslint.jsonc already exists in %s", directory)}
 	}
 
 	// write file content
 	err := os.WriteFile(configPath, []byte(defaultJsonc), 0644)
 	if err != nil {
-		return fmt.Errorf("failed to create rslint.json: %w", err)
+		wrapped := fmt.Errorf("failed to create rslint.jsonc in %s: %w", directory, err)
+		return &ConfigError{Path: configPath, Offset: -1, Message: wrapped.Error(), Err: wrapped}
 	}
 
 	return nil
@@ -3,6 +3,8 @@ package config
 import (
 	"encoding/json"
 	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/rule"
 )
 
 func TestProjectPathsUnmarshalJSON(t *testing.T) {
@@ -100,3 +102,240 @@ func TestParserOptionsUnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRulesForFileMatchesFiles(t *testing.T) {
+	config := RslintConfig{
+		{
+			Files: []string{"src/**/*.ts"},
+			Rules: Rules{"no-debugger": "error"},
+		},
+		{
+			Files: []string{"**/*.test.ts"},
+			Rules: Rules{"no-console": "warn"},
+		},
+		{
+			// No files patterns applies to every file
+			Rules: Rules{"eqeqeq": "error"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		filePath string
+		expected []string
+	}{
+		{
+			name:     "source file",
+			filePath: "src/index.ts",
+			expected: []string{"no-debugger", "eqeqeq"},
+		},
+		{
+			name:     "test file",
+			filePath: "tests/index.test.ts",
+			expected: []string{"no-console", "eqeqeq"},
+		},
+		{
+			name:     "file matched by neither entry",
+			filePath: "scripts/build.js",
+			expected: []string{"eqeqeq"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := config.GetRulesForFile(tt.filePath)
+			if len(rules) != len(tt.expected) {
+				t.Errorf("Expected %d rules for %s, got %d: %v", len(tt.expected), tt.filePath, len(rules), rules)
+			}
+			for _, name := range tt.expected {
+				if _, ok := rules[name]; !ok {
+					t.Errorf("Expected rule %s to be enabled for %s", name, tt.filePath)
+				}
+			}
+		})
+	}
+}
+
+func TestGetRulesForFileUsesPluginDefaultLevels(t *testing.T) {
+	config := RslintConfig{
+		{
+			Plugins: []string{"eslint-plugin-import"},
+			Rules: Rules{
+				"import/no-self-import":           "warn",
+				"import/no-webpack-loader-syntax": "off",
+			},
+		},
+	}
+
+	// Explicit rules of the entry win over the levels the plugin enables its rules at
+	expected := map[string]string{
+		"import/no-self-import":           "warn",
+		"import/no-webpack-loader-syntax": "off",
+	}
+
+	rules := config.GetRulesForFile("src/index.ts")
+	if len(rules) != len(expected) {
+		t.Errorf("Expected %d rules, got %d: %v", len(expected), len(rules), rules)
+	}
+	for name, level := range expected {
+		ruleConfig, ok := rules[name]
+		if !ok {
+			t.Errorf("Expected rule %s to be configured", name)
+			continue
+		}
+		if ruleConfig.Level != level {
+			t.Errorf("Expected rule %s at level %q, got %q", name, level, ruleConfig.Level)
+		}
+	}
+}
+
+func TestGetDefaultRuleLevelsForRecommendedPlugin(t *testing.T) {
+	recommended := GetAllRulesForPlugin("eslint-plugin-import/recommended")
+	levels := GetDefaultRuleLevelsForPlugin("eslint-plugin-import/recommended")
+	if len(levels) != len(recommended) {
+		t.Errorf("Expected levels for the %d recommended rules, got %v", len(recommended), levels)
+	}
+	for _, r := range recommended {
+		if _, ok := levels[r.Name]; !ok {
+			t.Errorf("Expected a level for recommended rule %s", r.Name)
+		}
+	}
+}
+
+func TestGetRulesForFileEnablesAllPluginRulesAsErrors(t *testing.T) {
+	config := RslintConfig{
+		{Plugins: []string{"eslint-plugin-import"}},
+	}
+
+	rules := config.GetRulesForFile("src/index.ts")
+	for _, name := range []string{"import/no-self-import", "import/no-webpack-loader-syntax"} {
+		ruleConfig, ok := rules[name]
+		if !ok {
+			t.Errorf("Expected rule %s to be configured", name)
+			continue
+		}
+		if ruleConfig.Level != "error" {
+			t.Errorf("Expected rule %s at level %q, got %q", name, "error", ruleConfig.Level)
+		}
+	}
+}
+
+func TestGetRulesForFileNumericSeverities(t *testing.T) {
+	var config RslintConfig
+	err := json.Unmarshal([]byte(`[{
+		"rules": {
+			"no-debugger": 2,
+			"no-console": [1, {"allow": ["warn"]}],
+			"eqeqeq": 0,
+			"radix": {"level": 1}
+		}
+	}]`), &config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	rules := config.GetRulesForFile("src/index.ts")
+
+	expected := map[string]string{
+		"no-debugger": "error",
+		"no-console":  "warn",
+		"eqeqeq":      "off",
+		"radix":       "warn",
+	}
+	for name, level := range expected {
+		ruleConfig, ok := rules[name]
+		if level == "off" {
+			if ok && ruleConfig.IsEnabled() {
+				t.Errorf("Expected rule %s to be disabled, got level %q", name, ruleConfig.Level)
+			}
+			continue
+		}
+		if !ok {
+			t.Errorf("Expected rule %s to be enabled", name)
+			continue
+		}
+		if ruleConfig.Level != level {
+			t.Errorf("Expected rule %s at level %q, got %q", name, level, ruleConfig.Level)
+		}
+	}
+
+	if options := rules["no-console"].GetOptions(); options["allow"] == nil {
+		t.Errorf("Expected options of no-console to be kept, got %v", options)
+	}
+}
+
+func TestParseArrayRuleConfigNumericSeverity(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []interface{}
+		expected string
+	}{
+		{name: "int error", input: []interface{}{2}, expected: "error"},
+		{name: "float warn", input: []interface{}{float64(1), map[string]interface{}{}}, expected: "warn"},
+		{name: "int off", input: []interface{}{0}, expected: "off"},
+		{name: "string", input: []interface{}{"warn"}, expected: "warn"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruleConfig := parseArrayRuleConfig(tt.input)
+			if ruleConfig == nil {
+				t.Fatalf("Expected a rule config for %v", tt.input)
+			}
+			if ruleConfig.Level != tt.expected {
+				t.Errorf("Expected level %q, got %q", tt.expected, ruleConfig.Level)
+			}
+		})
+	}
+
+	if ruleConfig := parseArrayRuleConfig([]interface{}{3}); ruleConfig != nil {
+		t.Errorf("Expected an out of range severity to be rejected, got %v", ruleConfig)
+	}
+}
+
+func TestGetReportUnusedDisableDirectivesForFile(t *testing.T) {
+	var config RslintConfig
+	err := json.Unmarshal([]byte(`[
+		{
+			"rules": {"no-debugger": "error"}
+		},
+		{
+			"files": ["src/**/*.ts"],
+			"linterOptions": {"reportUnusedDisableDirectives": "error"}
+		},
+		{
+			"files": ["src/generated/**/*.ts"],
+			"linterOptions": {"reportUnusedDisableDirectives": false}
+		},
+		{
+			"files": ["tests/**/*.ts"],
+			"linterOptions": {"reportUnusedDisableDirectives": true}
+		},
+		{
+			"files": ["scripts/**/*.ts"],
+			"linterOptions": {"reportUnusedDisableDirectives": 1}
+		}
+	]`), &config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		filePath string
+		expected rule.DiagnosticSeverity
+	}{
+		{filePath: "index.ts", expected: rule.SeverityOff},
+		{filePath: "src/index.ts", expected: rule.SeverityError},
+		{filePath: "src/generated/types.ts", expected: rule.SeverityOff},
+		{filePath: "tests/index.ts", expected: rule.SeverityWarning},
+		{filePath: "scripts/build.ts", expected: rule.SeverityWarning},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filePath, func(t *testing.T) {
+			if severity := config.GetReportUnusedDisableDirectivesForFile(tt.filePath); severity != tt.expected {
+				t.Errorf("Expected severity %s, got %s", tt.expected, severity)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// ConfigError is returned when an rslint config file can't be created, read or parsed.
+// Line and Column point at the offending token so editors and the CLI can show
+// exactly where the file is malformed.
+type ConfigError struct {
+	// Path is the config file the error refers to
+	Path string
+	// Offset is the 0-based byte offset of the offending token, -1 if unknown
+	Offset int
+	// Line and Column are 1-based, 0 if unknown
+	Line    int
+	Column  int
+	Message string
+	// Err is the underlying error, if any
+	Err error
+}
+
+func (e *ConfigError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %s", e.Path, e.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", e.Path, e.Line, e.Column, e.Message)
+}
+
+func (e *ConfigError) Unwrap() error {
+	return e.Err
+}
+
+// newConfigParseError wraps a JSONC parse error with the path of the config file.
+// Err is the underlying hujson or encoding/json error so callers can match on it
+func newConfigParseError(path string, err error) *ConfigError {
+	var jsoncErr *utils.JSONCError
+	if errors.As(err, &jsoncErr) && jsoncErr.Err != nil {
+		return &ConfigError{
+			Path:    path,
+			Offset:  jsoncErr.Offset,
+			Line:    jsoncErr.Line,
+			Column:  jsoncErr.Column,
+			Message: jsoncErr.Message,
+			Err:     jsoncErr.Err,
+		}
+	}
+	return &ConfigError{Path: path, Offset: -1, Message: err.Error(), Err: err}
+}
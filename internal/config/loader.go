@@ -3,7 +3,10 @@ package config
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/microsoft/typescript-go/shim/tspath"
 	"github.com/microsoft/typescript-go/shim/vfs"
@@ -31,20 +34,134 @@ func (loader *ConfigLoader) LoadRslintConfig(configPath string) (RslintConfig, s
 		return nil, "", fmt.Errorf("rslint config file %q doesn't exist", configFileName)
 	}
 
+	config, err := loader.readRslintConfig(configFileName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	config, err = loader.resolveExtends(config, []string{configFileName})
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Update current directory to the config file's directory
+	configDirectory := tspath.GetDirectoryPath(configFileName)
+	return config, configDirectory, nil
+}
+
+// readRslintConfig reads and parses a single rslint configuration file without resolving its extends
+func (loader *ConfigLoader) readRslintConfig(configFileName string) (RslintConfig, error) {
 	data, ok := loader.fs.ReadFile(configFileName)
 	if !ok {
-		return nil, "", fmt.Errorf("error reading rslint config file %q", configFileName)
+		return nil, &ConfigError{Path: configFileName, Offset: -1, Message: "error reading rslint config file"}
 	}
 
 	var config RslintConfig
 	// Use JSONC parser to support comments and trailing commas
 	if err := utils.ParseJSONC([]byte(data), &config); err != nil {
-		return nil, "", fmt.Errorf("error parsing rslint config file %q: %w", configFileName, err)
+		return nil, newConfigParseError(configFileName, err)
 	}
+	return config, nil
+}
 
-	// Update current directory to the config file's directory
+// resolveExtends replaces the extends of each entry with the entries of the configs it extends.
+// chain holds the config files being resolved, the last one owning config, to detect cycles.
+func (loader *ConfigLoader) resolveExtends(config RslintConfig, chain []string) (RslintConfig, error) {
+	configFileName := chain[len(chain)-1]
 	configDirectory := tspath.GetDirectoryPath(configFileName)
-	return config, configDirectory, nil
+
+	resolved := make(RslintConfig, 0, len(config))
+	for _, entry := range config {
+		if len(entry.Extends) == 0 {
+			resolved = append(resolved, entry)
+			continue
+		}
+
+		var merged ConfigEntry
+		for _, extendsPath := range entry.Extends {
+			baseFileName := tspath.ResolvePath(configDirectory, extendsPath)
+			if slices.Contains(chain, baseFileName) {
+				return nil, &ConfigError{
+					Path:    configFileName,
+					Offset:  -1,
+					Message: "circular extends: " + strings.Join(append(slices.Clone(chain), baseFileName), " -> "),
+				}
+			}
+			if !loader.fs.FileExists(baseFileName) {
+				return nil, &ConfigError{Path: configFileName, Offset: -1, Message: fmt.Sprintf("extended rslint config file %q doesn't exist", baseFileName)}
+			}
+
+			base, err := loader.readRslintConfig(baseFileName)
+			if err != nil {
+				return nil, err
+			}
+			base, err = loader.resolveExtends(base, append(slices.Clone(chain), baseFileName))
+			if err != nil {
+				return nil, err
+			}
+
+			// Paths and patterns are relative to the extended config, not to the one extending it
+			baseDirectory := tspath.GetDirectoryPath(baseFileName)
+			for _, baseEntry := range base {
+				if baseEntry.LanguageOptions != nil && baseEntry.LanguageOptions.ParserOptions != nil {
+					for i, project := range baseEntry.LanguageOptions.ParserOptions.Project {
+						baseEntry.LanguageOptions.ParserOptions.Project[i] = tspath.ResolvePath(baseDirectory, project)
+					}
+				}
+				baseEntry.Files = resolvePatterns(baseDirectory, baseEntry.Files)
+				baseEntry.Ignores = resolvePatterns(baseDirectory, baseEntry.Ignores)
+				merged = mergeConfigEntries(merged, baseEntry)
+			}
+		}
+		resolved = append(resolved, mergeConfigEntries(merged, entry))
+	}
+	return resolved, nil
+}
+
+// mergeConfigEntries returns base overridden by entry. Rules are merged rule by rule, ignores and
+// plugins are combined and the remaining fields of entry replace those of base when set.
+func mergeConfigEntries(base ConfigEntry, entry ConfigEntry) ConfigEntry {
+	merged := base
+	merged.Extends = nil
+
+	if entry.Language != "" {
+		merged.Language = entry.Language
+	}
+	if len(entry.Files) > 0 {
+		merged.Files = entry.Files
+	}
+	if entry.LanguageOptions != nil {
+		merged.LanguageOptions = entry.LanguageOptions
+	}
+	merged.Ignores = append(slices.Clone(base.Ignores), entry.Ignores...)
+	for _, plugin := range entry.Plugins {
+		if !slices.Contains(merged.Plugins, plugin) {
+			merged.Plugins = append(slices.Clone(merged.Plugins), plugin)
+		}
+	}
+
+	if base.Rules != nil || entry.Rules != nil {
+		merged.Rules = make(Rules, len(base.Rules)+len(entry.Rules))
+		maps.Copy(merged.Rules, base.Rules)
+		maps.Copy(merged.Rules, entry.Rules)
+	}
+	return merged
+}
+
+// resolvePatterns resolves glob patterns against directory, keeping the leading `!` of negated patterns
+func resolvePatterns(directory string, patterns []string) []string {
+	if patterns == nil {
+		return nil
+	}
+	resolved := make([]string, len(patterns))
+	for i, pattern := range patterns {
+		if negated, ok := strings.CutPrefix(pattern, "!"); ok {
+			resolved[i] = "!" + tspath.ResolvePath(directory, negated)
+		} else {
+			resolved[i] = tspath.ResolvePath(directory, pattern)
+		}
+	}
+	return resolved
 }
 
 // LoadDefaultRslintConfig attempts to load default configuration files
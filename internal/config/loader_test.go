@@ -0,0 +1,317 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/microsoft/typescript-go/shim/vfs/osvfs"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+func TestLoadRslintConfigError(t *testing.T) {
+	directory := tspath.NormalizePath(t.TempDir())
+	configPath := filepath.Join(directory, "rslint.jsonc")
+	content := "[\n  {\n    // comment\n    \"ignores\": [\"dist/**\",,]\n  }\n]\n"
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	loader := NewConfigLoader(osvfs.FS(), directory)
+	_, _, err := loader.LoadRslintConfig("rslint.jsonc")
+
+	var configErr *ConfigError
+	if !errors.As(err, &configErr) {
+		t.Fatalf("LoadRslintConfig() error = %v, want *ConfigError", err)
+	}
+	if configErr.Path != tspath.ResolvePath(directory, "rslint.jsonc") {
+		t.Errorf("ConfigError.Path = %q, want %q", configErr.Path, tspath.ResolvePath(directory, "rslint.jsonc"))
+	}
+	if configErr.Line != 4 {
+		t.Errorf("ConfigError.Line = %d, want 4 (%v)", configErr.Line, err)
+	}
+	if configErr.Column == 0 || configErr.Offset < 0 {
+		t.Errorf("ConfigError location missing: %+v", configErr)
+	}
+	if configErr.Err == nil {
+		t.Errorf("ConfigError.Err = nil, want the underlying parse error")
+	}
+	var jsoncErr *utils.JSONCError
+	if errors.As(err, &jsoncErr) {
+		t.Errorf("ConfigError.Err should be the underlying parse error, got %v", jsoncErr)
+	}
+}
+
+func TestLoadRslintConfigDecodeErrorUnwraps(t *testing.T) {
+	directory := tspath.NormalizePath(t.TempDir())
+	configPath := filepath.Join(directory, "rslint.jsonc")
+	if err := os.WriteFile(configPath, []byte(`[{"ignores": "dist/**"}]`), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	loader := NewConfigLoader(osvfs.FS(), directory)
+	_, _, err := loader.LoadRslintConfig("rslint.jsonc")
+
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("LoadRslintConfig() error = %v, want it to wrap *json.UnmarshalTypeError", err)
+	}
+}
+
+func TestInitDefaultConfigExists(t *testing.T) {
+	directory := t.TempDir()
+	if err := InitDefaultConfig(directory); err != nil {
+		t.Fatalf("InitDefaultConfig() error = %v", err)
+	}
+
+	err := InitDefaultConfig(directory)
+	var configErr *ConfigError
+	if !errors.As(err, &configErr) {
+		t.Fatalf("InitDefaultConfig() error = %v, want *ConfigError", err)
+	}
+	if configErr.Path != filepath.Join(directory, "rslint.jsonc") {
+		t.Errorf("ConfigError.Path = %q, want %q", configErr.Path, filepath.Join(directory, "rslint.jsonc"))
+	}
+	if !strings.Contains(configErr.Message, directory) {
+		t.Errorf("ConfigError.Message = %q, want it to mention %q", configErr.Message, directory)
+	}
+}
+
+func TestLoadRslintConfigWithComments(t *testing.T) {
+	directory := tspath.NormalizePath(t.TempDir())
+	content := `// rslint config
+[
+  {
+    /* ignore build output */
+    "ignores": ["dist/**", "https://example.com/*",],
+    "languageOptions": {
+      "parserOptions": {
+        "project": "./tsconfig.json", // a single path is allowed
+      },
+    },
+    "rules": {
+      "no-debugger": "error", // trailing comma and comment
+      "@typescript-eslint/array-type": ["warn", { "default": "array-simple", },],
+    },
+  },
+]
+`
+	if err := os.WriteFile(filepath.Join(directory, "rslint.jsonc"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	loader := NewConfigLoader(osvfs.FS(), directory)
+	config, configDirectory, err := loader.LoadRslintConfig("rslint.jsonc")
+	if err != nil {
+		t.Fatalf("LoadRslintConfig() error = %v", err)
+	}
+	if configDirectory != directory {
+		t.Errorf("LoadRslintConfig() directory = %q, want %q", configDirectory, directory)
+	}
+	if len(config) != 1 {
+		t.Fatalf("LoadRslintConfig() returned %d entries, want 1", len(config))
+	}
+
+	entry := config[0]
+	if len(entry.Ignores) != 2 || entry.Ignores[1] != "https://example.com/*" {
+		t.Errorf("Ignores = %v, want [dist/** https://example.com/*]", entry.Ignores)
+	}
+	if entry.LanguageOptions == nil || entry.LanguageOptions.ParserOptions == nil ||
+		len(entry.LanguageOptions.ParserOptions.Project) != 1 {
+		t.Fatalf("LanguageOptions not parsed: %+v", entry.LanguageOptions)
+	}
+	if entry.Rules["no-debugger"] != "error" {
+		t.Errorf("Rules[no-debugger] = %v, want error", entry.Rules["no-debugger"])
+	}
+	if _, ok := entry.Rules["@typescript-eslint/array-type"].([]interface{}); !ok {
+		t.Errorf("Rules[@typescript-eslint/array-type] = %v, want array config", entry.Rules["@typescript-eslint/array-type"])
+	}
+}
+
+func TestDefaultConfigIsValidJSONC(t *testing.T) {
+	directory := tspath.NormalizePath(t.TempDir())
+	if err := InitDefaultConfig(directory); err != nil {
+		t.Fatalf("InitDefaultConfig() error = %v", err)
+	}
+
+	loader := NewConfigLoader(osvfs.FS(), directory)
+	config, _, err := loader.LoadDefaultRslintConfig()
+	if err != nil {
+		t.Fatalf("LoadDefaultRslintConfig() error = %v", err)
+	}
+	if len(config) != 1 || len(config[0].Plugins) != 1 {
+		t.Errorf("default config parsed as %+v", config)
+	}
+}
+
+func TestLoadRslintConfigExtends(t *testing.T) {
+	directory := tspath.NormalizePath(t.TempDir())
+	files := map[string]string{
+		"shared/rslint.base.json": `[
+  {
+    "language": "javascript",
+    "ignores": ["dist/**"],
+    "languageOptions": { "parserOptions": { "project": ["./tsconfig.json"] } },
+    "rules": { "no-debugger": "error", "no-console": "warn" }
+  }
+]`,
+		"shared/rslint.strict.json": `[
+  {
+    "extends": ["./rslint.base.json"],
+    "rules": { "no-console": "error", "eqeqeq": "error" }
+  }
+]`,
+		"rslint.json": `[
+  {
+    "extends": ["./shared/rslint.strict.json"],
+    "files": ["src/**/*.ts"],
+    "rules": { "no-debugger": "off" }
+  }
+]`,
+	}
+	for name, content := range files {
+		path := filepath.Join(directory, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write config: %v", err)
+		}
+	}
+
+	loader := NewConfigLoader(osvfs.FS(), directory)
+	config, _, err := loader.LoadRslintConfig("rslint.json")
+	if err != nil {
+		t.Fatalf("LoadRslintConfig() error = %v", err)
+	}
+	if len(config) != 1 {
+		t.Fatalf("LoadRslintConfig() returned %d entries, want 1", len(config))
+	}
+
+	entry := config[0]
+	expectedRules := map[string]string{
+		"no-debugger": "off",
+		"no-console":  "error",
+		"eqeqeq":      "error",
+	}
+	if len(entry.Rules) != len(expectedRules) {
+		t.Errorf("Rules = %v, want %v", entry.Rules, expectedRules)
+	}
+	for name, level := range expectedRules {
+		if entry.Rules[name] != level {
+			t.Errorf("Rules[%s] = %v, want %s", name, entry.Rules[name], level)
+		}
+	}
+	if entry.Language != "javascript" {
+		t.Errorf("Language = %q, want javascript", entry.Language)
+	}
+	if len(entry.Files) != 1 || entry.Files[0] != "src/**/*.ts" {
+		t.Errorf("Files = %v, want [src/**/*.ts]", entry.Files)
+	}
+
+	// Paths and patterns of an extended config stay relative to that config
+	expectedIgnore := tspath.ResolvePath(directory, "shared/dist/**")
+	if len(entry.Ignores) != 1 || entry.Ignores[0] != expectedIgnore {
+		t.Errorf("Ignores = %v, want [%s]", entry.Ignores, expectedIgnore)
+	}
+	expectedProject := tspath.ResolvePath(directory, "shared/tsconfig.json")
+	if entry.LanguageOptions == nil || entry.LanguageOptions.ParserOptions == nil ||
+		len(entry.LanguageOptions.ParserOptions.Project) != 1 ||
+		entry.LanguageOptions.ParserOptions.Project[0] != expectedProject {
+		t.Errorf("LanguageOptions = %+v, want project %q", entry.LanguageOptions, expectedProject)
+	}
+
+	rules := config.GetRulesForFile("src/index.ts")
+	if rule, ok := rules["no-debugger"]; ok && rule.IsEnabled() {
+		t.Errorf("Expected no-debugger to be turned off by the extending config")
+	}
+	if rule, ok := rules["no-console"]; !ok || rule.Level != "error" {
+		t.Errorf("Expected no-console at level error, got %v", rule)
+	}
+}
+
+func TestLoadRslintConfigExtendsOverridesFilesAndLanguageOptions(t *testing.T) {
+	directory := tspath.NormalizePath(t.TempDir())
+	files := map[string]string{
+		"shared/rslint.base.json": `[
+  {
+    "languageOptions": { "parserOptions": { "project": ["./tsconfig.json"] } },
+    "rules": { "no-debugger": "error" }
+  }
+]`,
+		"rslint.json": `[
+  {
+    "extends": ["./shared/rslint.base.json"],
+    "files": ["packages/a/**/*.ts"],
+    "languageOptions": { "parserOptions": { "project": ["./packages/a/tsconfig.json"] } },
+    "rules": { "eqeqeq": "error" }
+  }
+]`,
+	}
+	for name, content := range files {
+		path := filepath.Join(directory, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write config: %v", err)
+		}
+	}
+
+	loader := NewConfigLoader(osvfs.FS(), directory)
+	config, _, err := loader.LoadRslintConfig("rslint.json")
+	if err != nil {
+		t.Fatalf("LoadRslintConfig() error = %v", err)
+	}
+	if len(config) != 1 {
+		t.Fatalf("LoadRslintConfig() returned %d entries, want 1", len(config))
+	}
+
+	// languageOptions of the extending entry replace those of the base
+	entry := config[0]
+	if entry.LanguageOptions == nil || entry.LanguageOptions.ParserOptions == nil ||
+		len(entry.LanguageOptions.ParserOptions.Project) != 1 ||
+		entry.LanguageOptions.ParserOptions.Project[0] != "./packages/a/tsconfig.json" {
+		t.Errorf("LanguageOptions = %+v, want only the project of rslint.json", entry.LanguageOptions)
+	}
+
+	// Inherited rules are scoped to the files of the extending entry
+	rules := config.GetRulesForFile("packages/a/index.ts")
+	if _, ok := rules["no-debugger"]; !ok {
+		t.Errorf("Expected no-debugger to apply to packages/a, got %v", rules)
+	}
+	if _, ok := rules["eqeqeq"]; !ok {
+		t.Errorf("Expected eqeqeq to apply to packages/a, got %v", rules)
+	}
+	if rules := config.GetRulesForFile("packages/b/index.ts"); len(rules) != 0 {
+		t.Errorf("Expected no rules outside packages/a, got %v", rules)
+	}
+}
+
+func TestLoadRslintConfigExtendsCycle(t *testing.T) {
+	directory := tspath.NormalizePath(t.TempDir())
+	files := map[string]string{
+		"a.json": `[{ "extends": ["./b.json"] }]`,
+		"b.json": `[{ "extends": ["./a.json"] }]`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(directory, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write config: %v", err)
+		}
+	}
+
+	loader := NewConfigLoader(osvfs.FS(), directory)
+	_, _, err := loader.LoadRslintConfig("a.json")
+
+	var configErr *ConfigError
+	if !errors.As(err, &configErr) {
+		t.Fatalf("LoadRslintConfig() error = %v, want *ConfigError", err)
+	}
+	if !strings.Contains(configErr.Message, "circular extends") {
+		t.Errorf("ConfigError.Message = %q, want a circular extends error", configErr.Message)
+	}
+}
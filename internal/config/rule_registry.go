@@ -1,6 +1,8 @@
 package config
 
 import (
+	"slices"
+
 	"github.com/web-infra-dev/rslint/internal/linter"
 	"github.com/web-infra-dev/rslint/internal/rule"
 )
@@ -8,12 +10,15 @@ import (
 // RuleRegistry manages all available rules
 type RuleRegistry struct {
 	rules map[string]rule.Rule
+	// deprecated maps the names of deprecated rules to the name of the rule replacing them
+	deprecated map[string]string
 }
 
 // NewRuleRegistry creates a new rule registry
 func NewRuleRegistry() *RuleRegistry {
 	return &RuleRegistry{
-		rules: make(map[string]rule.Rule),
+		rules:      make(map[string]rule.Rule),
+		deprecated: make(map[string]string),
 	}
 }
 
@@ -22,6 +27,18 @@ func (r *RuleRegistry) Register(ruleName string, ruleImpl rule.Rule) {
 	r.rules[ruleName] = ruleImpl
 }
 
+// RegisterDeprecated adds a deprecated rule to the registry, which configs should replace with replacement
+func (r *RuleRegistry) RegisterDeprecated(ruleName string, ruleImpl rule.Rule, replacement string) {
+	r.rules[ruleName] = ruleImpl
+	r.deprecated[ruleName] = replacement
+}
+
+// GetReplacement returns the name of the rule replacing a deprecated rule
+func (r *RuleRegistry) GetReplacement(ruleName string) (string, bool) {
+	replacement, isDeprecated := r.deprecated[ruleName]
+	return replacement, isDeprecated
+}
+
 // GetRule returns a rule by name
 func (r *RuleRegistry) GetRule(name string) (rule.Rule, bool) {
 	rule, exists := r.rules[name]
@@ -58,5 +75,35 @@ func (r *RuleRegistry) GetEnabledRules(config RslintConfig, filePath string) []l
 	return enabledRules
 }
 
+// GetUnknownRules returns the sorted, de-duplicated names of configured rules that aren't registered
+func (r *RuleRegistry) GetUnknownRules(config RslintConfig) []string {
+	var unknownRules []string
+	for _, entry := range config {
+		for ruleName := range entry.Rules {
+			if _, exists := r.rules[ruleName]; !exists {
+				unknownRules = append(unknownRules, ruleName)
+			}
+		}
+	}
+
+	slices.Sort(unknownRules)
+	return slices.Compact(unknownRules)
+}
+
+// GetDeprecatedRules returns the sorted, de-duplicated names of configured rules that are deprecated
+func (r *RuleRegistry) GetDeprecatedRules(config RslintConfig) []string {
+	var deprecatedRules []string
+	for _, entry := range config {
+		for ruleName := range entry.Rules {
+			if _, isDeprecated := r.deprecated[ruleName]; isDeprecated {
+				deprecatedRules = append(deprecatedRules, ruleName)
+			}
+		}
+	}
+
+	slices.Sort(deprecatedRules)
+	return slices.Compact(deprecatedRules)
+}
+
 // Global rule registry instance
 var GlobalRuleRegistry = NewRuleRegistry()
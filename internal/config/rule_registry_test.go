@@ -0,0 +1,87 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+func TestGetUnknownRules(t *testing.T) {
+	registry := NewRuleRegistry()
+	registry.Register("no-cond-assign", rule.Rule{Name: "no-cond-assign"})
+	registry.Register("@typescript-eslint/array-type", rule.Rule{Name: "@typescript-eslint/array-type"})
+
+	tests := []struct {
+		name     string
+		config   RslintConfig
+		expected []string
+	}{
+		{
+			name: "all rules registered",
+			config: RslintConfig{
+				{Rules: Rules{"no-cond-assign": "error", "@typescript-eslint/array-type": "warn"}},
+			},
+			expected: nil,
+		},
+		{
+			name: "misspelled rule",
+			config: RslintConfig{
+				{Rules: Rules{"no-cond-asign": "error", "@typescript-eslint/array-type": "warn"}},
+			},
+			expected: []string{"no-cond-asign"},
+		},
+		{
+			name: "unknown rules across entries are sorted and de-duplicated",
+			config: RslintConfig{
+				{Rules: Rules{"no-such-rule": "error", "@typescript-eslint/arry-type": "off"}},
+				{Rules: Rules{"no-such-rule": "warn"}},
+			},
+			expected: []string{"@typescript-eslint/arry-type", "no-such-rule"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unknownRules := registry.GetUnknownRules(tt.config)
+
+			if len(unknownRules) != len(tt.expected) {
+				t.Fatalf("Expected unknown rules %v, got %v", tt.expected, unknownRules)
+			}
+			for i, expected := range tt.expected {
+				if unknownRules[i] != expected {
+					t.Errorf("Expected %s at index %d, got %s", expected, i, unknownRules[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRegisterDeprecated(t *testing.T) {
+	registry := NewRuleRegistry()
+	registry.Register("return-await", rule.Rule{Name: "return-await"})
+	registry.RegisterDeprecated("no-return-await", rule.Rule{Name: "no-return-await"}, "return-await")
+
+	if replacement, isDeprecated := registry.GetReplacement("no-return-await"); !isDeprecated || replacement != "return-await" {
+		t.Errorf("Expected no-return-await to be replaced by return-await, got %q", replacement)
+	}
+	if _, isDeprecated := registry.GetReplacement("return-await"); isDeprecated {
+		t.Errorf("Expected return-await not to be deprecated")
+	}
+
+	config := RslintConfig{
+		{Rules: Rules{"no-return-await": "error", "return-await": "warn"}},
+		{Rules: Rules{"no-return-await": "off"}},
+	}
+	if unknownRules := registry.GetUnknownRules(config); len(unknownRules) != 0 {
+		t.Errorf("Expected no unknown rules, got %v", unknownRules)
+	}
+	deprecatedRules := registry.GetDeprecatedRules(config)
+	if len(deprecatedRules) != 1 || deprecatedRules[0] != "no-return-await" {
+		t.Errorf("Expected deprecated rules [no-return-await], got %v", deprecatedRules)
+	}
+
+	enabledRules := registry.GetEnabledRules(RslintConfig{{Rules: Rules{"no-return-await": "error"}}}, "index.ts")
+	if len(enabledRules) != 1 || enabledRules[0].Name != "no-return-await" {
+		t.Errorf("Expected the deprecated rule to be enabled, got %+v", enabledRules)
+	}
+}
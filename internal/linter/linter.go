@@ -19,7 +19,17 @@ type ConfiguredRule struct {
 	Run      func(ctx rule.RuleContext) rule.RuleListeners
 }
 
-func RunLinterInProgram(program *compiler.Program, allowFiles []string, skipFiles []string, getRulesForFile RuleHandler, onDiagnostic DiagnosticHandler) int32 {
+// reportKey identifies a single logical issue reported by a rule
+type reportKey struct {
+	ruleName  string
+	textRange core.TextRange
+	messageId string
+}
+
+// UnusedDisableDirectiveRuleName is the rule name unused eslint-disable directives are reported under
+const UnusedDisableDirectiveRuleName = "unused-disable-directive"
+
+func RunLinterInProgram(program *compiler.Program, allowFiles []string, skipFiles []string, getRulesForFile RuleHandler, getUnusedDirectivesSeverity UnusedDirectivesHandler, onDiagnostic DiagnosticHandler) int32 {
 	checker, done := program.GetTypeChecker(context.Background())
 	defer done()
 	var lintedFileCount int32 = 0
@@ -65,6 +75,27 @@ func RunLinterInProgram(program *compiler.Program, allowFiles []string, skipFile
 
 			// Create disable manager for this file
 			disableManager := rule.NewDisableManager(file, comments)
+			fileComments := rule.NewComments(file, comments)
+
+			// Rules may report the same issue twice, e.g. when checking both operand orders of a
+			// symmetric expression, so only the first identical report is emitted
+			reported := make(map[reportKey]struct{})
+			report := func(diagnostic rule.RuleDiagnostic) {
+				// Skip diagnostics suppressed by eslint-disable directives
+				if disableManager.IsRuleDisabled(diagnostic.RuleName, diagnostic.Range.Pos()) {
+					return
+				}
+				key := reportKey{
+					ruleName:  diagnostic.RuleName,
+					textRange: diagnostic.Range,
+					messageId: diagnostic.Message.Id,
+				}
+				if _, ok := reported[key]; ok {
+					return
+				}
+				reported[key] = struct{}{}
+				onDiagnostic(diagnostic)
+			}
 
 			for _, r := range rules {
 				ctx := rule.RuleContext{
@@ -72,12 +103,9 @@ func RunLinterInProgram(program *compiler.Program, allowFiles []string, skipFile
 					Program:        program,
 					TypeChecker:    checker,
 					DisableManager: disableManager,
+					Comments:       fileComments,
 					ReportRange: func(textRange core.TextRange, msg rule.RuleMessage) {
-						// Check if rule is disabled at this position
-						if disableManager.IsRuleDisabled(r.Name, textRange.Pos()) {
-							return
-						}
-						onDiagnostic(rule.RuleDiagnostic{
+						report(rule.RuleDiagnostic{
 							RuleName:   r.Name,
 							Range:      textRange,
 							Message:    msg,
@@ -86,11 +114,7 @@ func RunLinterInProgram(program *compiler.Program, allowFiles []string, skipFile
 						})
 					},
 					ReportRangeWithSuggestions: func(textRange core.TextRange, msg rule.RuleMessage, suggestions ...rule.RuleSuggestion) {
-						// Check if rule is disabled at this position
-						if disableManager.IsRuleDisabled(r.Name, textRange.Pos()) {
-							return
-						}
-						onDiagnostic(rule.RuleDiagnostic{
+						report(rule.RuleDiagnostic{
 							RuleName:    r.Name,
 							Range:       textRange,
 							Message:     msg,
@@ -100,11 +124,7 @@ func RunLinterInProgram(program *compiler.Program, allowFiles []string, skipFile
 						})
 					},
 					ReportRangeWithFixes: func(textRange core.TextRange, msg rule.RuleMessage, fixes ...rule.RuleFix) {
-						// Check if rule is disabled at this position
-						if disableManager.IsRuleDisabled(r.Name, textRange.Pos()) {
-							return
-						}
-						onDiagnostic(rule.RuleDiagnostic{
+						report(rule.RuleDiagnostic{
 							RuleName:   r.Name,
 							Range:      textRange,
 							Message:    msg,
@@ -114,11 +134,7 @@ func RunLinterInProgram(program *compiler.Program, allowFiles []string, skipFile
 						})
 					},
 					ReportNode: func(node *ast.Node, msg rule.RuleMessage) {
-						// Check if rule is disabled at this position
-						if disableManager.IsRuleDisabled(r.Name, node.Pos()) {
-							return
-						}
-						onDiagnostic(rule.RuleDiagnostic{
+						report(rule.RuleDiagnostic{
 							RuleName:   r.Name,
 							Range:      utils.TrimNodeTextRange(file, node),
 							Message:    msg,
@@ -127,11 +143,7 @@ func RunLinterInProgram(program *compiler.Program, allowFiles []string, skipFile
 						})
 					},
 					ReportNodeWithFixes: func(node *ast.Node, msg rule.RuleMessage, fixes ...rule.RuleFix) {
-						// Check if rule is disabled at this position
-						if disableManager.IsRuleDisabled(r.Name, node.Pos()) {
-							return
-						}
-						onDiagnostic(rule.RuleDiagnostic{
+						report(rule.RuleDiagnostic{
 							RuleName:   r.Name,
 							Range:      utils.TrimNodeTextRange(file, node),
 							Message:    msg,
@@ -142,11 +154,7 @@ func RunLinterInProgram(program *compiler.Program, allowFiles []string, skipFile
 					},
 
 					ReportNodeWithSuggestions: func(node *ast.Node, msg rule.RuleMessage, suggestions ...rule.RuleSuggestion) {
-						// Check if rule is disabled at this position
-						if disableManager.IsRuleDisabled(r.Name, node.Pos()) {
-							return
-						}
-						onDiagnostic(rule.RuleDiagnostic{
+						report(rule.RuleDiagnostic{
 							RuleName:    r.Name,
 							Range:       utils.TrimNodeTextRange(file, node),
 							Message:     msg,
@@ -241,6 +249,23 @@ func RunLinterInProgram(program *compiler.Program, allowFiles []string, skipFile
 			}
 			file.Node.ForEachChild(childVisitor)
 			clear(registeredListeners)
+
+			// Directives are only known to be unused once every rule has run
+			if getUnusedDirectivesSeverity != nil {
+				if severity := getUnusedDirectivesSeverity(file); severity != rule.SeverityOff {
+					for _, directive := range disableManager.GetUnusedDirectives() {
+						fixes := []rule.RuleFix{directive.Fix}
+						onDiagnostic(rule.RuleDiagnostic{
+							RuleName:   UnusedDisableDirectiveRuleName,
+							Range:      directive.Range,
+							Message:    directive.Message,
+							FixesPtr:   &fixes,
+							SourceFile: file,
+							Severity:   severity,
+						})
+					}
+				}
+			}
 		}
 
 	}
@@ -250,9 +275,13 @@ func RunLinterInProgram(program *compiler.Program, allowFiles []string, skipFile
 type RuleHandler = func(sourceFile *ast.SourceFile) []ConfiguredRule
 type DiagnosticHandler = func(diagnostic rule.RuleDiagnostic)
 
+// UnusedDirectivesHandler returns the severity of unused eslint-disable directives in a file, a nil handler
+// or rule.SeverityOff skips the report
+type UnusedDirectivesHandler = func(sourceFile *ast.SourceFile) rule.DiagnosticSeverity
+
 // when allowedFiles is passed as nil which means all files are allowed
 // when allowedFiles is passed as slice, only files in the slice are allowed
-func RunLinter(programs []*compiler.Program, singleThreaded bool, allowFiles []string, excludedPaths []string, getRulesForFile RuleHandler, onDiagnostic DiagnosticHandler) (int32, error) {
+func RunLinter(programs []*compiler.Program, singleThreaded bool, allowFiles []string, excludedPaths []string, getRulesForFile RuleHandler, getUnusedDirectivesSeverity UnusedDirectivesHandler, onDiagnostic DiagnosticHandler) (int32, error) {
 
 	wg := core.NewWorkGroup(singleThreaded)
 
@@ -260,7 +289,7 @@ func RunLinter(programs []*compiler.Program, singleThreaded bool, allowFiles []s
 	for _, program := range programs {
 		{
 			wg.Queue(func() {
-				fileCount := RunLinterInProgram(program, allowFiles, excludedPaths, getRulesForFile, onDiagnostic)
+				fileCount := RunLinterInProgram(program, allowFiles, excludedPaths, getRulesForFile, getUnusedDirectivesSeverity, onDiagnostic)
 				lintedFileCount.Add(fileCount)
 			})
 		}
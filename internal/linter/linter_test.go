@@ -0,0 +1,178 @@
+package linter_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/compiler"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/web-infra-dev/rslint/internal/linter"
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+	"github.com/web-infra-dev/rslint/internal/utils"
+	"gotest.tools/v3/assert"
+)
+
+// symmetricComparisonRule checks both operand orders of a comparison, so a
+// comparison matching in both directions is reported twice by the rule
+var symmetricComparisonRule = rule.CreateRule(rule.Rule{
+	Name: "symmetric-comparison",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		check := func(node *ast.Node, left *ast.Node, right *ast.Node) {
+			if ast.SkipParentheses(left).Kind == ast.KindTypeOfExpression {
+				ctx.ReportNode(node, rule.RuleMessage{
+					Id:          "typeofComparison",
+					Description: "Unexpected typeof comparison.",
+				})
+			}
+		}
+		return rule.RuleListeners{
+			ast.KindBinaryExpression: func(node *ast.Node) {
+				expr := node.AsBinaryExpression()
+				if expr.OperatorToken.Kind != ast.KindEqualsEqualsEqualsToken {
+					return
+				}
+				check(node, expr.Left, expr.Right)
+				check(node, expr.Right, expr.Left)
+			},
+		}
+	},
+})
+
+func TestIdenticalReportsAreDeduplicated(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&symmetricComparisonRule,
+		[]rule_tester.ValidTestCase{
+			{Code: `declare const a: string; a === 'string';`},
+		},
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `declare const a: string; typeof a === 'string';`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "typeofComparison", Line: 1, Column: 26},
+				},
+			},
+			{
+				Code: `declare const a: string, b: number; typeof a === typeof b;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "typeofComparison", Line: 1, Column: 37},
+				},
+			},
+			{
+				Code: `declare const a: string, b: number; typeof a === typeof b; typeof b === typeof a;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "typeofComparison", Line: 1, Column: 37},
+					{MessageId: "typeofComparison", Line: 1, Column: 60},
+				},
+			},
+		},
+	)
+}
+
+// identifierRule reports every identifier named text
+func identifierRule(name string, text string) linter.ConfiguredRule {
+	return linter.ConfiguredRule{
+		Name:     name,
+		Severity: rule.SeverityError,
+		Run: func(ctx rule.RuleContext) rule.RuleListeners {
+			return rule.RuleListeners{
+				ast.KindIdentifier: func(node *ast.Node) {
+					if node.Text() == text {
+						ctx.ReportNode(node, rule.RuleMessage{
+							Id:          "unexpected",
+							Description: "Unexpected " + text + ".",
+						})
+					}
+				},
+			}
+		},
+	}
+}
+
+// lintWithRules runs configuredRules on code and returns the name of the rule behind each diagnostic
+func lintWithRules(t *testing.T, code string, unusedDirectivesSeverity rule.DiagnosticSeverity, configuredRules ...linter.ConfiguredRule) []string {
+	t.Helper()
+
+	rootDir := fixtures.GetRootDir()
+	fs := utils.NewOverlayVFSForFile(tspath.ResolvePath(rootDir, "file.ts"), code)
+	host := utils.CreateCompilerHost(rootDir, fs)
+	program, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.json", host)
+	assert.NilError(t, err, "couldn't create program. code: "+code)
+
+	var reported []string
+	_, err = linter.RunLinter(
+		[]*compiler.Program{program},
+		true,
+		[]string{program.GetSourceFile("file.ts").FileName()},
+		[]string{},
+		func(sourceFile *ast.SourceFile) []linter.ConfiguredRule {
+			return configuredRules
+		},
+		func(sourceFile *ast.SourceFile) rule.DiagnosticSeverity {
+			return unusedDirectivesSeverity
+		},
+		func(diagnostic rule.RuleDiagnostic) {
+			reported = append(reported, diagnostic.RuleName)
+		},
+	)
+	assert.NilError(t, err, "error running linter. code:\n", code)
+	return reported
+}
+
+func TestDisableDirectivesSuppressSingleRule(t *testing.T) {
+	noFoo := identifierRule("no-foo", "foo")
+	noBar := identifierRule("no-bar", "bar")
+
+	tests := []struct {
+		name     string
+		code     string
+		expected []string
+	}{
+		{
+			name:     "no directive",
+			code:     "declare const foo: number, bar: number;\nfoo; bar;",
+			expected: []string{"no-bar", "no-bar", "no-foo", "no-foo"},
+		},
+		{
+			name:     "disable-line",
+			code:     "declare const foo: number, bar: number; // eslint-disable-line no-foo, no-bar\nfoo; bar; // eslint-disable-line no-foo",
+			expected: []string{"no-bar"},
+		},
+		{
+			name:     "disable-next-line",
+			code:     "declare const foo: number, bar: number; // eslint-disable-line\n// eslint-disable-next-line no-bar -- bar is fine here\nfoo; bar;",
+			expected: []string{"no-foo"},
+		},
+		{
+			name:     "disable block",
+			code:     "/* eslint-disable no-foo */\ndeclare const foo: number, bar: number; // eslint-disable-line no-bar\nfoo; bar;",
+			expected: []string{"no-bar"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reported := lintWithRules(t, tt.code, rule.SeverityOff, noFoo, noBar)
+			slices.Sort(reported)
+			assert.DeepEqual(t, reported, tt.expected)
+		})
+	}
+}
+
+func TestUnusedDisableDirectivesAreReported(t *testing.T) {
+	noFoo := identifierRule("no-foo", "foo")
+	noBar := identifierRule("no-bar", "bar")
+	code := "declare const foo: number; // eslint-disable-line no-foo, no-bar\n// eslint-disable-next-line no-bar\nfoo;"
+
+	reported := lintWithRules(t, code, rule.SeverityOff, noFoo, noBar)
+	assert.DeepEqual(t, reported, []string{"no-foo"})
+
+	reported = lintWithRules(t, code, rule.SeverityWarning, noFoo, noBar)
+	slices.Sort(reported)
+	assert.DeepEqual(t, reported, []string{"no-foo", linter.UnusedDisableDirectiveRuleName, linter.UnusedDisableDirectiveRuleName})
+}
@@ -0,0 +1,78 @@
+package linter
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// RuleTimings accumulates the wall-clock time spent in each rule across all linted files.
+// It is safe for concurrent use by multiple programs.
+type RuleTimings struct {
+	mu        sync.Mutex
+	durations map[string]*atomic.Int64
+}
+
+type RuleTiming struct {
+	RuleName string
+	Duration time.Duration
+}
+
+func NewRuleTimings() *RuleTimings {
+	return &RuleTimings{durations: make(map[string]*atomic.Int64)}
+}
+
+func (t *RuleTimings) counter(ruleName string) *atomic.Int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	counter, ok := t.durations[ruleName]
+	if !ok {
+		counter = &atomic.Int64{}
+		t.durations[ruleName] = counter
+	}
+	return counter
+}
+
+// Wrap instruments a configured rule so that its setup and every listener call
+// are timed and attributed to the rule
+func (t *RuleTimings) Wrap(r ConfiguredRule) ConfiguredRule {
+	counter := t.counter(r.Name)
+	run := r.Run
+	r.Run = func(ctx rule.RuleContext) rule.RuleListeners {
+		start := time.Now()
+		listeners := run(ctx)
+		counter.Add(int64(time.Since(start)))
+
+		for kind, listener := range listeners {
+			listeners[kind] = func(node *ast.Node) {
+				start := time.Now()
+				listener(node)
+				counter.Add(int64(time.Since(start)))
+			}
+		}
+		return listeners
+	}
+	return r
+}
+
+// Sorted returns the rule timings with the slowest rules first
+func (t *RuleTimings) Sorted() []RuleTiming {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	timings := make([]RuleTiming, 0, len(t.durations))
+	for ruleName, counter := range t.durations {
+		timings = append(timings, RuleTiming{RuleName: ruleName, Duration: time.Duration(counter.Load())})
+	}
+	slices.SortFunc(timings, func(a, b RuleTiming) int {
+		if c := cmp.Compare(b.Duration, a.Duration); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.RuleName, b.RuleName)
+	})
+	return timings
+}
@@ -0,0 +1,62 @@
+package linter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/linter"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"gotest.tools/v3/assert"
+)
+
+// sleepingRule is a configured rule that takes at least setup to set up and listener for each node it visits
+func sleepingRule(name string, setup time.Duration, listener time.Duration) linter.ConfiguredRule {
+	return linter.ConfiguredRule{
+		Name: name,
+		Run: func(ctx rule.RuleContext) rule.RuleListeners {
+			time.Sleep(setup)
+			return rule.RuleListeners{
+				ast.KindIdentifier: func(node *ast.Node) {
+					time.Sleep(listener)
+				},
+			}
+		},
+	}
+}
+
+func TestRuleTimingsWrapAccumulatesPerRule(t *testing.T) {
+	timings := linter.NewRuleTimings()
+
+	// The same rule runs for every linted program, its time adds up
+	for range 2 {
+		listeners := timings.Wrap(sleepingRule("slow", 5*time.Millisecond, 10*time.Millisecond)).Run(rule.RuleContext{})
+		listeners[ast.KindIdentifier](nil)
+		listeners[ast.KindIdentifier](nil)
+	}
+	timings.Wrap(sleepingRule("unused", time.Millisecond, time.Millisecond))
+
+	sorted := timings.Sorted()
+	assert.Equal(t, len(sorted), 2)
+	assert.Equal(t, sorted[0].RuleName, "slow")
+	assert.Assert(t, sorted[0].Duration >= 50*time.Millisecond, "slow took %v", sorted[0].Duration)
+	// A rule that never ran took no time
+	assert.Equal(t, sorted[1].RuleName, "unused")
+	assert.Equal(t, sorted[1].Duration, time.Duration(0))
+}
+
+func TestRuleTimingsSorted(t *testing.T) {
+	timings := linter.NewRuleTimings()
+	for _, name := range []string{"b-never-run", "fast", "a-never-run", "slow"} {
+		timings.Wrap(sleepingRule(name, 0, 0))
+	}
+	timings.Wrap(sleepingRule("slow", 20*time.Millisecond, 0)).Run(rule.RuleContext{})
+	timings.Wrap(sleepingRule("fast", time.Millisecond, 0)).Run(rule.RuleContext{})
+
+	// The slowest rules come first, rules with the same time by name
+	var names []string
+	for _, timing := range timings.Sorted() {
+		names = append(names, timing.RuleName)
+	}
+	assert.DeepEqual(t, names, []string{"slow", "fast", "a-never-run", "b-never-run"})
+}
@@ -336,6 +336,9 @@ func runLintWithProjectService(uri lsproto.DocumentUri, service *project.Service
 		func(sourceFile *ast.SourceFile) []linter.ConfiguredRule {
 			activeRules := config.GlobalRuleRegistry.GetEnabledRules(rslintConfig, sourceFile.FileName())
 			return activeRules
+		},
+		func(sourceFile *ast.SourceFile) rule.DiagnosticSeverity {
+			return rslintConfig.GetReportUnusedDisableDirectivesForFile(sourceFile.FileName())
 		}, diagnosticCollector)
 
 	if diagnostics == nil {
@@ -4,6 +4,25 @@ import (
 	"github.com/web-infra-dev/rslint/internal/rule"
 )
 
+// recommendedRuleLevels holds the severity eslint-plugin-import's recommended config enables each of its rules
+// at, for the rules implemented here. No implemented rule is part of that config yet.
+var recommendedRuleLevels = map[string]string{}
+
 func GetRecommendedRules() []rule.Rule {
-	return []rule.Rule{}
+	rules := []rule.Rule{}
+	for _, r := range GetAllRules() {
+		if _, ok := recommendedRuleLevels[r.Name]; ok {
+			rules = append(rules, r)
+		}
+	}
+	return rules
+}
+
+// GetRecommendedRuleLevels returns the level each rule of the recommended config is enabled at
+func GetRecommendedRuleLevels() map[string]string {
+	levels := make(map[string]string)
+	for _, r := range GetRecommendedRules() {
+		levels[r.Name] = recommendedRuleLevels[r.Name]
+	}
+	return levels
 }
@@ -1,10 +1,29 @@
 package consistent_indexed_object_style
 
 import (
+	"strings"
+
 	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/scanner"
 	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
 )
 
+func buildPreferRecordMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "preferRecord",
+		Description: "A record is preferred over an index signature.",
+	}
+}
+
+func buildPreferIndexSignatureMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "preferIndexSignature",
+		Description: "An index signature is preferred over a record.",
+	}
+}
+
 type ConsistentIndexedObjectStyleOptions struct {
 	Style string `json:"style"`
 }
@@ -36,6 +55,33 @@ func run(ctx rule.RuleContext, options any) rule.RuleListeners {
 		}
 	}
 
+	getText := func(node *ast.Node) string {
+		textRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+		return ctx.SourceFile.Text()[textRange.Pos():textRange.End()]
+	}
+
+	// getRecordText returns the Record equivalent of an index signature, or false when it has no key or value type
+	getRecordText := func(member *ast.Node) (string, bool) {
+		indexSig := member.AsIndexSignatureDeclaration()
+		params := indexSig.Parameters.Nodes
+		if len(params) != 1 || params[0].Type() == nil || indexSig.Type == nil {
+			return "", false
+		}
+
+		record := "Record<" + getText(params[0].Type()) + ", " + getText(indexSig.Type) + ">"
+		if ast.HasSyntacticModifier(member, ast.ModifierFlagsReadonly) {
+			record = "Readonly<" + record + ">"
+		}
+		return record, true
+	}
+
+	// isMergedDeclaration checks whether the interface shares its name with other declarations, which a type
+	// alias can't merge with
+	isMergedDeclaration := func(node *ast.Node) bool {
+		symbol := ctx.TypeChecker.GetSymbolAtLocation(node.Name())
+		return symbol != nil && len(symbol.Declarations) > 1
+	}
+
 	return rule.RuleListeners{
 		// Check interfaces with index signatures
 		ast.KindInterfaceDeclaration: func(node *ast.Node) {
@@ -77,11 +123,36 @@ func run(ctx rule.RuleContext, options any) rule.RuleListeners {
 				return
 			}
 
-			// Report violation
-			ctx.ReportNode(node, rule.RuleMessage{
-				Id:          "preferRecord",
-				Description: "A record is preferred over an index signature.",
-			})
+			record, ok := getRecordText(member)
+			if !ok {
+				return
+			}
+
+			// `export default` can't be followed by a type alias
+			if ast.HasSyntacticModifier(node, ast.ModifierFlagsDefault) || isMergedDeclaration(node) {
+				ctx.ReportNode(node, buildPreferRecordMessage())
+				return
+			}
+
+			typeParameters := ""
+			if interfaceDecl.TypeParameters != nil && len(interfaceDecl.TypeParameters.Nodes) > 0 {
+				texts := make([]string, len(interfaceDecl.TypeParameters.Nodes))
+				for i, typeParameter := range interfaceDecl.TypeParameters.Nodes {
+					texts[i] = getText(typeParameter)
+				}
+				typeParameters = "<" + strings.Join(texts, ", ") + ">"
+			}
+
+			// Keep the modifiers, replacing everything from the `interface` keyword
+			keywordPos := node.Pos()
+			if modifiers := node.Modifiers(); modifiers != nil {
+				keywordPos = modifiers.End()
+			}
+			keyword := scanner.GetRangeOfTokenAtPosition(ctx.SourceFile, keywordPos)
+			ctx.ReportNodeWithFixes(node, buildPreferRecordMessage(), rule.RuleFixReplaceRange(
+				core.NewTextRange(keyword.Pos(), node.End()),
+				"type "+node.Name().Text()+typeParameters+" = "+record+";",
+			))
 		},
 
 		// Check type literals with index signatures
@@ -119,10 +190,12 @@ func run(ctx rule.RuleContext, options any) rule.RuleListeners {
 				return
 			}
 
-			ctx.ReportNode(node, rule.RuleMessage{
-				Id:          "preferRecord",
-				Description: "A record is preferred over an index signature.",
-			})
+			record, ok := getRecordText(member)
+			if !ok {
+				return
+			}
+
+			ctx.ReportNodeWithFixes(node, buildPreferRecordMessage(), rule.RuleFixReplace(ctx.SourceFile, node, record))
 		},
 
 		// Check mapped types
@@ -145,10 +218,29 @@ func run(ctx rule.RuleContext, options any) rule.RuleListeners {
 				return
 			}
 
-			ctx.ReportNode(node, rule.RuleMessage{
-				Id:          "preferRecord",
-				Description: "A record is preferred over an index signature.",
-			})
+			// `-readonly` has no Record equivalent
+			if mappedType.ReadonlyToken != nil && mappedType.ReadonlyToken.Kind == ast.KindMinusToken {
+				ctx.ReportNode(node, buildPreferRecordMessage())
+				return
+			}
+
+			value := "any"
+			if mappedType.Type != nil {
+				value = getText(mappedType.Type)
+			}
+			record := "Record<" + getText(mappedType.TypeParameter.AsTypeParameter().Constraint) + ", " + value + ">"
+			if mappedType.QuestionToken != nil {
+				if mappedType.QuestionToken.Kind == ast.KindMinusToken {
+					record = "Required<" + record + ">"
+				} else {
+					record = "Partial<" + record + ">"
+				}
+			}
+			if mappedType.ReadonlyToken != nil {
+				record = "Readonly<" + record + ">"
+			}
+
+			ctx.ReportNodeWithFixes(node, buildPreferRecordMessage(), rule.RuleFixReplace(ctx.SourceFile, node, record))
 		},
 
 		// Check Record types when in index-signature mode
@@ -171,10 +263,20 @@ func run(ctx rule.RuleContext, options any) rule.RuleListeners {
 				return
 			}
 
-			ctx.ReportNode(node, rule.RuleMessage{
-				Id:          "preferIndexSignature",
-				Description: "An index signature is preferred over a record.",
-			})
+			// Only keyword keys can be index signature parameters
+			key := typeRef.TypeArguments.Nodes[0]
+			switch key.Kind {
+			case ast.KindStringKeyword, ast.KindNumberKeyword, ast.KindSymbolKeyword:
+			default:
+				ctx.ReportNode(node, buildPreferIndexSignatureMessage())
+				return
+			}
+
+			ctx.ReportNodeWithFixes(node, buildPreferIndexSignatureMessage(), rule.RuleFixReplace(
+				ctx.SourceFile,
+				node,
+				"{ [key: "+getText(key)+"]: "+getText(typeRef.TypeArguments.Nodes[1])+" }",
+			))
 		},
 	}
 }
@@ -254,6 +356,11 @@ func canConvertMappedTypeToRecord(mappedType *ast.MappedTypeNode) bool {
 		return false
 	}
 
+	// Key remapping has no Record equivalent
+	if mappedType.NameType != nil {
+		return false
+	}
+
 	constraint := typeParam.Constraint
 	switch constraint.Kind {
 	case ast.KindStringKeyword, ast.KindNumberKeyword, ast.KindSymbolKeyword:
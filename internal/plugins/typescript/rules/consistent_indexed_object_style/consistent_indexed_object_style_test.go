@@ -69,25 +69,29 @@ func TestConsistentIndexedObjectStyleRule(t *testing.T) {
 	}, []rule_tester.InvalidTestCase{
 		// Default mode (prefer record) - interface with only index signature
 		{
-			Code: "interface Foo { [key: string]: any; }",
+			Code:   "interface Foo { [key: string]: any; }",
+			Output: []string{"type Foo = Record<string, any>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "interface Foo { [key: string]: unknown; }",
+			Code:   "interface Foo { [key: string]: unknown; }",
+			Output: []string{"type Foo = Record<string, unknown>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "interface Foo { [key: number]: any; }",
+			Code:   "interface Foo { [key: number]: any; }",
+			Output: []string{"type Foo = Record<number, any>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "interface Foo { readonly [key: string]: any; }",
+			Code:   "interface Foo { readonly [key: string]: any; }",
+			Output: []string{"type Foo = Readonly<Record<string, any>>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
@@ -95,25 +99,29 @@ func TestConsistentIndexedObjectStyleRule(t *testing.T) {
 
 		// Type literals with only index signature
 		{
-			Code: "type Foo = { [key: string]: any };",
+			Code:   "type Foo = { [key: string]: any };",
+			Output: []string{"type Foo = Record<string, any>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "type Foo = { [key: string]: unknown };",
+			Code:   "type Foo = { [key: string]: unknown };",
+			Output: []string{"type Foo = Record<string, unknown>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "type Foo = { [key: number]: any };",
+			Code:   "type Foo = { [key: number]: any };",
+			Output: []string{"type Foo = Record<number, any>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "type Foo = { readonly [key: string]: any };",
+			Code:   "type Foo = { readonly [key: string]: any };",
+			Output: []string{"type Foo = Readonly<Record<string, any>>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
@@ -121,25 +129,29 @@ func TestConsistentIndexedObjectStyleRule(t *testing.T) {
 
 		// Mapped types that can be converted
 		{
-			Code: "type Foo = { [K in string]: any };",
+			Code:   "type Foo = { [K in string]: any };",
+			Output: []string{"type Foo = Record<string, any>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "type Foo = { [K in number]: any };",
+			Code:   "type Foo = { [K in number]: any };",
+			Output: []string{"type Foo = Record<number, any>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "type Foo = { readonly [K in string]: any };",
+			Code:   "type Foo = { readonly [K in string]: any };",
+			Output: []string{"type Foo = Readonly<Record<string, any>>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "type Foo = { [K in string]?: any };",
+			Code:   "type Foo = { [K in string]?: any };",
+			Output: []string{"type Foo = Partial<Record<string, any>>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
@@ -147,13 +159,15 @@ func TestConsistentIndexedObjectStyleRule(t *testing.T) {
 
 		// Generic interfaces with only index signature
 		{
-			Code: "interface Foo<T> { [key: string]: T; }",
+			Code:   "interface Foo<T> { [key: string]: T; }",
+			Output: []string{"type Foo<T> = Record<string, T>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "interface Foo<T, K> { [key: string]: T | K; }",
+			Code:   "interface Foo<T, K> { [key: string]: T | K; }",
+			Output: []string{"type Foo<T, K> = Record<string, T | K>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
@@ -162,6 +176,7 @@ func TestConsistentIndexedObjectStyleRule(t *testing.T) {
 		// Index-signature mode (prefer index-signature)
 		{
 			Code:    "type Foo = Record<string, any>;",
+			Output:  []string{"type Foo = { [key: string]: any };"},
 			Options: "index-signature",
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferIndexSignature"},
@@ -169,6 +184,7 @@ func TestConsistentIndexedObjectStyleRule(t *testing.T) {
 		},
 		{
 			Code:    "type Foo = Record<string, unknown>;",
+			Output:  []string{"type Foo = { [key: string]: unknown };"},
 			Options: "index-signature",
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferIndexSignature"},
@@ -176,6 +192,7 @@ func TestConsistentIndexedObjectStyleRule(t *testing.T) {
 		},
 		{
 			Code:    "type Foo = Record<number, any>;",
+			Output:  []string{"type Foo = { [key: number]: any };"},
 			Options: "index-signature",
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferIndexSignature"},
@@ -183,6 +200,7 @@ func TestConsistentIndexedObjectStyleRule(t *testing.T) {
 		},
 		{
 			Code:    "type Foo = Record<symbol, any>;",
+			Output:  []string{"type Foo = { [key: symbol]: any };"},
 			Options: "index-signature",
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferIndexSignature"},
@@ -197,6 +215,7 @@ func TestConsistentIndexedObjectStyleRule(t *testing.T) {
 		},
 		{
 			Code:    "type Foo<T> = Record<string, T>;",
+			Output:  []string{"type Foo<T> = { [key: string]: T };"},
 			Options: "index-signature",
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferIndexSignature"},
@@ -205,13 +224,15 @@ func TestConsistentIndexedObjectStyleRule(t *testing.T) {
 
 		// Nested in other types
 		{
-			Code: "type Foo = Array<{ [key: string]: any }>;",
+			Code:   "type Foo = Array<{ [key: string]: any }>;",
+			Output: []string{"type Foo = Array<Record<string, any>>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "type Foo = { prop: { [key: string]: any } };",
+			Code:   "type Foo = { prop: { [key: string]: any } };",
+			Output: []string{"type Foo = { prop: Record<string, any> };"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
@@ -219,19 +240,22 @@ func TestConsistentIndexedObjectStyleRule(t *testing.T) {
 
 		// In function signatures
 		{
-			Code: "function foo(arg: { [key: string]: any }) {}",
+			Code:   "function foo(arg: { [key: string]: any }) {}",
+			Output: []string{"function foo(arg: Record<string, any>) {}"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "const foo = (arg: { [key: string]: any }) => {};",
+			Code:   "const foo = (arg: { [key: string]: any }) => {};",
+			Output: []string{"const foo = (arg: Record<string, any>) => {};"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "function foo(): { [key: string]: any } { return {}; }",
+			Code:   "function foo(): { [key: string]: any } { return {}; }",
+			Output: []string{"function foo(): Record<string, any> { return {}; }"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
@@ -239,13 +263,15 @@ func TestConsistentIndexedObjectStyleRule(t *testing.T) {
 
 		// With comments
 		{
-			Code: "interface Foo { /* comment */ [key: string]: any; }",
+			Code:   "interface Foo { /* comment */ [key: string]: any; }",
+			Output: []string{"type Foo = Record<string, any>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "type Foo = { /* comment */ [key: string]: any };",
+			Code:   "type Foo = { /* comment */ [key: string]: any };",
+			Output: []string{"type Foo = Record<string, any>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
@@ -253,22 +279,72 @@ func TestConsistentIndexedObjectStyleRule(t *testing.T) {
 
 		// Complex value types
 		{
-			Code: "type Foo = { [key: string]: string | number };",
+			Code:   "type Foo = { [key: string]: string | number };",
+			Output: []string{"type Foo = Record<string, string | number>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "type Foo = { [key: string]: { nested: string } };",
+			Code:   "type Foo = { [key: string]: { nested: string } };",
+			Output: []string{"type Foo = Record<string, { nested: string }>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
 		{
-			Code: "interface Foo { [key: string]: Array<string> }",
+			Code:   "interface Foo { [key: string]: Array<string> }",
+			Output: []string{"type Foo = Record<string, Array<string>>;"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "preferRecord"},
 			},
 		},
+
+		// Only the Readonly wrapper is kept from the modifiers
+		{
+			Code:   "type Foo = { readonly [key: string]: number };",
+			Output: []string{"type Foo = Readonly<Record<string, number>>;"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferRecord", Line: 1, Column: 12},
+			},
+		},
+		{
+			Code:   "export interface Foo { readonly [key: string]: number; }",
+			Output: []string{"export type Foo = Readonly<Record<string, number>>;"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferRecord", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code: "type Foo = { -readonly [K in string]-?: number };",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferRecord", Line: 1, Column: 12},
+			},
+		},
+		{
+			Code:   "type Foo = { [K in string]-?: number };",
+			Output: []string{"type Foo = Required<Record<string, number>>;"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferRecord", Line: 1, Column: 12},
+			},
+		},
+
+		// Interfaces that can't become type aliases are reported without a fix
+		{
+			Code: `
+interface Foo { [key: string]: number; }
+interface Foo { [key: string]: number; }
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferRecord", Line: 2, Column: 1},
+				{MessageId: "preferRecord", Line: 3, Column: 1},
+			},
+		},
+		{
+			Code: "export default interface Foo { [key: string]: number; }",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferRecord", Line: 1, Column: 1},
+			},
+		},
 	})
 }
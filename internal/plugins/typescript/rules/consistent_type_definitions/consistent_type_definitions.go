@@ -1,10 +1,29 @@
 package consistent_type_definitions
 
 import (
+	"strings"
+
 	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/scanner"
 	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
 )
 
+func buildInterfaceOverTypeMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "interfaceOverType",
+		Description: "Use an interface instead of a type literal.",
+	}
+}
+
+func buildTypeOverInterfaceMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "typeOverInterface",
+		Description: "Use a type literal instead of an interface.",
+	}
+}
+
 type DefinitionStyle string
 
 const (
@@ -101,6 +120,24 @@ func run(ctx rule.RuleContext, options any) rule.RuleListeners {
 		return false
 	}
 
+	// Helper to get the range of the `type` or `interface` keyword, which follows the modifiers
+	getKeywordRange := func(node *ast.Node) core.TextRange {
+		pos := node.Pos()
+		if modifiers := node.Modifiers(); modifiers != nil {
+			pos = modifiers.End()
+		}
+		return scanner.GetRangeOfTokenAtPosition(ctx.SourceFile, pos)
+	}
+
+	// Helper to get the end of the declaration name, including its type parameters
+	getHeaderEnd := func(node *ast.Node, typeParameters *ast.NodeList) int {
+		if typeParameters == nil {
+			return node.Name().End()
+		}
+		// The type parameter list doesn't include the closing `>`
+		return scanner.GetRangeOfTokenAtPosition(ctx.SourceFile, typeParameters.End()).End()
+	}
+
 	checkTypeAlias := func(node *ast.Node) {
 		if opts.Style != DefinitionStyleInterface {
 			return
@@ -116,10 +153,23 @@ func run(ctx rule.RuleContext, options any) rule.RuleListeners {
 			return
 		}
 
-		ctx.ReportNode(node, rule.RuleMessage{
-			Id:          "interfaceOverType",
-			Description: "Use an interface instead of a type literal.",
-		})
+		typeLiteral := typeAlias.Type
+		for typeLiteral.Kind == ast.KindParenthesizedType {
+			typeLiteral = typeLiteral.AsParenthesizedTypeNode().Type
+		}
+		typeLiteralRange := utils.TrimNodeTextRange(ctx.SourceFile, typeLiteral)
+
+		fixes := []rule.RuleFix{
+			rule.RuleFixReplaceRange(getKeywordRange(node), "interface"),
+			// `type T<U> = ({` -> `interface T<U> {`
+			rule.RuleFixReplaceRange(core.NewTextRange(getHeaderEnd(node, typeAlias.TypeParameters), typeLiteralRange.Pos()), " "),
+		}
+		// Drop the closing parentheses and the semicolon
+		if typeLiteralRange.End() < node.End() {
+			fixes = append(fixes, rule.RuleFixRemoveRange(core.NewTextRange(typeLiteralRange.End(), node.End())))
+		}
+
+		ctx.ReportNodeWithFixes(node, buildInterfaceOverTypeMessage(), fixes...)
 	}
 
 	checkInterface := func(node *ast.Node) {
@@ -134,17 +184,47 @@ func run(ctx rule.RuleContext, options any) rule.RuleListeners {
 
 		// Don't fix interfaces in global modules (see typescript-eslint #2707)
 		if isInGlobalModule(node) {
-			ctx.ReportNode(node, rule.RuleMessage{
-				Id:          "typeOverInterface",
-				Description: "Use a type literal instead of an interface.",
-			})
+			ctx.ReportNode(node, buildTypeOverInterfaceMessage())
 			return
 		}
 
-		ctx.ReportNode(node, rule.RuleMessage{
-			Id:          "typeOverInterface",
-			Description: "Use a type literal instead of an interface.",
-		})
+		headerEnd := getHeaderEnd(node, interfaceDecl.TypeParameters)
+		bodyStart := headerEnd
+		if interfaceDecl.HeritageClauses != nil {
+			bodyStart = interfaceDecl.HeritageClauses.End()
+		}
+		openBrace := scanner.GetRangeOfTokenAtPosition(ctx.SourceFile, bodyStart)
+
+		fixes := []rule.RuleFix{
+			rule.RuleFixReplaceRange(getKeywordRange(node), "type"),
+			// `interface T<U> extends A {` -> `type T<U> = {`
+			rule.RuleFixReplaceRange(core.NewTextRange(headerEnd, openBrace.Pos()), " = "),
+		}
+
+		// Heritage clauses become intersections, i.e. `{ ... } & A & B`
+		var suffix strings.Builder
+		if interfaceDecl.HeritageClauses != nil {
+			for _, clause := range interfaceDecl.HeritageClauses.Nodes {
+				for _, heritage := range clause.AsHeritageClause().Types.Nodes {
+					heritageRange := utils.TrimNodeTextRange(ctx.SourceFile, heritage)
+					suffix.WriteString(" & ")
+					suffix.WriteString(ctx.SourceFile.Text()[heritageRange.Pos():heritageRange.End()])
+				}
+			}
+		}
+
+		// A type alias can't be exported as default in the same statement
+		if ast.HasSyntacticModifier(node, ast.ModifierFlagsDefault) {
+			modifiersRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			fixes = append(fixes, rule.RuleFixRemoveRange(core.NewTextRange(modifiersRange.Pos(), getKeywordRange(node).Pos())))
+			suffix.WriteString("\nexport default " + node.Name().Text())
+		}
+
+		if suffix.Len() > 0 {
+			fixes = append(fixes, rule.RuleFixReplaceRange(core.NewTextRange(node.End(), node.End()), suffix.String()))
+		}
+
+		ctx.ReportNodeWithFixes(node, buildTypeOverInterfaceMessage(), fixes...)
 	}
 
 	return rule.RuleListeners{
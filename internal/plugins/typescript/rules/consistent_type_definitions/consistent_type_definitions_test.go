@@ -41,67 +41,85 @@ func TestConsistentTypeDefinitionsRule(t *testing.T) {
 	}, []rule_tester.InvalidTestCase{
 		// Default options (style: 'interface') - expect type to be interface
 		{
-			Code: `type T = { x: number; };`,
+			Code:   `type T = { x: number; };`,
+			Output: []string{`interface T { x: number; }`},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "interfaceOverType"},
 			},
 		},
 		{
-			Code: `type T={ x: number; };`,
+			Code:   `type T={ x: number; };`,
+			Output: []string{`interface T { x: number; }`},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "interfaceOverType"},
 			},
 		},
 		{
-			Code: `type T= { x: number; };`,
+			Code:   `type T= { x: number; };`,
+			Output: []string{`interface T { x: number; }`},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "interfaceOverType"},
 			},
 		},
 		{
-			Code: `type T = { x: number };`,
+			Code:   `type T = { x: number };`,
+			Output: []string{`interface T { x: number }`},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "interfaceOverType"},
 			},
 		},
 		{
-			Code: `type T = { x: number; y: string; };`,
+			Code:   `type T = { x: number; y: string; };`,
+			Output: []string{`interface T { x: number; y: string; }`},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "interfaceOverType"},
 			},
 		},
 		{
-			Code: `type T = { x: number; y: { z: string; }; };`,
+			Code:   `type T = { x: number; y: { z: string; }; };`,
+			Output: []string{`interface T { x: number; y: { z: string; }; }`},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "interfaceOverType"},
 			},
 		},
 		{
-			Code: `export type W<T> = { x: T; };`,
+			Code:   `export type W<T> = { x: T; };`,
+			Output: []string{`export interface W<T> { x: T; }`},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "interfaceOverType"},
 			},
 		},
 		{
-			Code: `type T<U> = { x: U; };`,
+			Code:   `type T<U> = { x: U; };`,
+			Output: []string{`interface T<U> { x: U; }`},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "interfaceOverType"},
 			},
 		},
 		{
-			Code: `type Foo = { a: string; };`,
+			Code:   `type Foo = { a: string; };`,
+			Output: []string{`interface Foo { a: string; }`},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "interfaceOverType"},
 			},
 		},
 		{
-			Code: `type Foo = ({ a: string; });`,
+			Code:   `type Foo = ({ a: string; });`,
+			Output: []string{`interface Foo { a: string; }`},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "interfaceOverType"},
 			},
 		},
 		{
-			Code: `type Foo = (  { a: string; });`,
+			Code:   `type Foo = (  { a: string; });`,
+			Output: []string{`interface Foo { a: string; }`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "interfaceOverType"},
+			},
+		},
+		{
+			Code:   `type Box<T = string> = { value: T };`,
+			Output: []string{`interface Box<T = string> { value: T }`},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "interfaceOverType"},
 			},
@@ -110,6 +128,7 @@ func TestConsistentTypeDefinitionsRule(t *testing.T) {
 		// style: 'type' - expect interface to be type
 		{
 			Code:    `interface T { x: number; }`,
+			Output:  []string{`type T = { x: number; }`},
 			Options: []interface{}{"type"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "typeOverInterface"},
@@ -117,6 +136,7 @@ func TestConsistentTypeDefinitionsRule(t *testing.T) {
 		},
 		{
 			Code:    `interface T { x: number }`,
+			Output:  []string{`type T = { x: number }`},
 			Options: []interface{}{"type"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "typeOverInterface"},
@@ -124,6 +144,7 @@ func TestConsistentTypeDefinitionsRule(t *testing.T) {
 		},
 		{
 			Code:    `interface T { x: number; y: string; }`,
+			Output:  []string{`type T = { x: number; y: string; }`},
 			Options: []interface{}{"type"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "typeOverInterface"},
@@ -131,6 +152,7 @@ func TestConsistentTypeDefinitionsRule(t *testing.T) {
 		},
 		{
 			Code:    `interface A extends B, C { x: number; };`,
+			Output:  []string{`type A = { x: number; } & B & C;`},
 			Options: []interface{}{"type"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "typeOverInterface"},
@@ -138,6 +160,7 @@ func TestConsistentTypeDefinitionsRule(t *testing.T) {
 		},
 		{
 			Code:    `interface A extends B<T1>, C<T2> { x: number; };`,
+			Output:  []string{`type A = { x: number; } & B<T1> & C<T2>;`},
 			Options: []interface{}{"type"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "typeOverInterface"},
@@ -145,6 +168,7 @@ func TestConsistentTypeDefinitionsRule(t *testing.T) {
 		},
 		{
 			Code:    `export interface W<T> { x: T; };`,
+			Output:  []string{`export type W<T> = { x: T; };`},
 			Options: []interface{}{"type"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "typeOverInterface"},
@@ -152,6 +176,7 @@ func TestConsistentTypeDefinitionsRule(t *testing.T) {
 		},
 		{
 			Code:    `interface T<U> { x: U; };`,
+			Output:  []string{`type T<U> = { x: U; };`},
 			Options: []interface{}{"type"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "typeOverInterface"},
@@ -159,6 +184,7 @@ func TestConsistentTypeDefinitionsRule(t *testing.T) {
 		},
 		{
 			Code:    `interface Foo { a: string; }`,
+			Output:  []string{`type Foo = { a: string; }`},
 			Options: []interface{}{"type"},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "typeOverInterface"},
@@ -166,7 +192,25 @@ func TestConsistentTypeDefinitionsRule(t *testing.T) {
 		},
 		{
 			Code:    `namespace Foo { export interface Bar {} }`,
+			Output:  []string{`namespace Foo { export type Bar = {} }`},
+			Options: []interface{}{"type"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "typeOverInterface"},
+			},
+		},
+		{
+			Code:    `interface Foo<T extends object> extends Bar<T> { baz: T; }`,
+			Options: []interface{}{"type"},
+			Output:  []string{`type Foo<T extends object> = { baz: T; } & Bar<T>`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "typeOverInterface"},
+			},
+		},
+		{
+			Code:    `export default interface Test { bar(): string; foo: number; }`,
 			Options: []interface{}{"type"},
+			Output: []string{`type Test = { bar(): string; foo: number; }
+export default Test`},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "typeOverInterface"},
 			},
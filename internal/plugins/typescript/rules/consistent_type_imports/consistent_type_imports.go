@@ -1,8 +1,13 @@
 package consistent_type_imports
 
 import (
+	"slices"
+	"strings"
+
 	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
 	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
 )
 
 type ConsistentTypeImportsOptions struct {
@@ -11,6 +16,124 @@ type ConsistentTypeImportsOptions struct {
 	FixStyle                string `json:"fixStyle"`
 }
 
+// importBinding tracks how a single imported name is referenced in the file
+type importBinding struct {
+	specifier  *ast.Node
+	name       string
+	symbol     *ast.Symbol
+	target     *ast.Symbol
+	typeRefs   int
+	valueRefs  int
+	declaredBy *ast.Node
+}
+
+// valueImportReport describes a value import declaration with bindings only used as types
+type valueImportReport struct {
+	node             *ast.Node
+	typeSpecifiers   []*ast.Node
+	valueSpecifiers  []*ast.Node
+	unusedSpecifiers []*ast.Node
+}
+
+// importSpecifiers splits the bindings of an import declaration by kind. The default
+// specifier is represented by its name, the others by their declaration nodes.
+type importSpecifiers struct {
+	defaultSpecifier   *ast.Node
+	namespaceSpecifier *ast.Node
+	namedSpecifiers    []*ast.Node
+}
+
+func (s importSpecifiers) all() []*ast.Node {
+	var specifiers []*ast.Node
+	if s.defaultSpecifier != nil {
+		specifiers = append(specifiers, s.defaultSpecifier)
+	}
+	if s.namespaceSpecifier != nil {
+		specifiers = append(specifiers, s.namespaceSpecifier)
+	}
+	return append(specifiers, s.namedSpecifiers...)
+}
+
+func classifySpecifiers(node *ast.Node) importSpecifiers {
+	var specifiers importSpecifiers
+	clauseNode := node.AsImportDeclaration().ImportClause
+	if clauseNode == nil {
+		return specifiers
+	}
+	specifiers.defaultSpecifier = clauseNode.Name()
+	namedBindings := clauseNode.AsImportClause().NamedBindings
+	if namedBindings == nil {
+		return specifiers
+	}
+	if namedBindings.Kind == ast.KindNamespaceImport {
+		specifiers.namespaceSpecifier = namedBindings
+	} else {
+		specifiers.namedSpecifiers = namedBindings.AsNamedImports().Elements.Nodes
+	}
+	return specifiers
+}
+
+// getLocalName returns the name a specifier binds in the file
+func getLocalName(specifier *ast.Node) string {
+	if specifier.Kind == ast.KindIdentifier {
+		return specifier.Text()
+	}
+	return specifier.Name().Text()
+}
+
+func isInlineTypeSpecifier(specifier *ast.Node) bool {
+	return specifier.Kind == ast.KindImportSpecifier && specifier.AsImportSpecifier().IsTypeOnly
+}
+
+// formatWordList joins words like `"A", "B", and "C"`
+func formatWordList(words []string) string {
+	switch len(words) {
+	case 0:
+		return ""
+	case 1:
+		return words[0]
+	case 2:
+		return words[0] + " and " + words[1]
+	}
+	return strings.Join(words[:len(words)-1], ", ") + ", and " + words[len(words)-1]
+}
+
+// Message builders
+func buildTypeOverValueMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "typeOverValue",
+		Description: "All imports in the declaration are only used as types. Use `import type`.",
+	}
+}
+
+func buildAImportIsOnlyTypesMessage(typeImports string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "aImportIsOnlyTypes",
+		Description: "Import " + typeImports + " is only used as types.",
+	}
+}
+
+func buildSomeImportsAreOnlyTypesMessage(typeImports string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "someImportsAreOnlyTypes",
+		Description: "Imports " + typeImports + " are only used as type.",
+	}
+}
+
+func buildAvoidImportTypeMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "avoidImportType",
+		Description: "Use an `import` instead of an `import type`.",
+	}
+}
+
+func buildNoImportTypeAnnotationsMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "noImportTypeAnnotations",
+		Description: "`import()` type annotations are forbidden.",
+	}
+}
+
 // ConsistentTypeImportsRule enforces consistent type imports
 var ConsistentTypeImportsRule = rule.CreateRule(rule.Rule{
 	Name: "consistent-type-imports",
@@ -51,56 +174,438 @@ func run(ctx rule.RuleContext, options any) rule.RuleListeners {
 		}
 	}
 
-	checkImportDeclaration := func(node *ast.Node) {
+	text := ctx.SourceFile.Text()
+
+	getText := func(node *ast.Node) string {
+		r := utils.TrimNodeTextRange(ctx.SourceFile, node)
+		return text[r.Pos():r.End()]
+	}
+
+	// skipWhitespace returns the first position at or after pos that is not whitespace
+	skipWhitespace := func(pos int) int {
+		for pos < len(text) && strings.ContainsRune(" \t\r\n", rune(text[pos])) {
+			pos++
+		}
+		return pos
+	}
+
+	insertAt := func(pos int, insertText string) rule.RuleFix {
+		return rule.RuleFixReplaceRange(core.NewTextRange(pos, pos), insertText)
+	}
+
+	// importKeywordEnd returns the position right after the `import` keyword
+	importKeywordEnd := func(node *ast.Node) int {
+		return utils.TrimNodeTextRange(ctx.SourceFile, node).Pos() + len("import")
+	}
+
+	// removeLeadingTypeKeyword removes `type ` from `import type X` or `{ type X }`
+	removeLeadingTypeKeyword := func(node *ast.Node) rule.RuleFix {
+		typeToken := ctx.GetFirstToken(node)
+		return rule.RuleFixRemoveRange(core.NewTextRange(typeToken.Range.Pos(), skipWhitespace(typeToken.Range.End())))
+	}
+
+	checkTSImportType := func(node *ast.Node) {
+		ctx.ReportNode(node, buildNoImportTypeAnnotationsMessage())
+	}
+
+	if opts.Prefer == "no-type-imports" {
+		listeners := rule.RuleListeners{
+			ast.KindImportDeclaration: func(node *ast.Node) {
+				clauseNode := node.AsImportDeclaration().ImportClause
+				if clauseNode == nil || !clauseNode.AsImportClause().IsTypeOnly {
+					return
+				}
+				ctx.ReportNodeWithFixes(node, buildAvoidImportTypeMessage(), removeLeadingTypeKeyword(clauseNode))
+			},
+			ast.KindImportSpecifier: func(node *ast.Node) {
+				if !node.AsImportSpecifier().IsTypeOnly {
+					return
+				}
+				ctx.ReportNodeWithFixes(node, buildAvoidImportTypeMessage(), removeLeadingTypeKeyword(node))
+			},
+		}
+		if opts.DisallowTypeAnnotations {
+			listeners[ast.KindImportType] = checkTSImportType
+		}
+		return listeners
+	}
+
+	// fixInsertTypeSpecifierForImportDeclaration turns the whole declaration into `import type`
+	fixInsertTypeSpecifierForImportDeclaration := func(node *ast.Node, isDefaultImport bool) []rule.RuleFix {
+		fixes := []rule.RuleFix{insertAt(importKeywordEnd(node), " type")}
 		importDecl := node.AsImportDeclaration()
-		if importDecl == nil {
-			return
+		clause := importDecl.ImportClause.AsImportClause()
+		if isDefaultImport && clause.NamedBindings != nil && clause.NamedBindings.Kind == ast.KindNamedImports {
+			// import type Foo, {} from 'foo'
+			//                ^^^^ remove
+			commaToken := ctx.GetTokenAfter(importDecl.ImportClause.Name())
+			namedRange := utils.TrimNodeTextRange(ctx.SourceFile, clause.NamedBindings)
+			fixes = append(fixes, rule.RuleFixRemoveRange(core.NewTextRange(commaToken.Range.Pos(), namedRange.End())))
+			if len(clause.NamedBindings.AsNamedImports().Elements.Nodes) > 0 {
+				specifiersText := text[commaToken.Range.End():namedRange.End()]
+				fixes = append(fixes, rule.RuleFixInsertAfter(node, "\nimport type"+specifiersText+" from "+getText(importDecl.ModuleSpecifier)+";"))
+			}
 		}
+		// Don't produce `import type { type T } from 'foo'`
+		for _, specifier := range classifySpecifiers(node).namedSpecifiers {
+			if isInlineTypeSpecifier(specifier) {
+				fixes = append(fixes, removeLeadingTypeKeyword(specifier))
+			}
+		}
+		return fixes
+	}
 
-		importClauseNode := importDecl.ImportClause
-		if importClauseNode == nil {
-			return
+	// fixInlineTypeImportDeclaration adds inline `type` modifiers to the named type specifiers
+	fixInlineTypeImportDeclaration := func(report valueImportReport) []rule.RuleFix {
+		var fixes []rule.RuleFix
+		for _, specifier := range classifySpecifiers(report.node).namedSpecifiers {
+			if slices.Contains(report.typeSpecifiers, specifier) {
+				fixes = append(fixes, rule.RuleFixInsertBefore(ctx.SourceFile, specifier, "type "))
+			}
 		}
+		return fixes
+	}
 
-		importClause := importClauseNode.AsImportClause()
-		if importClause == nil {
-			return
+	// getFixesNamedSpecifiers removes the given named specifiers from the declaration and
+	// returns their text for a new `import type` declaration
+	getFixesNamedSpecifiers := func(node *ast.Node, subset []*ast.Node, all []*ast.Node) ([]rule.RuleFix, string) {
+		if len(all) == 0 || len(subset) == 0 {
+			return nil, ""
+		}
+		if len(subset) == len(all) {
+			// import Foo, {Type1, Type2} from 'foo'
+			//           ^^^^^^^^^^^^^^^^ remove
+			namedBindings := node.AsImportDeclaration().ImportClause.AsImportClause().NamedBindings
+			namedRange := utils.TrimNodeTextRange(ctx.SourceFile, namedBindings)
+			commaToken := ctx.GetTokenBefore(namedBindings)
+			removeFix := rule.RuleFixRemoveRange(core.NewTextRange(commaToken.Range.Pos(), namedRange.End()))
+			return []rule.RuleFix{removeFix}, text[namedRange.Pos()+1 : namedRange.End()-1]
 		}
 
-		// Skip if entire import is already type-only
-		if importClause.IsTypeOnly {
-			// If prefer is 'no-type-imports', report error
-			if opts.Prefer == "no-type-imports" {
-				ctx.ReportNode(node, rule.RuleMessage{
-					Id:          "avoidImportType",
-					Description: "Use regular imports instead of import type.",
-				})
+		// Group adjacent type specifiers so each group can be removed with its commas
+		var groups [][]*ast.Node
+		var group []*ast.Node
+		for _, specifier := range all {
+			if slices.Contains(subset, specifier) {
+				group = append(group, specifier)
+			} else if len(group) > 0 {
+				groups = append(groups, group)
+				group = nil
 			}
-			return
+		}
+		if len(group) > 0 {
+			groups = append(groups, group)
 		}
 
-		// For now, implement basic check: if prefer is 'type-imports',
-		// we need to analyze imports to see if they're only used in type positions
-		// This is a simplified version - a full implementation would require
-		// tracking all references to imported symbols throughout the file
+		var fixes []rule.RuleFix
+		var texts []string
+		for _, group := range groups {
+			first := group[0]
+			last := group[len(group)-1]
 
+			before := ctx.GetTokenBefore(first)
+			textStart := before.Range.End()
+			removeStart := before.Range.End()
+			if before.Kind == ast.KindCommaToken {
+				removeStart = before.Range.Pos()
+			}
+			removeEnd := last.End()
+			after := ctx.GetTokenAfter(last)
+			textEnd := after.Range.Pos()
+			if (all[0] == first || all[len(all)-1] == last) && after.Kind == ast.KindCommaToken {
+				removeEnd = after.Range.End()
+			}
+
+			fixes = append(fixes, rule.RuleFixRemoveRange(core.NewTextRange(removeStart, removeEnd)))
+			texts = append(texts, text[textStart:textEnd])
+		}
+		return fixes, strings.Join(texts, ",")
 	}
 
-	checkTSImportType := func(node *ast.Node) {
+	// fixInsertNamedSpecifiersInNamedSpecifierList appends specifiers to an existing `import type { ... }`
+	fixInsertNamedSpecifiersInNamedSpecifierList := func(target *ast.Node, insertText string) rule.RuleFix {
+		namedBindings := target.AsImportDeclaration().ImportClause.AsImportClause().NamedBindings
+		closingBracePos := utils.TrimNodeTextRange(ctx.SourceFile, namedBindings).End() - 1
+		if elements := namedBindings.AsNamedImports().Elements.Nodes; len(elements) > 0 {
+			if after := ctx.GetTokenAfter(elements[len(elements)-1]); after.Kind != ast.KindCommaToken {
+				insertText = "," + insertText
+			}
+		}
+		return insertAt(closingBracePos, insertText)
+	}
+
+	fixToTypeImportDeclaration := func(report valueImportReport, typeOnlyNamedImport *ast.Node) []rule.RuleFix {
+		node := report.node
+		importDecl := node.AsImportDeclaration()
+		specifiers := classifySpecifiers(node)
+		isTypeSpecifier := func(specifier *ast.Node) bool {
+			return slices.Contains(report.typeSpecifiers, specifier)
+		}
+
+		if specifiers.namespaceSpecifier != nil && specifiers.defaultSpecifier == nil {
+			// import * as types from 'foo'
+			return fixInsertTypeSpecifierForImportDeclaration(node, false)
+		}
+		if specifiers.defaultSpecifier != nil {
+			if isTypeSpecifier(specifiers.defaultSpecifier) && len(specifiers.namedSpecifiers) == 0 && specifiers.namespaceSpecifier == nil {
+				// import Type from 'foo'
+				return fixInsertTypeSpecifierForImportDeclaration(node, true)
+			}
+			if opts.FixStyle == "inline-type-imports" && !isTypeSpecifier(specifiers.defaultSpecifier) &&
+				len(specifiers.namedSpecifiers) > 0 && specifiers.namespaceSpecifier == nil {
+				// import AValue, {BValue, Type1, Type2} from 'foo'
+				return fixInlineTypeImportDeclaration(report)
+			}
+		} else if specifiers.namespaceSpecifier == nil {
+			if opts.FixStyle == "inline-type-imports" && utils.Some(specifiers.namedSpecifiers, isTypeSpecifier) {
+				// import {AValue, Type1, Type2} from 'foo'
+				return fixInlineTypeImportDeclaration(report)
+			}
+			if utils.Every(specifiers.namedSpecifiers, func(specifier *ast.Node) bool {
+				return isTypeSpecifier(specifier) || isInlineTypeSpecifier(specifier)
+			}) {
+				// import {Type1, Type2} from 'foo'
+				return fixInsertTypeSpecifierForImportDeclaration(node, false)
+			}
+		}
+
+		source := getText(importDecl.ModuleSpecifier)
+		typeNamedSpecifiers := utils.Filter(specifiers.namedSpecifiers, isTypeSpecifier)
+		removeNamedFixes, typeNamedSpecifiersText := getFixesNamedSpecifiers(node, typeNamedSpecifiers, specifiers.namedSpecifiers)
+
+		var fixes []rule.RuleFix
+		// Declarations inserted before the import are merged so their order is deterministic
+		var prefix strings.Builder
+		if len(typeNamedSpecifiers) > 0 {
+			if typeOnlyNamedImport != nil {
+				fixes = append(fixes, fixInsertNamedSpecifiersInNamedSpecifierList(typeOnlyNamedImport, typeNamedSpecifiersText))
+			} else if opts.FixStyle == "inline-type-imports" {
+				texts := make([]string, 0, len(typeNamedSpecifiers))
+				for _, specifier := range typeNamedSpecifiers {
+					texts = append(texts, "type "+getText(specifier))
+				}
+				prefix.WriteString("import {" + strings.Join(texts, ", ") + "} from " + source + ";\n")
+			} else {
+				prefix.WriteString("import type {" + typeNamedSpecifiersText + "} from " + source + ";\n")
+			}
+		}
+
+		if specifiers.namespaceSpecifier != nil && isTypeSpecifier(specifiers.namespaceSpecifier) {
+			// import Foo, * as Type from 'foo'
+			//           ^^^^^^^^^^^ remove
+			commaToken := ctx.GetTokenBefore(specifiers.namespaceSpecifier)
+			fixes = append(fixes, rule.RuleFixRemoveRange(core.NewTextRange(commaToken.Range.Pos(), specifiers.namespaceSpecifier.End())))
+			prefix.WriteString("import type " + getText(specifiers.namespaceSpecifier) + " from " + source + ";\n")
+		}
+
+		if specifiers.defaultSpecifier != nil && isTypeSpecifier(specifiers.defaultSpecifier) {
+			if len(report.typeSpecifiers) == len(specifiers.all()) {
+				// import type Type from 'foo'
+				fixes = append(fixes, insertAt(importKeywordEnd(node), " type"))
+			} else {
+				// import Type, {...} from 'foo'
+				//        ^^^^^^ remove
+				commaToken := ctx.GetTokenAfter(specifiers.defaultSpecifier)
+				defaultStart := utils.TrimNodeTextRange(ctx.SourceFile, specifiers.defaultSpecifier).Pos()
+				defaultText := strings.TrimSpace(text[defaultStart:commaToken.Range.Pos()])
+				prefix.WriteString("import type " + defaultText + " from " + source + ";\n")
+				fixes = append(fixes, rule.RuleFixRemoveRange(core.NewTextRange(defaultStart, skipWhitespace(commaToken.Range.End()))))
+			}
+		}
+
+		if prefix.Len() > 0 {
+			fixes = append(fixes, rule.RuleFixInsertBefore(ctx.SourceFile, node, prefix.String()))
+		}
+		fixes = append(fixes, removeNamedFixes...)
+		return fixes
+	}
+
+	// With decorator metadata, imports used in decorated signatures are emitted as values
+	if compilerOptions := ctx.Program.Options(); compilerOptions != nil &&
+		compilerOptions.EmitDecoratorMetadata.IsTrue() && compilerOptions.ExperimentalDecorators.IsTrue() {
 		if opts.DisallowTypeAnnotations {
-			// Check if this is an import type in a type annotation position
-			importType := node.AsImportTypeNode()
-			if importType != nil {
-				ctx.ReportNode(node, rule.RuleMessage{
-					Id:          "noImportTypeAnnotations",
-					Description: "Type imports in type annotations are not allowed.",
-				})
+			return rule.RuleListeners{ast.KindImportType: checkTSImportType}
+		}
+		return rule.RuleListeners{}
+	}
+
+	// Collect the value imports and the `import type { ... }` declarations per module
+	var valueImports []*ast.Node
+	typeOnlyNamedImports := make(map[string]*ast.Node)
+	bindingsByName := make(map[string][]*importBinding)
+	var bindings []*importBinding
+	for _, statement := range ctx.SourceFile.Statements.Nodes {
+		if statement.Kind != ast.KindImportDeclaration {
+			continue
+		}
+		importDecl := statement.AsImportDeclaration()
+		if importDecl.ImportClause == nil {
+			continue
+		}
+		clause := importDecl.ImportClause.AsImportClause()
+		source := importDecl.ModuleSpecifier.Text()
+		if clause.IsTypeOnly {
+			if _, ok := typeOnlyNamedImports[source]; !ok && importDecl.ImportClause.Name() == nil &&
+				clause.NamedBindings != nil && clause.NamedBindings.Kind == ast.KindNamedImports {
+				typeOnlyNamedImports[source] = statement
 			}
+			continue
+		}
+
+		valueImports = append(valueImports, statement)
+		for _, specifier := range classifySpecifiers(statement).all() {
+			if isInlineTypeSpecifier(specifier) {
+				continue
+			}
+			name := specifier
+			if specifier.Kind != ast.KindIdentifier {
+				name = specifier.Name()
+			}
+			binding := &importBinding{specifier: specifier, name: name.Text(), declaredBy: statement}
+			binding.symbol = ctx.TypeChecker.GetSymbolAtLocation(name)
+			if binding.symbol != nil {
+				binding.target = ctx.TypeChecker.GetAliasedSymbol(binding.symbol)
+			}
+			bindings = append(bindings, binding)
+			bindingsByName[binding.name] = append(bindingsByName[binding.name], binding)
+		}
+	}
+
+	if len(bindings) > 0 {
+		// matchesTarget compares a symbol resolved through the alias with the import's target.
+		// Imports of unresolved modules all resolve to the unknown symbol, so fall back to the name.
+		matchesTarget := func(binding *importBinding, resolved *ast.Symbol) bool {
+			if binding.target == nil || ctx.TypeChecker.IsUnknownSymbol(binding.target) {
+				return true
+			}
+			return resolved == binding.target
+		}
+
+		checkIdentifier := func(identifier *ast.Node) {
+			candidates := bindingsByName[identifier.Text()]
+			if len(candidates) == 0 {
+				return
+			}
+			parent := identifier.Parent
+
+			for _, binding := range candidates {
+				switch {
+				case parent.Kind == ast.KindShorthandPropertyAssignment && parent.Name() == identifier:
+					if matchesTarget(binding, ctx.TypeChecker.GetShorthandAssignmentValueSymbol(parent)) {
+						binding.valueRefs++
+					}
+				case parent.Kind == ast.KindExportSpecifier:
+					exportDecl := parent.Parent.Parent.AsExportDeclaration()
+					if exportDecl.ModuleSpecifier != nil || parent.PropertyNameOrName() != identifier {
+						continue
+					}
+					if matchesTarget(binding, ctx.TypeChecker.GetExportSpecifierLocalTargetSymbol(parent)) {
+						// `export { T }` re-exports a value unless it is explicitly type-only
+						if exportDecl.IsTypeOnly || parent.AsExportSpecifier().IsTypeOnly {
+							binding.typeRefs++
+						} else {
+							binding.valueRefs++
+						}
+					}
+				case parent.Kind == ast.KindExportAssignment:
+					// `export default T` resolves through the alias
+					if matchesTarget(binding, ctx.TypeChecker.GetSymbolAtLocation(identifier)) {
+						binding.valueRefs++
+					}
+				default:
+					if binding.symbol == nil || ctx.TypeChecker.GetSymbolAtLocation(identifier) != binding.symbol {
+						continue
+					}
+					entityName := identifier
+					for entityName.Parent.Kind == ast.KindQualifiedName {
+						entityName = entityName.Parent
+					}
+					// `import X = T.Y` cannot reference a type-only import
+					if entityName.Parent.Kind != ast.KindImportEqualsDeclaration && ast.IsValidTypeOnlyAliasUseSite(identifier) {
+						binding.typeRefs++
+					} else {
+						binding.valueRefs++
+					}
+				}
+			}
+		}
+
+		hasJsx := false
+		var visit ast.Visitor
+		visit = func(node *ast.Node) bool {
+			switch node.Kind {
+			case ast.KindImportDeclaration:
+				return false
+			case ast.KindIdentifier:
+				checkIdentifier(node)
+			case ast.KindJsxElement, ast.KindJsxSelfClosingElement, ast.KindJsxFragment:
+				hasJsx = true
+			}
+			node.ForEachChild(visit)
+			return false
+		}
+		ctx.SourceFile.Node.ForEachChild(visit)
+
+		// The classic JSX transform references the factory namespace, e.g. `React.createElement`
+		if compilerOptions := ctx.Program.Options(); hasJsx && compilerOptions != nil &&
+			compilerOptions.Jsx != core.JsxEmitReactJSX && compilerOptions.Jsx != core.JsxEmitReactJSXDev {
+			factory := "React"
+			if compilerOptions.JsxFactory != "" {
+				factory, _, _ = strings.Cut(compilerOptions.JsxFactory, ".")
+			} else if compilerOptions.ReactNamespace != "" {
+				factory = compilerOptions.ReactNamespace
+			}
+			for _, binding := range bindingsByName[factory] {
+				binding.valueRefs++
+			}
+		}
+	}
+
+	for _, node := range valueImports {
+		report := valueImportReport{node: node}
+		for _, binding := range bindings {
+			if binding.declaredBy != node {
+				continue
+			}
+			switch {
+			case binding.valueRefs > 0:
+				report.valueSpecifiers = append(report.valueSpecifiers, binding.specifier)
+			case binding.typeRefs > 0:
+				report.typeSpecifiers = append(report.typeSpecifiers, binding.specifier)
+			default:
+				report.unusedSpecifiers = append(report.unusedSpecifiers, binding.specifier)
+			}
+		}
+		if len(report.typeSpecifiers) == 0 {
+			continue
+		}
+
+		typeOnlyNamedImport := typeOnlyNamedImports[node.AsImportDeclaration().ModuleSpecifier.Text()]
+		var fixes []rule.RuleFix
+		// Type-only imports cannot carry import attributes, so leave those to the user
+		if node.AsImportDeclaration().Attributes == nil {
+			fixes = fixToTypeImportDeclaration(report, typeOnlyNamedImport)
+		}
+
+		if len(report.valueSpecifiers) == 0 && len(report.unusedSpecifiers) == 0 {
+			ctx.ReportNodeWithFixes(node, buildTypeOverValueMessage(), fixes...)
+			continue
+		}
+
+		importNames := make([]string, 0, len(report.typeSpecifiers))
+		for _, specifier := range report.typeSpecifiers {
+			importNames = append(importNames, "\""+getLocalName(specifier)+"\"")
+		}
+		typeImports := formatWordList(importNames)
+		if len(importNames) == 1 {
+			ctx.ReportNodeWithFixes(node, buildAImportIsOnlyTypesMessage(typeImports), fixes...)
+		} else {
+			ctx.ReportNodeWithFixes(node, buildSomeImportsAreOnlyTypesMessage(typeImports), fixes...)
 		}
 	}
 
-	return rule.RuleListeners{
-		ast.KindImportDeclaration: checkImportDeclaration,
-		ast.KindImportType:        checkTSImportType,
+	if opts.DisallowTypeAnnotations {
+		return rule.RuleListeners{ast.KindImportType: checkTSImportType}
 	}
+	return rule.RuleListeners{}
 }
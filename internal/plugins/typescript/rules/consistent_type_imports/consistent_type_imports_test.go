@@ -14,9 +14,17 @@ func TestConsistentTypeImportsRule(t *testing.T) {
 		{Code: `import Foo from 'foo'; const foo = Foo;`},
 		{Code: `import Foo from 'foo'; class Bar extends Foo {}`},
 
-		// Named imports with mixed usage
-		{Code: `import { A, B } from 'foo'; const a: A = B();`},
-		{Code: `import { A, B } from 'foo'; const a = A; type T = B;`},
+		// Named imports used as values
+		{Code: `import { A, B } from 'foo'; const a: A = B(); const b = new A();`},
+		{Code: `import { A } from 'foo'; class B extends A {}`},
+		{Code: `import { A } from 'foo'; const a = { A };`},
+		{Code: `import { A } from 'foo'; export { A };`},
+		{Code: `import A from 'foo'; export default A;`},
+		{Code: `import { A } from 'foo'; import B = A.B;`},
+
+		// Unused imports are left to other rules
+		{Code: `import { A } from 'foo';`},
+		{Code: `import Foo, { A } from 'foo'; const foo = Foo;`},
 
 		// Empty imports
 		{Code: `import {} from 'foo';`},
@@ -67,6 +75,145 @@ func TestConsistentTypeImportsRule(t *testing.T) {
 		{Code: `import './styles.css';`},
 		{Code: `import 'reflect-metadata';`},
 	}, []rule_tester.InvalidTestCase{
+		// Imports only used as types
+		{
+			Code:   `import Foo from 'foo'; type T = Foo;`,
+			Output: []string{`import type Foo from 'foo'; type T = Foo;`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "typeOverValue", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:   `import { A, B } from 'foo'; type T = A | B;`,
+			Output: []string{`import type { A, B } from 'foo'; type T = A | B;`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "typeOverValue", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:   `import * as Foo from 'foo'; type T = Foo.Bar;`,
+			Output: []string{`import type * as Foo from 'foo'; type T = Foo.Bar;`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "typeOverValue", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:   `import { foo } from 'foo'; type T = typeof foo;`,
+			Output: []string{`import type { foo } from 'foo'; type T = typeof foo;`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "typeOverValue", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:   `import { Foo } from 'foo'; class A implements Foo {}`,
+			Output: []string{`import type { Foo } from 'foo'; class A implements Foo {}`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "typeOverValue", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:   `import { A } from 'foo'; export type { A };`,
+			Output: []string{`import type { A } from 'foo'; export type { A };`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "typeOverValue", Line: 1, Column: 1},
+			},
+		},
+
+		// Already partially typed imports
+		{
+			Code:   `import { type A, B } from 'foo'; type T = A | B;`,
+			Output: []string{`import type { A, B } from 'foo'; type T = A | B;`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "typeOverValue", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:   `import type { A } from 'foo'; import { B, C } from 'foo'; type T = A | B; const c = C;`,
+			Output: []string{`import type { A, B} from 'foo'; import { C } from 'foo'; type T = A | B; const c = C;`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "aImportIsOnlyTypes", Line: 1, Column: 31},
+			},
+		},
+
+		// Mixed type and value imports
+		{
+			Code:   `import { A, B } from 'foo'; const a: A = B();`,
+			Output: []string{"import type { A} from 'foo';\nimport { B } from 'foo'; const a: A = B();"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "aImportIsOnlyTypes", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:   `import { A, B } from 'foo'; const a = A; type T = B;`,
+			Output: []string{"import type { B} from 'foo';\nimport { A } from 'foo'; const a = A; type T = B;"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "aImportIsOnlyTypes", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:   `import { A, B } from 'foo'; type T = A;`,
+			Output: []string{"import type { A} from 'foo';\nimport { B } from 'foo'; type T = A;"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "aImportIsOnlyTypes", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:   `import { A, B, C, D } from 'foo'; type T = A | B | C; const d = D;`,
+			Output: []string{"import type { A, B, C} from 'foo';\nimport { D } from 'foo'; type T = A | B | C; const d = D;"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "someImportsAreOnlyTypes", Line: 1, Column: 1},
+			},
+		},
+
+		// Default and named imports mixed
+		{
+			Code:   `import Foo, { Bar } from 'foo'; type T = Foo; const b = Bar;`,
+			Output: []string{"import type Foo from 'foo';\nimport { Bar } from 'foo'; type T = Foo; const b = Bar;"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "aImportIsOnlyTypes", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:   `import Foo, { A, B } from 'foo'; type T = A | B; const f = Foo;`,
+			Output: []string{"import type { A, B } from 'foo';\nimport Foo from 'foo'; type T = A | B; const f = Foo;"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "someImportsAreOnlyTypes", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:   `import Foo, * as Bar from 'foo'; type T = Bar.Baz; const f = Foo;`,
+			Output: []string{"import type * as Bar from 'foo';\nimport Foo from 'foo'; type T = Bar.Baz; const f = Foo;"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "aImportIsOnlyTypes", Line: 1, Column: 1},
+			},
+		},
+
+		// Options: fixStyle: 'inline-type-imports'
+		{
+			Code:    `import { A, B } from 'foo'; const a: A = B();`,
+			Output:  []string{`import { type A, B } from 'foo'; const a: A = B();`},
+			Options: []interface{}{map[string]interface{}{"fixStyle": "inline-type-imports"}},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "aImportIsOnlyTypes", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:    `import Foo, { A, B } from 'foo'; type T = A; const f = Foo; const b = B;`,
+			Output:  []string{`import Foo, { type A, B } from 'foo'; type T = A; const f = Foo; const b = B;`},
+			Options: []interface{}{map[string]interface{}{"fixStyle": "inline-type-imports"}},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "aImportIsOnlyTypes", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:    `import Foo, { A } from 'foo'; type T = Foo | A;`,
+			Output:  []string{"import {type A} from 'foo';\nimport type Foo from 'foo'; type T = Foo | A;"},
+			Options: []interface{}{map[string]interface{}{"fixStyle": "inline-type-imports"}},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "typeOverValue", Line: 1, Column: 1},
+			},
+		},
+
 		// import() type annotations (disallowed by default)
 		{
 			Code: `let foo: import('foo');`,
@@ -90,6 +237,7 @@ func TestConsistentTypeImportsRule(t *testing.T) {
 		// Options: prefer: 'no-type-imports'
 		{
 			Code:    `import type Foo from 'foo'; type T = Foo;`,
+			Output:  []string{`import Foo from 'foo'; type T = Foo;`},
 			Options: []interface{}{map[string]interface{}{"prefer": "no-type-imports"}},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "avoidImportType"},
@@ -97,6 +245,7 @@ func TestConsistentTypeImportsRule(t *testing.T) {
 		},
 		{
 			Code:    `import type { A } from 'foo'; type T = A;`,
+			Output:  []string{`import { A } from 'foo'; type T = A;`},
 			Options: []interface{}{map[string]interface{}{"prefer": "no-type-imports"}},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "avoidImportType"},
@@ -104,10 +253,19 @@ func TestConsistentTypeImportsRule(t *testing.T) {
 		},
 		{
 			Code:    `import type * as Foo from 'foo'; type T = Foo.Bar;`,
+			Output:  []string{`import * as Foo from 'foo'; type T = Foo.Bar;`},
 			Options: []interface{}{map[string]interface{}{"prefer": "no-type-imports"}},
 			Errors: []rule_tester.InvalidTestCaseError{
 				{MessageId: "avoidImportType"},
 			},
 		},
+		{
+			Code:    `import { type A, B } from 'foo'; type T = A; const b = B;`,
+			Output:  []string{`import { A, B } from 'foo'; type T = A; const b = B;`},
+			Options: []interface{}{map[string]interface{}{"prefer": "no-type-imports"}},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "avoidImportType", Line: 1, Column: 10},
+			},
+		},
 	})
 }
@@ -114,53 +114,24 @@ func run(ctx rule.RuleContext, options any) rule.RuleListeners {
 			return
 		}
 
-		// Iterate backward through parameters
-		hasSeenPlainParam := false
-		for i := len(params) - 1; i >= 0; i-- {
-			current := params[i]
-			if current == nil {
-				continue
-			}
-
-			// Get the actual parameter (unwrap if it's a parameter property)
-			param := current
-			if current.Kind == ast.KindParameter {
-				p := current.AsParameterDeclaration()
-				if p != nil && p.Name() != nil {
-					// Check if parameter has modifiers (public/private/protected/readonly)
-					// which would make it a parameter property
-					hasModifiers := ast.GetCombinedModifierFlags(current)&(ast.ModifierFlagsPublic|ast.ModifierFlagsPrivate|ast.ModifierFlagsProtected|ast.ModifierFlagsReadonly) != 0
-					if hasModifiers {
-						// For parameter properties, check the parameter itself
-						param = current
-					}
-				}
-			}
-
-			// Skip rest parameters - they can come after defaults
-			if isRestParam(param) {
-				continue
+		// Optional and default parameters are only allowed after the last plain parameter
+		lastPlainParam := -1
+		for i, param := range params {
+			if param != nil && isPlainParam(param) {
+				lastPlainParam = i
 			}
+		}
 
-			if isPlainParam(param) {
-				hasSeenPlainParam = true
+		// Report in source order; rest parameters are neither plain nor reported
+		for _, param := range params[:max(lastPlainParam, 0)] {
+			if param == nil || param.Kind != ast.KindParameter {
 				continue
 			}
-
-			// Check if this is a default or optional parameter that comes before a plain parameter
-			if hasSeenPlainParam {
-				if param.Kind == ast.KindParameter {
-					paramDecl := param.AsParameterDeclaration()
-					isDefaultParam := paramDecl != nil && paramDecl.Initializer != nil
-					isOptional := isOptionalParam(param)
-
-					if isDefaultParam || isOptional {
-						ctx.ReportNode(current, rule.RuleMessage{
-							Id:          "shouldBeLast",
-							Description: "Default parameters should be last.",
-						})
-					}
-				}
+			if param.AsParameterDeclaration().Initializer != nil || isOptionalParam(param) {
+				ctx.ReportNode(param, rule.RuleMessage{
+					Id:          "shouldBeLast",
+					Description: "Default parameters should be last.",
+				})
 			}
 		}
 	}
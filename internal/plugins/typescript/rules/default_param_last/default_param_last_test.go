@@ -22,6 +22,10 @@ func TestDefaultParamLastRule(t *testing.T) {
 		{Code: `function f(a: number, b: number, c = 0) {}`},
 
 		// Valid: optional parameters at the end
+		{Code: `function f(a = 1, b?: number) {}`},
+		{Code: `function f(a?: number, b = 1) {}`},
+		{Code: `function f(a: number, b = 1, c?: number, ...rest: number[]) {}`},
+		{Code: `const f = (a: string, b?: number, c = 1) => {}`},
 		{Code: `function f(a: number, b?: number) {}`},
 		{Code: `function f(a: number, b?: number, c?: number) {}`},
 
@@ -188,6 +192,29 @@ func TestDefaultParamLastRule(t *testing.T) {
 			},
 		},
 
+		// Invalid: optional and default parameters are reported together, in source order
+		{
+			Code: `function f(a = 1, b?: number, c: number, d = 2, e?: number) {}`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "shouldBeLast", Line: 1, Column: 12},
+				{MessageId: "shouldBeLast", Line: 1, Column: 19},
+			},
+		},
+		{
+			Code: `function f(a?: number, b: number, c = 1, d: string) {}`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "shouldBeLast", Line: 1, Column: 12},
+				{MessageId: "shouldBeLast", Line: 1, Column: 35},
+			},
+		},
+		{
+			Code: `class A { method(a = 1, b?: number, c: string, ...rest: string[]) {} }`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "shouldBeLast", Line: 1, Column: 18},
+				{MessageId: "shouldBeLast", Line: 1, Column: 25},
+			},
+		},
+
 		// Invalid: default after optional before required
 		{
 			Code: `function f(a?: number, b = 0, c: number) {}`,
@@ -0,0 +1,121 @@
+package max_params
+
+import (
+	"fmt"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Options for max-params rule
+type Options struct {
+	Max           int  `json:"max"`
+	CountVoidThis bool `json:"countVoidThis"`
+}
+
+func parseOptions(options any) Options {
+	opts := Options{
+		Max: 3,
+	}
+
+	if options == nil {
+		return opts
+	}
+
+	// A bare number is the maximum
+	if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+		options = optArray[0]
+	}
+	if v, ok := options.(float64); ok {
+		opts.Max = int(v)
+		return opts
+	}
+
+	if optsMap, ok := options.(map[string]interface{}); ok {
+		// "maximum" is the deprecated spelling of "max"
+		if v, ok := optsMap["maximum"].(float64); ok {
+			opts.Max = int(v)
+		}
+		if v, ok := optsMap["max"].(float64); ok {
+			opts.Max = int(v)
+		}
+		if v, ok := optsMap["countVoidThis"].(bool); ok {
+			opts.CountVoidThis = v
+		}
+	}
+	return opts
+}
+
+func buildExceedMessage(name string, count int, max int) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "exceed",
+		Description: fmt.Sprintf("%s has too many parameters (%d). Maximum allowed is %d.", name, count, max),
+	}
+}
+
+// getFunctionNameWithKind describes node for the report, e.g. "Method 'foo'"
+func getFunctionNameWithKind(sourceFile *ast.SourceFile, node *ast.Node) string {
+	kind := "Function"
+	switch node.Kind {
+	case ast.KindArrowFunction:
+		kind = "Arrow function"
+	case ast.KindConstructor:
+		return "Constructor"
+	case ast.KindMethodDeclaration:
+		kind = "Method"
+	case ast.KindGetAccessor:
+		kind = "Getter"
+	case ast.KindSetAccessor:
+		kind = "Setter"
+	case ast.KindFunctionType:
+		return "Function type"
+	}
+
+	name := node.Name()
+	if name == nil {
+		return kind
+	}
+	text, _ := utils.GetNameFromMember(sourceFile, name)
+	if text == "" {
+		return kind
+	}
+	return kind + " '" + text + "'"
+}
+
+// MaxParamsRule enforces a maximum number of parameters in function definitions
+var MaxParamsRule = rule.CreateRule(rule.Rule{
+	Name: "max-params",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+
+		checkParams := func(node *ast.Node) {
+			params := node.Parameters()
+			count := len(params)
+
+			// A `this` parameter only types the receiver and is not passed by callers
+			if count > 0 && ast.IsThisParameter(params[0]) {
+				thisType := params[0].AsParameterDeclaration().Type
+				isVoidThis := thisType != nil && thisType.Kind == ast.KindVoidKeyword
+				if !isVoidThis || !opts.CountVoidThis {
+					count--
+				}
+			}
+
+			if count > opts.Max {
+				ctx.ReportNode(node, buildExceedMessage(getFunctionNameWithKind(ctx.SourceFile, node), count, opts.Max))
+			}
+		}
+
+		return rule.RuleListeners{
+			ast.KindFunctionDeclaration: checkParams,
+			ast.KindFunctionExpression:  checkParams,
+			ast.KindArrowFunction:       checkParams,
+			ast.KindMethodDeclaration:   checkParams,
+			ast.KindConstructor:         checkParams,
+			ast.KindGetAccessor:         checkParams,
+			ast.KindSetAccessor:         checkParams,
+			ast.KindFunctionType:        checkParams,
+		}
+	},
+})
@@ -0,0 +1,104 @@
+package max_params
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestMaxParamsRule(t *testing.T) {
+	rule_tester.RunRuleTester(fixtures.GetRootDir(), "tsconfig.json", t, &MaxParamsRule, []rule_tester.ValidTestCase{
+		{Code: `function foo() {}`},
+		{Code: `const foo = function () {};`},
+		{Code: `const foo = () => {};`},
+		{Code: `function foo(a) {}`},
+		{Code: `function foo(a, b, c) {}`},
+		{Code: `function foo(a, b, c) {}`, Options: map[string]interface{}{"max": 3.0}},
+		{Code: `function foo(a, b, c) {}`, Options: []interface{}{map[string]interface{}{"maximum": 3.0}}},
+		{Code: `function foo(a, b) {}`, Options: []interface{}{2.0}},
+		{Code: `class Foo { method(a, b, c) {} }`},
+		{Code: `class Foo { constructor(a, b, c) {} }`},
+		{Code: `type Fn = (a: string, b: string, c: string) => void;`},
+
+		// `this` parameters are not counted
+		{Code: `function foo(this: Window, a, b, c) {}`},
+		{Code: `class Foo { method(this: Foo, a, b, c) {} }`},
+		{Code: `class Foo { method(this: void, a, b, c) {} }`},
+		{Code: `class Foo { method(this: Foo, a, b, c) {} }`, Options: map[string]interface{}{"countVoidThis": true}},
+		{Code: `type Fn = (this: void, a: string, b: string, c: string) => void;`},
+		{Code: `declare function foo(this: void, a, b, c): void;`},
+	}, []rule_tester.InvalidTestCase{
+		{
+			Code: `function foo(a, b, c, d) {}`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "exceed", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:    `const foo = function (a, b, c) {};`,
+			Options: map[string]interface{}{"max": 2.0},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "exceed", Line: 1, Column: 13},
+			},
+		},
+		{
+			Code:    `const foo = (a, b) => {};`,
+			Options: []interface{}{1.0},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "exceed", Line: 1, Column: 13},
+			},
+		},
+		{
+			Code: `class Foo { method(a, b, c, d) {} }`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "exceed", Line: 1, Column: 13},
+			},
+		},
+		{
+			Code: `type Fn = (a: string, b: string, c: string, d: string) => void;`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "exceed", Line: 1, Column: 11},
+			},
+		},
+
+		// A `this` parameter plus too many real parameters
+		{
+			Code: `class Foo { method(this: Foo, a, b, c, d) {} }`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "exceed", Line: 1, Column: 13},
+			},
+		},
+		{
+			Code:    `function foo(this: Window, a, b) {}`,
+			Options: map[string]interface{}{"max": 1.0},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "exceed", Line: 1, Column: 1},
+			},
+		},
+
+		// countVoidThis counts `this: void`
+		{
+			Code:    `class Foo { method(this: void, a, b, c) {} }`,
+			Options: map[string]interface{}{"countVoidThis": true},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "exceed", Line: 1, Column: 13},
+			},
+		},
+		{
+			Code:    `type Fn = (this: void, a: string, b: string, c: string) => void;`,
+			Options: []interface{}{map[string]interface{}{"countVoidThis": true}},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "exceed", Line: 1, Column: 11},
+			},
+		},
+	})
+}
+
+func TestExceedMessage(t *testing.T) {
+	message := buildExceedMessage("Method 'method'", 4, 3)
+	expected := "Method 'method' has too many parameters (4). Maximum allowed is 3."
+	if message.Description != expected {
+		t.Errorf("expected %q, got %q", expected, message.Description)
+	}
+}
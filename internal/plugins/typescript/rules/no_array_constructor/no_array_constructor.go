@@ -0,0 +1,52 @@
+package no_array_constructor
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+func buildUseLiteralMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "useLiteral",
+		Description: "The array literal notation [] is preferable.",
+	}
+}
+
+var NoArrayConstructorRule = rule.CreateRule(rule.Rule{
+	Name: "no-array-constructor",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		isGlobalArray := func(callee *ast.Node) bool {
+			if callee.Kind != ast.KindIdentifier || callee.Text() != "Array" {
+				return false
+			}
+			symbol := ctx.TypeChecker.GetSymbolAtLocation(callee)
+			return symbol == nil || utils.IsSymbolFromDefaultLibrary(ctx.Program, symbol)
+		}
+
+		check := func(node *ast.Node) {
+			callee := ast.SkipParentheses(node.Expression())
+			args := node.Arguments()
+			// A single argument is the length of the array, and type arguments type the elements
+			if len(args) == 1 || node.TypeArguments() != nil || ast.IsOptionalChain(node) || !isGlobalArray(callee) {
+				return
+			}
+
+			if len(args) == 0 {
+				ctx.ReportNodeWithFixes(node, buildUseLiteralMessage(), rule.RuleFixReplace(ctx.SourceFile, node, "[]"))
+				return
+			}
+
+			// Keep the arguments as they are written, including comments, between the parentheses
+			openParen := scanner.GetRangeOfTokenAtPosition(ctx.SourceFile, node.Expression().End())
+			argsText := ctx.SourceFile.Text()[openParen.End() : node.End()-1]
+			ctx.ReportNodeWithFixes(node, buildUseLiteralMessage(), rule.RuleFixReplace(ctx.SourceFile, node, "["+argsText+"]"))
+		}
+
+		return rule.RuleListeners{
+			ast.KindCallExpression: check,
+			ast.KindNewExpression:  check,
+		}
+	},
+})
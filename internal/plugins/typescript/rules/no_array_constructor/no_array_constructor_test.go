@@ -0,0 +1,104 @@
+package no_array_constructor
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoArrayConstructorRule(t *testing.T) {
+	rule_tester.RunRuleTester(fixtures.GetRootDir(), "tsconfig.json", t, &NoArrayConstructorRule, []rule_tester.ValidTestCase{
+		{Code: "new Array(x);"},
+		{Code: "Array(x);"},
+		{Code: "new Array(9);"},
+		{Code: "Array(9);"},
+		{Code: "new foo.Array();"},
+		{Code: "foo.Array();"},
+		{Code: "new Array.foo();"},
+		{Code: "Array.foo();"},
+		{Code: "new Array<Foo>(1, 2, 3);"},
+		{Code: "new Array<Foo>();"},
+		{Code: "new Array<number>(x, y);"},
+		{Code: "Array<Foo>(1, 2, 3);"},
+		{Code: "Array<Foo>();"},
+		{Code: "Array<Foo>(3);"},
+		{Code: "Array?.(0, 1, 2);"},
+		{Code: "Array?.(x, y);"},
+		{Code: "function foo(Array: any) { return new Array(1, 2); }"},
+	}, []rule_tester.InvalidTestCase{
+		{
+			Code:   "new Array();",
+			Output: []string{"[];"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "useLiteral", Line: 1, Column: 1, EndColumn: 12},
+			},
+		},
+		{
+			Code:   "new Array;",
+			Output: []string{"[];"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "useLiteral", Line: 1, Column: 1, EndColumn: 10},
+			},
+		},
+		{
+			Code:   "Array();",
+			Output: []string{"[];"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "useLiteral", Line: 1, Column: 1, EndColumn: 8},
+			},
+		},
+		{
+			Code:   "new Array(x, y);",
+			Output: []string{"[x, y];"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "useLiteral", Line: 1, Column: 1, EndColumn: 16},
+			},
+		},
+		{
+			Code:   "Array(x, y);",
+			Output: []string{"[x, y];"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "useLiteral", Line: 1, Column: 1, EndColumn: 12},
+			},
+		},
+		{
+			Code:   "new Array(1, 2, 3);",
+			Output: []string{"[1, 2, 3];"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "useLiteral", Line: 1, Column: 1, EndColumn: 19},
+			},
+		},
+		{
+			Code:   "Array(1, 2);",
+			Output: []string{"[1, 2];"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "useLiteral", Line: 1, Column: 1, EndColumn: 12},
+			},
+		},
+		{
+			Code:   "const a = (Array)(...b, c);",
+			Output: []string{"const a = [...b, c];"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "useLiteral", Line: 1, Column: 11, EndColumn: 27},
+			},
+		},
+		{
+			Code: `
+new Array(
+  0, // a
+  1, // b
+);
+      `,
+			Output: []string{`
+[
+  0, // a
+  1, // b
+];
+      `},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "useLiteral", Line: 2, Column: 1, EndLine: 5, EndColumn: 2},
+			},
+		},
+	})
+}
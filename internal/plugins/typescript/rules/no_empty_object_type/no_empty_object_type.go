@@ -0,0 +1,216 @@
+package no_empty_object_type
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+type NoEmptyObjectTypeOptions struct {
+	AllowInterfaces  string `json:"allowInterfaces"`
+	AllowObjectTypes string `json:"allowObjectTypes"`
+	AllowWithName    string `json:"allowWithName"`
+}
+
+func buildNoEmptyMessage(emptyType string, option string) string {
+	return strings.Join([]string{
+		emptyType + " allows any non-nullish value, including literals like `0` and `\"\"`.",
+		"- If that's what you want, disable this lint rule with an inline comment or configure the '" + option + "' rule option.",
+		"- If you want a type meaning \"any object\", you probably want `object` instead.",
+		"- If you want a type meaning \"any value\", you probably want `unknown` instead.",
+	}, "\n")
+}
+
+func buildNoEmptyInterfaceMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "noEmptyInterface",
+		Description: buildNoEmptyMessage("An empty interface declaration", "allowInterfaces"),
+	}
+}
+
+func buildNoEmptyInterfaceWithSuperMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "noEmptyInterfaceWithSuper",
+		Description: "An interface declaring no members is equivalent to its supertype.",
+	}
+}
+
+func buildNoEmptyObjectMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "noEmptyObject",
+		Description: buildNoEmptyMessage("The `{}` (\"empty object\") type", "allowObjectTypes"),
+	}
+}
+
+func buildReplaceEmptyInterfaceMessage(replacement string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "replaceEmptyInterface",
+		Description: fmt.Sprintf("Replace empty interface with `%s`.", replacement),
+	}
+}
+
+func buildReplaceEmptyInterfaceWithSuperMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "replaceEmptyInterfaceWithSuper",
+		Description: "Replace empty interface with a type alias.",
+	}
+}
+
+func buildReplaceEmptyObjectTypeMessage(replacement string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "replaceEmptyObjectType",
+		Description: fmt.Sprintf("Replace `{}` with `%s`.", replacement),
+	}
+}
+
+var replacements = []string{"object", "unknown", "Record<string, never>"}
+
+var NoEmptyObjectTypeRule = rule.CreateRule(rule.Rule{
+	Name: "no-empty-object-type",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := NoEmptyObjectTypeOptions{
+			AllowInterfaces:  "never",
+			AllowObjectTypes: "never",
+		}
+		// Parse options with dual-format support (handles both array and object formats)
+		if options != nil {
+			var optsMap map[string]interface{}
+			var ok bool
+
+			// Handle array format: [{ option: value }]
+			if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+				optsMap, ok = optArray[0].(map[string]interface{})
+			} else {
+				// Handle direct object format: { option: value }
+				optsMap, ok = options.(map[string]interface{})
+			}
+
+			if ok {
+				if allowInterfaces, ok := optsMap["allowInterfaces"].(string); ok {
+					opts.AllowInterfaces = allowInterfaces
+				}
+				if allowObjectTypes, ok := optsMap["allowObjectTypes"].(string); ok {
+					opts.AllowObjectTypes = allowObjectTypes
+				}
+				if allowWithName, ok := optsMap["allowWithName"].(string); ok {
+					opts.AllowWithName = allowWithName
+				}
+			}
+		}
+
+		var allowWithName *regexp.Regexp
+		if opts.AllowWithName != "" {
+			allowWithName, _ = regexp.Compile(opts.AllowWithName)
+		}
+		isAllowedName := func(name *ast.Node) bool {
+			return allowWithName != nil && allowWithName.MatchString(name.Text())
+		}
+
+		getNodeText := func(node *ast.Node) string {
+			nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			return ctx.SourceFile.Text()[nodeRange.Pos():nodeRange.End()]
+		}
+
+		return rule.RuleListeners{
+			ast.KindInterfaceDeclaration: func(node *ast.Node) {
+				if opts.AllowInterfaces == "always" {
+					return
+				}
+
+				interfaceDecl := node.AsInterfaceDeclaration()
+				if interfaceDecl.Members != nil && len(interfaceDecl.Members.Nodes) > 0 {
+					return
+				}
+
+				var extends []*ast.Node
+				if interfaceDecl.HeritageClauses != nil {
+					for _, clause := range interfaceDecl.HeritageClauses.Nodes {
+						heritageClause := clause.AsHeritageClause()
+						if heritageClause.Token == ast.KindExtendsKeyword {
+							extends = heritageClause.Types.Nodes
+						}
+					}
+				}
+
+				if (len(extends) > 0 && opts.AllowInterfaces == "with-single-extends") || len(extends) > 1 || isAllowedName(interfaceDecl.Name()) {
+					return
+				}
+
+				// A type alias can't merge with a class declaration
+				mergedWithClassDeclaration := false
+				if symbol := ctx.TypeChecker.GetSymbolAtLocation(interfaceDecl.Name()); symbol != nil {
+					mergedWithClassDeclaration = utils.Some(symbol.Declarations, func(decl *ast.Node) bool {
+						return decl.Kind == ast.KindClassDeclaration
+					})
+				}
+
+				exportText := ""
+				if utils.IncludesModifier(interfaceDecl, ast.KindExportKeyword) {
+					exportText = "export "
+				}
+				typeParamsText := ""
+				if interfaceDecl.TypeParameters != nil && len(interfaceDecl.TypeParameters.Nodes) > 0 {
+					typeParamsText = "<" + strings.Join(utils.Map(interfaceDecl.TypeParameters.Nodes, getNodeText), ", ") + ">"
+				}
+				buildTypeAlias := func(aliased string) string {
+					return fmt.Sprintf("%stype %s%s = %s", exportText, interfaceDecl.Name().Text(), typeParamsText, aliased)
+				}
+
+				if len(extends) == 1 {
+					if mergedWithClassDeclaration {
+						ctx.ReportNode(interfaceDecl.Name(), buildNoEmptyInterfaceWithSuperMessage())
+						return
+					}
+					ctx.ReportNodeWithSuggestions(interfaceDecl.Name(), buildNoEmptyInterfaceWithSuperMessage(), rule.RuleSuggestion{
+						Message:  buildReplaceEmptyInterfaceWithSuperMessage(),
+						FixesArr: []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, node, buildTypeAlias(getNodeText(extends[0])))},
+					})
+					return
+				}
+
+				if mergedWithClassDeclaration {
+					ctx.ReportNode(interfaceDecl.Name(), buildNoEmptyInterfaceMessage())
+					return
+				}
+				suggestions := utils.Map(replacements, func(replacement string) rule.RuleSuggestion {
+					return rule.RuleSuggestion{
+						Message:  buildReplaceEmptyInterfaceMessage(replacement),
+						FixesArr: []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, node, buildTypeAlias(replacement))},
+					}
+				})
+				ctx.ReportNodeWithSuggestions(interfaceDecl.Name(), buildNoEmptyInterfaceMessage(), suggestions...)
+			},
+			ast.KindTypeLiteral: func(node *ast.Node) {
+				if opts.AllowObjectTypes == "always" {
+					return
+				}
+
+				typeLiteral := node.AsTypeLiteralNode()
+				if typeLiteral.Members != nil && len(typeLiteral.Members.Nodes) > 0 {
+					return
+				}
+
+				// `{}` in an intersection doesn't widen the type
+				if node.Parent.Kind == ast.KindIntersectionType {
+					return
+				}
+
+				if node.Parent.Kind == ast.KindTypeAliasDeclaration && isAllowedName(node.Parent.Name()) {
+					return
+				}
+
+				suggestions := utils.Map(replacements, func(replacement string) rule.RuleSuggestion {
+					return rule.RuleSuggestion{
+						Message:  buildReplaceEmptyObjectTypeMessage(replacement),
+						FixesArr: []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, node, replacement)},
+					}
+				})
+				ctx.ReportNodeWithSuggestions(node, buildNoEmptyObjectMessage(), suggestions...)
+			},
+		}
+	},
+})
@@ -0,0 +1,197 @@
+package no_empty_object_type
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func objectTypeSuggestions(prefix string, suffix string) []rule_tester.InvalidTestCaseSuggestion {
+	return []rule_tester.InvalidTestCaseSuggestion{
+		{MessageId: "replaceEmptyObjectType", Output: prefix + "object" + suffix},
+		{MessageId: "replaceEmptyObjectType", Output: prefix + "unknown" + suffix},
+		{MessageId: "replaceEmptyObjectType", Output: prefix + "Record<string, never>" + suffix},
+	}
+}
+
+func interfaceSuggestions(prefix string, suffix string) []rule_tester.InvalidTestCaseSuggestion {
+	return []rule_tester.InvalidTestCaseSuggestion{
+		{MessageId: "replaceEmptyInterface", Output: prefix + "object" + suffix},
+		{MessageId: "replaceEmptyInterface", Output: prefix + "unknown" + suffix},
+		{MessageId: "replaceEmptyInterface", Output: prefix + "Record<string, never>" + suffix},
+	}
+}
+
+func TestNoEmptyObjectTypeRule(t *testing.T) {
+	rule_tester.RunRuleTester(fixtures.GetRootDir(), "tsconfig.json", t, &NoEmptyObjectTypeRule, []rule_tester.ValidTestCase{
+		{Code: `
+interface Base {
+  name: string;
+}
+    `},
+		{Code: `
+interface Base {
+  name: string;
+}
+
+interface Derived {
+  age: number;
+}
+
+// valid because extending multiple interfaces can be used instead of a union type
+interface Both extends Base, Derived {}
+    `},
+		{Code: "interface Base {}", Options: map[string]interface{}{"allowInterfaces": "always"}},
+		{Code: `
+interface Base {
+  name: string;
+}
+
+interface Derived extends Base {}
+    `, Options: map[string]interface{}{"allowInterfaces": "with-single-extends"}},
+		{Code: "let value: object;"},
+		{Code: "let value: Object;"},
+		{Code: "let value: { inner: true };"},
+		{Code: "type MyNonNullable<T> = T & {};"},
+		{Code: "type Base = {};", Options: map[string]interface{}{"allowObjectTypes": "always"}},
+		{Code: "type Base = {};", Options: map[string]interface{}{"allowWithName": "Base"}},
+		{Code: "type BaseProps = {};", Options: map[string]interface{}{"allowWithName": "Props$"}},
+		{Code: "interface Base {}", Options: map[string]interface{}{"allowWithName": "Base"}},
+		{Code: "interface BaseProps {}", Options: map[string]interface{}{"allowWithName": "Props$"}},
+	}, []rule_tester.InvalidTestCase{
+		{
+			Code: "interface Base {}",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId:   "noEmptyInterface",
+					Line:        1,
+					Column:      11,
+					Suggestions: interfaceSuggestions("type Base = ", ""),
+				},
+			},
+		},
+		{
+			Code:    "interface Base {}",
+			Options: map[string]interface{}{"allowInterfaces": "never"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId:   "noEmptyInterface",
+					Line:        1,
+					Column:      11,
+					Suggestions: interfaceSuggestions("type Base = ", ""),
+				},
+			},
+		},
+		{
+			Code: "export interface Base<T> {}",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId:   "noEmptyInterface",
+					Line:        1,
+					Column:      18,
+					Suggestions: interfaceSuggestions("export type Base<T> = ", ""),
+				},
+			},
+		},
+		{
+			Code: `
+interface Base {
+  props: string;
+}
+
+interface Derived extends Base {}
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noEmptyInterfaceWithSuper",
+					Line:      6,
+					Column:    11,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "replaceEmptyInterfaceWithSuper",
+							Output: `
+interface Base {
+  props: string;
+}
+
+type Derived = Base
+      `,
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: `
+interface Base {
+  props: string;
+}
+
+interface Derived extends Base {}
+
+class Derived {}
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "noEmptyInterfaceWithSuper", Line: 6, Column: 11},
+			},
+		},
+		{
+			Code: "type Base = {};",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId:   "noEmptyObject",
+					Line:        1,
+					Column:      13,
+					Suggestions: objectTypeSuggestions("type Base = ", ";"),
+				},
+			},
+		},
+		{
+			Code: "let value: {};",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId:   "noEmptyObject",
+					Line:        1,
+					Column:      12,
+					Suggestions: objectTypeSuggestions("let value: ", ";"),
+				},
+			},
+		},
+		{
+			Code: "type MyUnion<T> = T | {};",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId:   "noEmptyObject",
+					Line:        1,
+					Column:      23,
+					Suggestions: objectTypeSuggestions("type MyUnion<T> = T | ", ";"),
+				},
+			},
+		},
+		{
+			Code:    "type Base = {} | null;",
+			Options: map[string]interface{}{"allowWithName": "Mismatch"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId:   "noEmptyObject",
+					Line:        1,
+					Column:      13,
+					Suggestions: objectTypeSuggestions("type Base = ", " | null;"),
+				},
+			},
+		},
+		{
+			Code:    "interface Base {}",
+			Options: map[string]interface{}{"allowObjectTypes": "always"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId:   "noEmptyInterface",
+					Line:        1,
+					Column:      11,
+					Suggestions: interfaceSuggestions("type Base = ", ""),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,345 @@
+package no_invalid_this
+
+import (
+	"regexp"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+var (
+	thisTagPattern                = regexp.MustCompile(`(?m)^[\s*]*@this`)
+	bindOrCallOrApplyPattern      = regexp.MustCompile(`^(?:bind|call|apply)$`)
+	arrayMethodWithThisArgPattern = regexp.MustCompile(`^(?:every|filter|find(?:Last)?(?:Index)?|flatMap|forEach|map|some)$`)
+	reflectPattern                = regexp.MustCompile(`^Reflect$`)
+	applyPattern                  = regexp.MustCompile(`^apply$`)
+	arrayTypePattern              = regexp.MustCompile(`Array$`)
+	fromMethodPattern             = regexp.MustCompile(`^from$`)
+)
+
+type NoInvalidThisOptions struct {
+	CapIsConstructor bool `json:"capIsConstructor"`
+}
+
+func parseOptions(options any) NoInvalidThisOptions {
+	opts := NoInvalidThisOptions{
+		CapIsConstructor: true,
+	}
+
+	var optsMap map[string]interface{}
+	if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+		optsMap, _ = optArray[0].(map[string]interface{})
+	} else {
+		optsMap, _ = options.(map[string]interface{})
+	}
+
+	if optsMap != nil {
+		if v, ok := optsMap["capIsConstructor"].(bool); ok {
+			opts.CapIsConstructor = v
+		}
+	}
+	return opts
+}
+
+func buildUnexpectedThisMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpectedThis",
+		Description: "Unexpected 'this'.",
+	}
+}
+
+func startsWithUpperCase(name string) bool {
+	r, _ := utf8.DecodeRuneInString(name)
+	return r != utf8.RuneError && unicode.IsUpper(r)
+}
+
+func isNullOrUndefined(node *ast.Node) bool {
+	node = ast.SkipParentheses(node)
+	switch node.Kind {
+	case ast.KindNullKeyword:
+		return true
+	case ast.KindIdentifier:
+		return node.Text() == "undefined"
+	case ast.KindVoidExpression:
+		return true
+	}
+	return false
+}
+
+// getMemberName returns the statically known property name of a member access
+func getMemberName(node *ast.Node) (string, bool) {
+	switch node.Kind {
+	case ast.KindPropertyAccessExpression:
+		name := node.AsPropertyAccessExpression().Name()
+		if name.Kind == ast.KindIdentifier {
+			return name.Text(), true
+		}
+	case ast.KindElementAccessExpression:
+		argument := ast.SkipParentheses(node.AsElementAccessExpression().ArgumentExpression)
+		if argument.Kind == ast.KindStringLiteral || argument.Kind == ast.KindNoSubstitutionTemplateLiteral {
+			return argument.Text(), true
+		}
+	}
+	return "", false
+}
+
+// isSpecificMemberAccess checks for `object.property` where the object is an identifier matching objectPattern (if given)
+func isSpecificMemberAccess(node *ast.Node, objectPattern *regexp.Regexp, propertyPattern *regexp.Regexp) bool {
+	node = ast.SkipParentheses(node)
+	if !ast.IsAccessExpression(node) {
+		return false
+	}
+	if objectPattern != nil {
+		object := ast.SkipParentheses(node.Expression())
+		if object.Kind != ast.KindIdentifier || !objectPattern.MatchString(object.Text()) {
+			return false
+		}
+	}
+	name, ok := getMemberName(node)
+	return ok && propertyPattern.MatchString(name)
+}
+
+// getCallByCallee returns the call expression whose callee is node, skipping parentheses
+func getCallByCallee(node *ast.Node) *ast.Node {
+	for node.Parent != nil && node.Parent.Kind == ast.KindParenthesizedExpression {
+		node = node.Parent
+	}
+	if node.Parent != nil && node.Parent.Kind == ast.KindCallExpression && node.Parent.Expression() == node {
+		return node.Parent
+	}
+	return nil
+}
+
+// getUpperFunction returns the closest enclosing function, including arrow functions
+func getUpperFunction(node *ast.Node) *ast.Node {
+	for current := node.Parent; current != nil; current = current.Parent {
+		if ast.IsFunctionLike(current) {
+			return current
+		}
+	}
+	return nil
+}
+
+// hasUseStrictDirective checks the directive prologue of a list of statements
+func hasUseStrictDirective(statements []*ast.Node) bool {
+	for _, statement := range statements {
+		if !ast.IsPrologueDirective(statement) {
+			return false
+		}
+		if statement.AsExpressionStatement().Expression.Text() == "use strict" {
+			return true
+		}
+	}
+	return false
+}
+
+// NoInvalidThisRule disallows `this` outside of classes or class-like objects
+var NoInvalidThisRule = rule.CreateRule(rule.Rule{
+	Name: "no-invalid-this",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+
+		isModule := ast.IsExternalModule(ctx.SourceFile)
+		alwaysStrict := false
+		if ctx.Program != nil {
+			compilerOptions := ctx.Program.Options()
+			alwaysStrict = utils.IsStrictCompilerOptionEnabled(compilerOptions, compilerOptions.AlwaysStrict)
+		}
+
+		isStrict := func(node *ast.Node) bool {
+			if isModule || alwaysStrict {
+				return true
+			}
+			for current := node; current != nil; current = current.Parent {
+				switch {
+				case ast.IsClassLike(current):
+					return true
+				case current.Kind == ast.KindSourceFile:
+					return hasUseStrictDirective(current.AsSourceFile().Statements.Nodes)
+				case ast.IsFunctionLike(current):
+					if body := current.Body(); body != nil && body.Kind == ast.KindBlock && hasUseStrictDirective(body.AsBlock().Statements.Nodes) {
+						return true
+					}
+				}
+			}
+			return false
+		}
+
+		hasJSDocThisTag := func(node *ast.Node) bool {
+			targets := []*ast.Node{node}
+			// Declarations document their initializer
+			if node.Parent != nil && node.Parent.Kind == ast.KindVariableDeclaration && node.Parent.Parent != nil && node.Parent.Parent.Parent != nil {
+				targets = append(targets, node.Parent.Parent.Parent)
+			}
+			for _, target := range targets {
+				for _, comment := range ctx.GetCommentsBefore(target) {
+					if thisTagPattern.MatchString(comment.Text) {
+						return true
+					}
+				}
+			}
+			return false
+		}
+
+		// isDefaultThisBinding checks whether a function is called without an explicit `this`
+		isDefaultThisBinding := func(node *ast.Node) bool {
+			name := node.Name()
+			isAnonymous := name == nil
+			if opts.CapIsConstructor && !isAnonymous && startsWithUpperCase(name.Text()) {
+				return false
+			}
+			if hasJSDocThisTag(node) {
+				return false
+			}
+
+			current := node
+			for current.Parent != nil {
+				parent := current.Parent
+				switch parent.Kind {
+				case ast.KindParenthesizedExpression, ast.KindConditionalExpression:
+					current = parent
+					continue
+
+				case ast.KindBinaryExpression:
+					expr := parent.AsBinaryExpression()
+					switch expr.OperatorToken.Kind {
+					case ast.KindAmpersandAmpersandToken, ast.KindBarBarToken, ast.KindQuestionQuestionToken:
+						current = parent
+						continue
+					}
+					if !ast.IsAssignmentOperator(expr.OperatorToken.Kind) {
+						return true
+					}
+					left := ast.SkipParentheses(expr.Left)
+					if ast.IsAccessExpression(left) {
+						return false
+					}
+					return !(opts.CapIsConstructor && isAnonymous && left.Kind == ast.KindIdentifier && startsWithUpperCase(left.Text()))
+
+				case ast.KindParameter, ast.KindBindingElement:
+					if parent.Initializer() != current {
+						return true
+					}
+					left := parent.Name()
+					return !(opts.CapIsConstructor && isAnonymous && left.Kind == ast.KindIdentifier && startsWithUpperCase(left.Text()))
+
+				case ast.KindReturnStatement:
+					upper := getUpperFunction(parent)
+					if upper == nil {
+						return true
+					}
+					call := getCallByCallee(upper)
+					if call == nil {
+						return true
+					}
+					current = call
+					continue
+
+				case ast.KindArrowFunction:
+					if parent.Body() != current {
+						return true
+					}
+					call := getCallByCallee(parent)
+					if call == nil {
+						return true
+					}
+					current = call
+					continue
+
+				case ast.KindPropertyAssignment, ast.KindPropertyDeclaration:
+					return parent.Initializer() != current
+
+				case ast.KindVariableDeclaration:
+					id := parent.Name()
+					return !(opts.CapIsConstructor && isAnonymous && parent.Initializer() == current &&
+						id.Kind == ast.KindIdentifier && startsWithUpperCase(id.Text()))
+
+				case ast.KindPropertyAccessExpression, ast.KindElementAccessExpression:
+					if parent.Expression() == current && isSpecificMemberAccess(parent, nil, bindOrCallOrApplyPattern) {
+						call := getCallByCallee(parent)
+						return !(call != nil && len(call.Arguments()) >= 1 && !isNullOrUndefined(call.Arguments()[0]))
+					}
+					return true
+
+				case ast.KindCallExpression:
+					callee := parent.Expression()
+					args := parent.Arguments()
+					switch {
+					case isSpecificMemberAccess(callee, reflectPattern, applyPattern):
+						return len(args) != 3 || args[0] != current || isNullOrUndefined(args[1])
+					case isSpecificMemberAccess(callee, arrayTypePattern, fromMethodPattern):
+						return len(args) != 3 || args[1] != current || isNullOrUndefined(args[2])
+					case isSpecificMemberAccess(callee, nil, arrayMethodWithThisArgPattern):
+						return len(args) != 2 || args[0] != current || isNullOrUndefined(args[1])
+					}
+					return true
+				}
+				return true
+			}
+			return true
+		}
+
+		hasThisParameter := func(node *ast.Node) bool {
+			params := node.Parameters()
+			return len(params) > 0 && ast.IsThisParameter(params[0])
+		}
+
+		// isValidThisContainer checks whether `this` is bound to something meaningful in the container
+		isValidThisContainer := func(container *ast.Node) bool {
+			switch container.Kind {
+			case ast.KindSourceFile:
+				return !isModule
+			case ast.KindFunctionDeclaration, ast.KindFunctionExpression:
+				if hasThisParameter(container) || !isStrict(container) {
+					return true
+				}
+				return !isDefaultThisBinding(container)
+			}
+			// Methods, constructors, accessors, field initializers and static blocks
+			return true
+		}
+
+		// getThisContainer finds the node that determines the value of `this`
+		getThisContainer := func(node *ast.Node) *ast.Node {
+			child := node
+			for parent := node.Parent; parent != nil; child, parent = parent, parent.Parent {
+				switch parent.Kind {
+				case ast.KindFunctionDeclaration,
+					ast.KindFunctionExpression,
+					ast.KindMethodDeclaration,
+					ast.KindConstructor,
+					ast.KindGetAccessor,
+					ast.KindSetAccessor:
+					// Computed names and decorators are evaluated in the enclosing scope
+					if child == parent.Name() || child.Kind == ast.KindDecorator {
+						continue
+					}
+					return parent
+				case ast.KindPropertyDeclaration:
+					if child == parent.Initializer() {
+						return parent
+					}
+				case ast.KindClassStaticBlockDeclaration, ast.KindSourceFile:
+					return parent
+				}
+			}
+			return nil
+		}
+
+		return rule.RuleListeners{
+			ast.KindThisKeyword: func(node *ast.Node) {
+				if ast.IsPartOfTypeNode(node) {
+					return
+				}
+				container := getThisContainer(node)
+				if container == nil || isValidThisContainer(container) {
+					return
+				}
+				ctx.ReportNode(node, buildUnexpectedThisMessage())
+			},
+		}
+	},
+})
@@ -0,0 +1,137 @@
+package no_invalid_this
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoInvalidThisRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoInvalidThisRule,
+		[]rule_tester.ValidTestCase{
+			// `this` parameter
+			{Code: `function foo(this: Window) { console.log(this); }`},
+			{Code: `const foo = function (this: Window) { console.log(this); };`},
+			{Code: `function foo(this: Window) { return () => this; }`},
+
+			// Class members
+			{Code: `class A { a = this; }`},
+			{Code: `class A { a = () => this.b; b = 1; }`},
+			{Code: `class A { static a = this; }`},
+			{Code: `class A { accessor a = this; }`},
+			{Code: `class A { static { console.log(this); } }`},
+			{Code: `class A { constructor() { this.a = 1; } a: number; }`},
+			{Code: `class A { foo() { return this; } }`},
+			{Code: `class A { foo() { return () => this; } }`},
+			{Code: `class A { get foo() { return this; } set foo(v) { this.bar = v; } bar: any; }`},
+			{Code: `class A { foo = function () { return this; }; }`},
+			{Code: `declare function dec(a: any): any; class A { @dec(1) foo() { return this; } }`},
+
+			// Object methods and properties
+			{Code: `const obj = { foo() { return this; } };`},
+			{Code: `const obj = { foo: function () { return this; } };`},
+			{Code: `const obj = { foo: cond ? function () { return this; } : null };`},
+			{Code: `const obj = { get foo() { return this; } };`},
+			{Code: `obj.foo = function () { return this; };`},
+
+			// Constructors
+			{Code: `function Foo() { this.a = 1; }`},
+			{Code: `const Foo = function () { this.a = 1; };`},
+			{Code: `Foo = function () { this.a = 1; };`},
+
+			// Bound functions
+			{Code: `const foo = function () { return this; }.bind(obj);`},
+			{Code: `const foo = (function () { return this; }).call(obj);`},
+			{Code: `Reflect.apply(function () { return this; }, obj, []);`},
+			{Code: `Array.from([], function () { return this; }, obj);`},
+			{Code: `[].forEach(function () { return this; }, obj);`},
+			{Code: `const foo = (() => function () { return this; })().bind(obj);`},
+
+			// JSDoc @this
+			{Code: `/** @this Obj */ function foo() { return this; }`},
+			{Code: `/** @this Obj */ const foo = function () { return this; };`},
+
+			// Scripts
+			{Code: `console.log(this);`},
+			{Code: `const foo = () => this;`},
+		},
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `function foo() { console.log(this); }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedThis", Line: 1, Column: 30},
+				},
+			},
+			{
+				Code: `function foo() { return () => this; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedThis", Line: 1, Column: 31},
+				},
+			},
+			{
+				Code: `const foo = function () { return this; };`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedThis", Line: 1, Column: 34},
+				},
+			},
+			{
+				Code: `function foo(a: string) { this.a = a; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedThis", Line: 1, Column: 27},
+				},
+			},
+			{
+				Code: `class A { foo() { return function () { return this; }; } }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedThis", Line: 1, Column: 47},
+				},
+			},
+			{
+				Code: `const foo = function () { return this; }.bind(null);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedThis", Line: 1, Column: 34},
+				},
+			},
+			{
+				Code: `[].forEach(function () { return this; });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedThis", Line: 1, Column: 33},
+				},
+			},
+			{
+				Code:    `function Foo() { this.a = 1; }`,
+				Options: map[string]interface{}{"capIsConstructor": false},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedThis", Line: 1, Column: 18},
+				},
+			},
+
+			// Computed keys are evaluated in the enclosing scope
+			{
+				Code: `function foo() { return class { [this.key]() {} }; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedThis", Line: 1, Column: 34},
+				},
+			},
+
+			// Top-level `this` in modules
+			{
+				Code: `export const foo = this;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedThis", Line: 1, Column: 20},
+				},
+			},
+			{
+				Code: `export const foo = () => this;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedThis", Line: 1, Column: 26},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,78 @@
+package no_non_null_assertion
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+func buildNoNonNullMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "noNonNull",
+		Description: "Forbidden non-null assertion.",
+	}
+}
+
+func buildSuggestOptionalChainMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "suggestOptionalChain",
+		Description: "Consider using the optional chain operator `?.` instead. This operator includes runtime checks, so it is safer than the compile-only non-null assertion operator.",
+	}
+}
+
+var NoNonNullAssertionRule = rule.CreateRule(rule.Rule{
+	Name: "no-non-null-assertion",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		return rule.RuleListeners{
+			ast.KindNonNullExpression: func(node *ast.Node) {
+				// the `!` is always the last token of the assertion
+				nonNullOperator := core.NewTextRange(node.End()-1, node.End())
+				replaceTokenWithOptional := []rule.RuleFix{rule.RuleFixReplaceRange(nonNullOperator, "?.")}
+				removeToken := []rule.RuleFix{rule.RuleFixRemoveRange(nonNullOperator)}
+
+				var fixes []rule.RuleFix
+				parent := node.Parent
+				switch {
+				case ast.IsPropertyAccessExpression(parent) && parent.Expression() == node:
+					if parent.AsPropertyAccessExpression().QuestionDotToken != nil {
+						// it is x!?.y.z
+						fixes = removeToken
+					} else {
+						// it is x!.y?.z
+						punctuator := scanner.GetRangeOfTokenAtPosition(ctx.SourceFile, node.End())
+						fixes = []rule.RuleFix{
+							rule.RuleFixRemoveRange(nonNullOperator),
+							rule.RuleFixReplaceRange(punctuator.WithEnd(punctuator.Pos()), "?"),
+						}
+					}
+				case ast.IsElementAccessExpression(parent) && parent.Expression() == node:
+					if parent.AsElementAccessExpression().QuestionDotToken != nil {
+						// it is x!?.[y].z
+						fixes = removeToken
+					} else {
+						// it is x![y]?.z
+						fixes = replaceTokenWithOptional
+					}
+				case ast.IsCallExpression(parent) && parent.Expression() == node:
+					if parent.AsCallExpression().QuestionDotToken != nil {
+						// it is x.y.z!?.()
+						fixes = removeToken
+					} else {
+						// it is x.y?.z!()
+						fixes = replaceTokenWithOptional
+					}
+				}
+
+				if fixes == nil {
+					ctx.ReportNode(node, buildNoNonNullMessage())
+					return
+				}
+				ctx.ReportNodeWithSuggestions(node, buildNoNonNullMessage(), rule.RuleSuggestion{
+					Message:  buildSuggestOptionalChainMessage(),
+					FixesArr: fixes,
+				})
+			},
+		}
+	},
+})
@@ -0,0 +1,426 @@
+package no_non_null_assertion
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoNonNullAssertionRule(t *testing.T) {
+	rule_tester.RunRuleTester(fixtures.GetRootDir(), "tsconfig.json", t, &NoNonNullAssertionRule, []rule_tester.ValidTestCase{
+		{Code: "x;"},
+		{Code: "x.y;"},
+		{Code: "x.y.z;"},
+		{Code: "x?.y.z;"},
+		{Code: "x?.y?.z;"},
+		{Code: "!x;"},
+	}, []rule_tester.InvalidTestCase{
+		{
+			Code: "x!;",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+				},
+			},
+		},
+		{
+			Code: "x!.y;",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output:    "x?.y;",
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: "x.y!;",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+				},
+			},
+		},
+		{
+			Code: "!x!.y;",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    2,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output:    "!x?.y;",
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: "x!.y?.z;",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output:    "x?.y?.z;",
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: "x![y];",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output:    "x?.[y];",
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: "x![y]?.z;",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output:    "x?.[y]?.z;",
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: "x.y.z!();",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output:    "x.y.z?.();",
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: "x.y?.z!();",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output:    "x.y?.z?.();",
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: "x!();",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output:    "x?.();",
+						},
+					},
+				},
+			},
+		},
+		// some weirder cases that are stupid but valid
+		{
+			Code: "x!!!;",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					EndColumn: 5,
+				},
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					EndColumn: 4,
+				},
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					EndColumn: 3,
+				},
+			},
+		},
+		{
+			Code: "x!!.y;",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					EndColumn: 4,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output:    "x!?.y;",
+						},
+					},
+				},
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					EndColumn: 3,
+				},
+			},
+		},
+		{
+			Code: "x.y!!;",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					EndColumn: 6,
+				},
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					EndColumn: 5,
+				},
+			},
+		},
+		{
+			Code: "x.y.z!!();",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					EndColumn: 8,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output:    "x.y.z!?.();",
+						},
+					},
+				},
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					EndColumn: 7,
+				},
+			},
+		},
+		{
+			Code: "x!?.[y].z;",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output:    "x?.[y].z;",
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: "x!?.y.z;",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output:    "x?.y.z;",
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: "x.y.z!?.();",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output:    "x.y.z?.();",
+						},
+					},
+				},
+			},
+		},
+		// Inside an optional chain, a trailing assertion has nothing to become optional
+		{
+			Code: "x?.y!;",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+				},
+			},
+		},
+		{
+			Code: "x?.y!.z;",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      1,
+					Column:    1,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output:    "x?.y?.z;",
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: `
+x!
+.y
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      2,
+					Column:    1,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output: `
+x
+?.y
+      `,
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: `
+x!
+// comment
+.y
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      2,
+					Column:    1,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output: `
+x
+// comment
+?.y
+      `,
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: `
+x!
+ // comment
+    . /* comment */
+      y
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      2,
+					Column:    1,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output: `
+x
+ // comment
+    ?. /* comment */
+      y
+      `,
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: `
+x!
+ // comment
+     /* comment */ ['y']
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "noNonNull",
+					Line:      2,
+					Column:    1,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "suggestOptionalChain",
+							Output: `
+x?.
+ // comment
+     /* comment */ ['y']
+      `,
+						},
+					},
+				},
+			},
+		},
+	})
+}
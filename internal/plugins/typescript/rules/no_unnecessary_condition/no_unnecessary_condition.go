@@ -3,6 +3,7 @@ package no_unnecessary_condition
 import (
 	"github.com/microsoft/typescript-go/shim/ast"
 	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/microsoft/typescript-go/shim/core"
 	"github.com/web-infra-dev/rslint/internal/rule"
 	"github.com/web-infra-dev/rslint/internal/utils"
 )
@@ -28,12 +29,17 @@ func parseOptions(options any) Options {
 		AllowRuleToRunWithoutStrictNullChecks: false,
 	}
 
-	if options == nil {
-		return opts
+	// Options may arrive as [{...}] or as the object itself
+	var optionsMap map[string]any
+	switch v := options.(type) {
+	case map[string]any:
+		optionsMap = v
+	case []any:
+		if len(v) > 0 {
+			optionsMap, _ = v[0].(map[string]any)
+		}
 	}
-
-	optionsMap, ok := options.(map[string]any)
-	if !ok {
+	if optionsMap == nil {
 		return opts
 	}
 
@@ -113,6 +119,20 @@ func buildNeverOptionalChainMessage() rule.RuleMessage {
 	}
 }
 
+func buildNoStrictNullCheckMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "noStrictNullCheck",
+		Description: "This rule requires the `strictNullChecks` compiler option to be turned on to function correctly.",
+	}
+}
+
+func buildTypeGuardAlreadyIsTypeMessage(typeGuardOrAssertionFunction string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "typeGuardAlreadyIsType",
+		Description: "Unnecessary conditional, expression already has the type being checked by the " + typeGuardOrAssertionFunction + ".",
+	}
+}
+
 func buildNoOverlapMessage() rule.RuleMessage {
 	return rule.RuleMessage{
 		Id:          "noOverlap",
@@ -140,6 +160,7 @@ var NoUnnecessaryConditionRule = rule.CreateRule(rule.Rule{
 		)
 
 		if !strictNullChecksEnabled && !opts.AllowRuleToRunWithoutStrictNullChecks {
+			ctx.ReportRange(core.NewTextRange(0, 0), buildNoStrictNullCheckMessage())
 			return rule.RuleListeners{}
 		}
 
@@ -440,7 +461,61 @@ var NoUnnecessaryConditionRule = rule.CreateRule(rule.Rule{
 			}
 
 			if !canBeNullish && !utils.IsTypeAnyType(typeToCheck) && !utils.IsTypeUnknownType(typeToCheck) {
-				ctx.ReportNode(node, buildNeverOptionalChainMessage())
+				// `a?.b` becomes `a.b`, while `a?.[b]` and `a?.()` drop the `?.` entirely
+				questionDot := utils.TrimNodeTextRange(ctx.SourceFile, node.QuestionDotToken())
+				replacement := ""
+				if ast.IsPropertyAccessExpression(node) {
+					replacement = "."
+				}
+				ctx.ReportRangeWithFixes(questionDot, buildNeverOptionalChainMessage(),
+					rule.RuleFixReplaceRange(questionDot, replacement))
+			}
+		}
+
+		// Check calls to type guards and assertion functions whose argument already satisfies them
+		checkTypePredicateCall := func(node *ast.Node) {
+			signature := checker.Checker_getResolvedSignature(ctx.TypeChecker, node, nil, checker.CheckModeNormal)
+			if signature == nil {
+				return
+			}
+			declaration := checker.Signature_declaration(signature)
+			if declaration == nil || declaration.Type() == nil || declaration.Type().Kind != ast.KindTypePredicate {
+				return
+			}
+			predicate := declaration.Type().AsTypePredicateNode()
+			if predicate.ParameterName == nil || predicate.ParameterName.Kind != ast.KindIdentifier {
+				return
+			}
+
+			// Find the argument passed for the parameter named by the predicate
+			args := node.Arguments()
+			var argument *ast.Node
+			for i, param := range declaration.Parameters() {
+				if param.Name().Kind == ast.KindIdentifier && param.Name().Text() == predicate.ParameterName.Text() {
+					if i < len(args) && args[i].Kind != ast.KindSpreadElement {
+						argument = args[i]
+					}
+					break
+				}
+			}
+			if argument == nil {
+				return
+			}
+
+			// `asserts x` narrows by truthiness, `x is T` and `asserts x is T` by type
+			if predicate.Type == nil {
+				if predicate.AssertsModifier != nil {
+					checkNode(argument, true)
+				}
+				return
+			}
+			argumentType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, argument)
+			if argumentType == ctx.TypeChecker.GetTypeFromTypeNode(predicate.Type) {
+				typeGuardOrAssertionFunction := "type guard"
+				if predicate.AssertsModifier != nil {
+					typeGuardOrAssertionFunction = "assertion function"
+				}
+				ctx.ReportNode(argument, buildTypeGuardAlreadyIsTypeMessage(typeGuardOrAssertionFunction))
 			}
 		}
 
@@ -613,6 +688,9 @@ var NoUnnecessaryConditionRule = rule.CreateRule(rule.Rule{
 			ast.KindCallExpression: func(node *ast.Node) {
 				checkOptionalChain(node)
 				checkArrayPredicate(node)
+				if opts.CheckTypePredicates {
+					checkTypePredicateCall(node)
+				}
 			},
 		}
 	},
@@ -104,6 +104,28 @@ if (num === 0) {}
 		{Code: `for (; true; ) {}`, Options: map[string]any{"allowConstantLoopConditions": true}},
 		{Code: `while (1) {}`, Options: map[string]any{"allowConstantLoopConditions": "only-allowed-literals"}},
 		{Code: `while (0) {}`, Options: map[string]any{"allowConstantLoopConditions": "only-allowed-literals"}},
+		{Code: `while (true) {}`, Options: []any{map[string]any{"allowConstantLoopConditions": true}}},
+		// Type predicates that narrow the argument
+		{Code: `
+declare function isString(x: unknown): x is string;
+declare const val: string | number;
+if (isString(val)) {}
+		`, Options: map[string]any{"checkTypePredicates": true}},
+		{Code: `
+declare function isString(x: unknown): x is string;
+declare const str: string;
+if (isString(str)) {}
+		`},
+		{Code: `
+declare function assert(x: unknown): asserts x;
+declare const val: string;
+assert(val);
+		`, Options: map[string]any{"checkTypePredicates": true}},
+		// Optional chaining on nullable values
+		{Code: `
+declare const obj: { prop: string } | undefined;
+const result = obj?.prop;
+		`},
 		// Array predicate methods with proper return types
 		{Code: `
 declare const arr: number[];
@@ -275,8 +297,83 @@ if (!true) {}
 declare const obj: { prop: string };
 const result = obj?.prop;
 			`,
+			Output: []string{`
+declare const obj: { prop: string };
+const result = obj.prop;
+			`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "neverOptionalChain", Line: 3, Column: 19},
+			},
+		},
+		{
+			Code: `
+declare const arr: string[];
+const first = arr?.[0];
+			`,
+			Output: []string{`
+declare const arr: string[];
+const first = arr[0];
+			`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "neverOptionalChain", Line: 3, Column: 18},
+			},
+		},
+		{
+			Code: `
+declare const fn: () => void;
+fn?.();
+			`,
+			Output: []string{`
+declare const fn: () => void;
+fn();
+			`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "neverOptionalChain", Line: 3, Column: 3},
+			},
+		},
+		// Type predicates whose argument already has the checked type
+		{
+			Code: `
+declare function isString(x: unknown): x is string;
+declare const str: string;
+if (isString(str)) {}
+			`,
+			Options: map[string]any{"checkTypePredicates": true},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "typeGuardAlreadyIsType", Line: 4, Column: 14},
+			},
+		},
+		{
+			Code: `
+declare function assertString(x: unknown): asserts x is string;
+declare const str: string;
+assertString(str);
+			`,
+			Options: []any{map[string]any{"checkTypePredicates": true}},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "typeGuardAlreadyIsType", Line: 4, Column: 14},
+			},
+		},
+		{
+			Code: `
+declare function assert(x: unknown): asserts x;
+declare const obj: object;
+assert(obj);
+			`,
+			Options: map[string]any{"checkTypePredicates": true},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "alwaysTruthy", Line: 4, Column: 8},
+			},
+		},
+		// strictNullChecks is required unless explicitly allowed
+		{
+			Code: `
+declare const str: string;
+if (str) {}
+			`,
+			TSConfig: "tsconfig.unstrict.json",
 			Errors: []rule_tester.InvalidTestCaseError{
-				{MessageId: "neverOptionalChain", Line: 3},
+				{MessageId: "noStrictNullCheck"},
 			},
 		},
 		// Literal comparison
@@ -212,6 +212,50 @@ f<Bar>();
 	}, []rule_tester.InvalidTestCase{
 		{
 			Code: `
+type Default = { value: string };
+type Foo<T = Default> = { box: T };
+let foo: Foo<Default>;
+      `,
+			Output: []string{`
+type Default = { value: string };
+type Foo<T = Default> = { box: T };
+let foo: Foo;
+      `,
+			},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "unnecessaryTypeParameter",
+					Line:      4,
+					Column:    14,
+				},
+			},
+		},
+		{
+			Code: `
+type Default = { value: string };
+interface Foo<T, U = Default, V = number> {}
+let foo: Foo<string, Default, number>;
+      `,
+			Output: []string{`
+type Default = { value: string };
+interface Foo<T, U = Default, V = number> {}
+let foo: Foo<string, Default>;
+      `, `
+type Default = { value: string };
+interface Foo<T, U = Default, V = number> {}
+let foo: Foo<string>;
+      `,
+			},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "unnecessaryTypeParameter",
+					Line:      4,
+					Column:    31,
+				},
+			},
+		},
+		{
+			Code: `
 function f<T = number>() {}
 f<number>();
       `,
@@ -243,6 +243,10 @@ var NoUnsafeAssignmentRule = rule.CreateRule(rule.Rule{
 						// don't handle rests as they're not a 1:1 assignment
 						continue
 					}
+					if ast.IsOmittedExpression(receiverElement) {
+						// holes don't receive anything
+						continue
+					}
 
 					if checkArrayElement(receiverElement, receiverIndex) {
 						didReport = true
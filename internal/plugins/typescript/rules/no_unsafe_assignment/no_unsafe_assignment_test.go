@@ -136,6 +136,9 @@ declare function Foo(props: { a: string }): never;
       `,
 			Tsx: true,
 		},
+		{Code: "const { a }: { a: string } = { a: 'x' };"},
+		{Code: "const [, b] = [1 as any, 2] as [any, number];"},
+		{Code: "const [a, { b: [c] }] = [1, { b: ['x'] }] as [number, { b: [string] }];"},
 		{Code: "const x: unknown = y as any;"},
 		{Code: "const x: unknown[] = y as any[];"},
 		{Code: "const x: Set<unknown> = y as Set<any>;"},
@@ -376,6 +379,53 @@ const x = [...([] as any[])];
 		}),
 
 		[]rule_tester.InvalidTestCase{
+			{
+				Code: `
+declare const anyVal: any;
+const { a }: { a: string } = anyVal;
+      `,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "anyAssignment",
+						Line:      3,
+						Column:    7,
+						EndColumn: 36,
+					},
+				},
+			},
+			{
+				Code: "const [, b] = [1, 2 as any] as [number, any];",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unsafeArrayPatternFromTuple",
+						Line:      1,
+						Column:    10,
+						EndColumn: 11,
+					},
+				},
+			},
+			{
+				Code: "const [a, { b: [c] }] = [1, { b: [2] }] as [number, { b: [any] }];",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unsafeArrayPatternFromTuple",
+						Line:      1,
+						Column:    17,
+						EndColumn: 18,
+					},
+				},
+			},
+			{
+				Code: "const { a, b: { c: [d] } } = { a: 1, b: { c: [2] } } as { a: number; b: { c: [any] } };",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unsafeArrayPatternFromTuple",
+						Line:      1,
+						Column:    21,
+						EndColumn: 22,
+					},
+				},
+			},
 			{
 				Code: "const x = { y: 1 as any };",
 				Errors: []rule_tester.InvalidTestCaseError{
@@ -25,6 +25,12 @@ function foo(x: { a?: () => void }) {
 }
     `},
 		{Code: "new Map();"},
+		{Code: `
+declare const tag: (strings: TemplateStringsArray, ...values: unknown[]) => string;
+declare const Ctor: new () => object;
+tag` + "`" + `template ${1}` + "`" + `;
+new Ctor();
+    `},
 		{Code: "String.raw`foo`;"},
 		{Code: "const x = import('./foo');"},
 		{Code: `
@@ -236,6 +242,41 @@ function foo(x: { tag: any }) {
 		},
 		{
 			Code: `
+declare const anyVal: any;
+anyVal` + "`" + `template ${1}` + "`" + `;
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "unsafeTemplateTag",
+					Line:      3,
+					Column:    1,
+					EndColumn: 7,
+				},
+			},
+		},
+		{
+			Code: `
+declare const anyVal: any;
+new anyVal();
+new anyVal;
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "unsafeNew",
+					Line:      3,
+					Column:    1,
+					EndColumn: 13,
+				},
+				{
+					MessageId: "unsafeNew",
+					Line:      4,
+					Column:    1,
+					EndColumn: 11,
+				},
+			},
+		},
+		{
+			Code: `
 const methods = {
   methodA() {
     return this.methodB()
@@ -0,0 +1,71 @@
+package no_unsafe_function_type
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+func buildBannedFunctionTypeMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "bannedFunctionType",
+		Description: "The `Function` type accepts any function-like value.\nPrefer explicitly defining any function parameters and return type.",
+	}
+}
+
+// needsParens checks whether a function type replacing node must be parenthesized
+func needsParens(node *ast.Node) bool {
+	parent := node.Parent
+	switch parent.Kind {
+	case ast.KindArrayType, ast.KindUnionType, ast.KindIntersectionType, ast.KindTypeOperator, ast.KindOptionalType:
+		return true
+	case ast.KindIndexedAccessType:
+		return parent.AsIndexedAccessTypeNode().ObjectType == node
+	case ast.KindConditionalType:
+		conditional := parent.AsConditionalTypeNode()
+		return conditional.CheckType == node || conditional.ExtendsType == node
+	}
+	return false
+}
+
+var NoUnsafeFunctionTypeRule = rule.CreateRule(rule.Rule{
+	Name: "no-unsafe-function-type",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		// isReferenceToGlobalFunction checks for `Function` not shadowed by a user declaration
+		isReferenceToGlobalFunction := func(node *ast.Node) bool {
+			if node.Kind != ast.KindIdentifier || node.Text() != "Function" {
+				return false
+			}
+			symbol := ctx.TypeChecker.GetSymbolAtLocation(node)
+			return symbol == nil || utils.IsSymbolFromDefaultLibrary(ctx.Program, symbol)
+		}
+
+		return rule.RuleListeners{
+			ast.KindTypeReference: func(node *ast.Node) {
+				typeRef := node.AsTypeReferenceNode()
+				if typeRef.TypeArguments != nil || !isReferenceToGlobalFunction(typeRef.TypeName) {
+					return
+				}
+				replacement := "(...args: any[]) => any"
+				if needsParens(node) {
+					replacement = "(" + replacement + ")"
+				}
+				ctx.ReportNodeWithFixes(node, buildBannedFunctionTypeMessage(), rule.RuleFixReplace(ctx.SourceFile, node, replacement))
+			},
+			ast.KindExpressionWithTypeArguments: func(node *ast.Node) {
+				// `class Foo implements Function` and `interface Foo extends Function`
+				if node.Parent.Kind != ast.KindHeritageClause {
+					return
+				}
+				clause := node.Parent.AsHeritageClause()
+				if clause.Token == ast.KindExtendsKeyword && node.Parent.Parent.Kind != ast.KindInterfaceDeclaration {
+					return
+				}
+				if !isReferenceToGlobalFunction(node.AsExpressionWithTypeArguments().Expression) {
+					return
+				}
+				ctx.ReportNode(node, buildBannedFunctionTypeMessage())
+			},
+		}
+	},
+})
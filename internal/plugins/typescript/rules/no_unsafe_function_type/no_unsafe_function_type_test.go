@@ -0,0 +1,66 @@
+package no_unsafe_function_type
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoUnsafeFunctionTypeRule(t *testing.T) {
+	rule_tester.RunRuleTester(fixtures.GetRootDir(), "tsconfig.json", t, &NoUnsafeFunctionTypeRule, []rule_tester.ValidTestCase{
+		{Code: "let value: () => void;"},
+		{Code: "let value: <T>(t: T) => T;"},
+		{Code: `
+// create a scope since it's illegal to declare a duplicate identifier
+// 'Function' in the global script scope.
+{
+  type Function = () => void;
+  let value: Function;
+}
+    `},
+		{Code: "class Weird extends Function {}"},
+	}, []rule_tester.InvalidTestCase{
+		{
+			Code:   "let value: Function;",
+			Output: []string{"let value: (...args: any[]) => any;"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "bannedFunctionType", Line: 1, Column: 12},
+			},
+		},
+		{
+			Code:   "let value: Function[];",
+			Output: []string{"let value: ((...args: any[]) => any)[];"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "bannedFunctionType", Line: 1, Column: 12},
+			},
+		},
+		{
+			Code:   "let value: Function | number;",
+			Output: []string{"let value: ((...args: any[]) => any) | number;"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "bannedFunctionType", Line: 1, Column: 12},
+			},
+		},
+		{
+			Code: `
+class Weird implements Function {
+  // ...
+}
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "bannedFunctionType", Line: 2, Column: 24},
+			},
+		},
+		{
+			Code: `
+interface Weird extends Function {
+  // ...
+}
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "bannedFunctionType", Line: 2, Column: 25},
+			},
+		},
+	})
+}
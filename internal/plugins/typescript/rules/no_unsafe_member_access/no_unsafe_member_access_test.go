@@ -139,6 +139,72 @@ function foo(x: any) {
 		},
 		{
 			Code: `
+function foo(x: any) {
+  x[0].foo;
+}
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "unsafeMemberExpression",
+					Line:      3,
+					Column:    5,
+					EndColumn: 6,
+				},
+			},
+		},
+		{
+			Code: `
+function foo(x: any, i: number) {
+  x[i].foo[i + 1];
+}
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "unsafeMemberExpression",
+					Line:      3,
+					Column:    5,
+					EndColumn: 6,
+				},
+			},
+		},
+		{
+			Code: `
+function foo(x: { a: any[] }) {
+  x.a[0].foo;
+}
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "unsafeMemberExpression",
+					Line:      3,
+					Column:    10,
+					EndColumn: 13,
+				},
+			},
+		},
+		{
+			Code: `
+function foo(x: any) {
+  x[0]()[1].foo;
+}
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "unsafeMemberExpression",
+					Line:      3,
+					Column:    10,
+					EndColumn: 11,
+				},
+				{
+					MessageId: "unsafeMemberExpression",
+					Line:      3,
+					Column:    5,
+					EndColumn: 6,
+				},
+			},
+		},
+		{
+			Code: `
 let value: NotKnown;
 
 value.property;
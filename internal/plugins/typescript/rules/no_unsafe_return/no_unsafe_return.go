@@ -28,6 +28,18 @@ func buildUnsafeReturnThisMessage(t string) rule.RuleMessage {
 			"You can try to fix this by turning on the `noImplicitThis` compiler option, or adding a `this` parameter to the function.",
 	}
 }
+func buildUnsafeYieldMessage(t string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unsafeYield",
+		Description: fmt.Sprintf("Unsafe yield of a value of type %v.", t),
+	}
+}
+func buildUnsafeYieldAssignmentMessage(sender, receiver string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unsafeYieldAssignment",
+		Description: fmt.Sprintf("Unsafe yield of type `%v` from generator with yield type `%v`.", sender, receiver),
+	}
+}
 
 var NoUnsafeReturnRule = rule.CreateRule(rule.Rule{
 	Name: "no-unsafe-return",
@@ -163,6 +175,59 @@ var NoUnsafeReturnRule = rule.CreateRule(rule.Rule{
 			ctx.ReportNode(reportingNode, buildUnsafeReturnAssignmentMessage(ctx.TypeChecker.TypeToString(sender), ctx.TypeChecker.TypeToString(receiver)))
 		}
 
+		// checkYield checks yielded values against the yield type of the generator's declared return type,
+		// e.g. `Generator<string>` or `AsyncIterable<string>`
+		checkYield := func(
+			yieldedNode *ast.Node,
+			reportingNode *ast.Node,
+		) {
+			// the contextual type of a yield operand is only known when the generator has a declared return type
+			yieldType := checker.Checker_getContextualType(ctx.TypeChecker, yieldedNode, checker.ContextFlagsNone)
+			if yieldType == nil || utils.IsTypeFlagSet(yieldType, checker.TypeFlagsAny|checker.TypeFlagsUnknown) {
+				return
+			}
+
+			yieldedType := utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, yieldedNode)
+			anyType := utils.DiscriminateAnyType(
+				yieldedType,
+				ctx.TypeChecker,
+				ctx.Program,
+				yieldedNode,
+			)
+
+			functionNode := utils.GetParentFunctionNode(yieldedNode)
+			isAsync := functionNode != nil && ast.HasSyntacticModifier(functionNode, ast.ModifierFlagsAsync)
+
+			switch anyType {
+			case utils.DiscriminatedAnyTypeAny:
+				ctx.ReportNode(reportingNode, buildUnsafeYieldMessage("`any`"))
+				return
+			case utils.DiscriminatedAnyTypeAnyArray:
+				if !utils.IsTypeUnknownArrayType(yieldType, ctx.TypeChecker) {
+					ctx.ReportNode(reportingNode, buildUnsafeYieldMessage("`any[]`"))
+				}
+				return
+			case utils.DiscriminatedAnyTypePromiseAny:
+				// async generators await yielded promises
+				if isAsync {
+					ctx.ReportNode(reportingNode, buildUnsafeYieldMessage("`Promise<any>`"))
+					return
+				}
+			}
+
+			receiver, sender, unsafe := utils.IsUnsafeAssignment(
+				yieldedType,
+				yieldType,
+				ctx.TypeChecker,
+				yieldedNode,
+			)
+			if !unsafe {
+				return
+			}
+
+			ctx.ReportNode(reportingNode, buildUnsafeYieldAssignmentMessage(ctx.TypeChecker.TypeToString(sender), ctx.TypeChecker.TypeToString(receiver)))
+		}
+
 		return rule.RuleListeners{
 			ast.KindArrowFunction: func(node *ast.Node) {
 				body := node.Body()
@@ -178,6 +243,15 @@ var NoUnsafeReturnRule = rule.CreateRule(rule.Rule{
 
 				checkReturn(argument, node)
 			},
+			ast.KindYieldExpression: func(node *ast.Node) {
+				yield := node.AsYieldExpression()
+				// `yield*` delegates to another iterable, whose values are checked where they are produced
+				if yield.Expression == nil || yield.AsteriskToken != nil {
+					return
+				}
+
+				checkYield(yield.Expression, node)
+			},
 		}
 	},
 })
@@ -156,6 +156,41 @@ function foo(): Set<number> {
           resolve();
         }
       }
+    `},
+		{Code: `
+declare const value: any;
+function* foo() {
+  yield value;
+}
+    `},
+		{Code: `
+declare const value: any;
+function* foo(): Generator<unknown> {
+  yield value;
+}
+    `},
+		{Code: `
+declare const value: any;
+function* foo(): Generator<any> {
+  yield value;
+}
+    `},
+		{Code: `
+function* foo(): Generator<unknown[]> {
+  yield [] as any[];
+}
+    `},
+		{Code: `
+declare const values: any;
+function* foo(): Generator<number> {
+  yield* values;
+}
+    `},
+		{Code: `
+declare const value: Promise<any>;
+function* foo(): Generator<Promise<unknown>> {
+  yield value;
+}
     `},
 	}, []rule_tester.InvalidTestCase{
 		{
@@ -602,5 +637,91 @@ async function foo() {
 				},
 			},
 		},
+		{
+			Code: `
+declare const value: any;
+const foo = async (): Promise<string[]> => value as any[];
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "unsafeReturn",
+					Line:      3,
+					Column:    44,
+				},
+			},
+		},
+		{
+			Code: `
+declare const value: any;
+function* foo(): Generator<string> {
+  yield value;
+}
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "unsafeYield",
+					Line:      4,
+					Column:    3,
+				},
+			},
+		},
+		{
+			Code: `
+function* foo(): Iterable<string[]> {
+  yield [] as any[];
+}
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "unsafeYield",
+					Line:      3,
+					Column:    3,
+				},
+			},
+		},
+		{
+			Code: `
+declare const value: any;
+async function* foo(): AsyncGenerator<number> {
+  yield value;
+}
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "unsafeYield",
+					Line:      4,
+					Column:    3,
+				},
+			},
+		},
+		{
+			Code: `
+declare const value: Promise<any>;
+async function* foo(): AsyncGenerator<number> {
+  yield value;
+}
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "unsafeYield",
+					Line:      4,
+					Column:    3,
+				},
+			},
+		},
+		{
+			Code: `
+function* foo(): Generator<Set<string>> {
+  yield new Set<any>();
+}
+      `,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "unsafeYieldAssignment",
+					Line:      3,
+					Column:    3,
+				},
+			},
+		},
 	})
 }
@@ -0,0 +1,65 @@
+package no_wrapper_object_types
+
+import (
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+func buildBannedClassTypeMessage(typeName string, preferred string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "bannedClassType",
+		Description: "Prefer using the primitive `" + preferred + "` as a type name, rather than the upper-cased `" + typeName + "`.",
+	}
+}
+
+var classNames = []string{"BigInt", "Boolean", "Number", "Object", "String", "Symbol"}
+
+var NoWrapperObjectTypesRule = rule.CreateRule(rule.Rule{
+	Name: "no-wrapper-object-types",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		// checkBannedTypes reports references to the global wrapper types, fixing them
+		// to the primitive type where the primitive is allowed
+		checkBannedTypes := func(node *ast.Node, includeFix bool) {
+			if node.Kind != ast.KindIdentifier {
+				return
+			}
+			typeName := node.Text()
+			if !utils.Some(classNames, func(name string) bool { return name == typeName }) {
+				return
+			}
+
+			symbol := ctx.TypeChecker.GetSymbolAtLocation(node)
+			if symbol != nil && !utils.IsSymbolFromDefaultLibrary(ctx.Program, symbol) {
+				return
+			}
+
+			preferred := strings.ToLower(typeName)
+			msg := buildBannedClassTypeMessage(typeName, preferred)
+			if includeFix {
+				ctx.ReportNodeWithFixes(node, msg, rule.RuleFixReplace(ctx.SourceFile, node, preferred))
+			} else {
+				ctx.ReportNode(node, msg)
+			}
+		}
+
+		return rule.RuleListeners{
+			ast.KindTypeReference: func(node *ast.Node) {
+				checkBannedTypes(node.AsTypeReferenceNode().TypeName, true)
+			},
+			ast.KindExpressionWithTypeArguments: func(node *ast.Node) {
+				// `class Foo implements Number` and `interface Foo extends Number`
+				if node.Parent.Kind != ast.KindHeritageClause {
+					return
+				}
+				clause := node.Parent.AsHeritageClause()
+				if clause.Token == ast.KindExtendsKeyword && node.Parent.Parent.Kind != ast.KindInterfaceDeclaration {
+					return
+				}
+				checkBannedTypes(node.AsExpressionWithTypeArguments().Expression, false)
+			},
+		}
+	},
+})
@@ -0,0 +1,102 @@
+package prefer_at
+
+import (
+	"strconv"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+func buildPreferAtMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "preferAt",
+		Description: "Prefer `.at(…)` over `[….length - index]`.",
+	}
+}
+
+// getIndexFromEnd returns N for an `object.length - N` index, where N is a positive integer literal
+func getIndexFromEnd(object *ast.Node, index *ast.Node) (string, bool) {
+	index = ast.SkipParentheses(index)
+	if !ast.IsBinaryExpression(index) {
+		return "", false
+	}
+	binary := index.AsBinaryExpression()
+	if binary.OperatorToken.Kind != ast.KindMinusToken {
+		return "", false
+	}
+
+	length := ast.SkipParentheses(binary.Left)
+	if !ast.IsPropertyAccessExpression(length) || length.AsPropertyAccessExpression().QuestionDotToken != nil ||
+		length.Name().Text() != "length" || !utils.IsSameReference(length.Expression(), object) {
+		return "", false
+	}
+
+	right := ast.SkipParentheses(binary.Right)
+	if !ast.IsNumericLiteral(right) {
+		return "", false
+	}
+	value, err := strconv.Atoi(right.Text())
+	if err != nil || value < 1 {
+		return "", false
+	}
+	return right.Text(), true
+}
+
+// isReadOnlyUsage checks whether replacing the element access with a call keeps the behavior, which rules out
+// writes, deletes and method calls relying on the array as `this`
+func isReadOnlyUsage(node *ast.Node) bool {
+	if ast.IsAssignmentTarget(node) {
+		return false
+	}
+	parent := node.Parent
+	switch parent.Kind {
+	case ast.KindDeleteExpression:
+		return false
+	case ast.KindCallExpression:
+		return parent.Expression() != node
+	case ast.KindTaggedTemplateExpression:
+		return parent.AsTaggedTemplateExpression().Tag != node
+	}
+	return true
+}
+
+var PreferAtRule = rule.CreateRule(rule.Rule{
+	Name: "prefer-at",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		// isArrayOrString checks that every constituent of t has an `at` method with the same semantics as indexing
+		isArrayOrString := func(t *checker.Type) bool {
+			return utils.Every(utils.UnionTypeParts(t), func(part *checker.Type) bool {
+				return utils.IsTypeFlagSet(part, checker.TypeFlagsStringLike) || checker.Checker_isArrayOrTupleType(ctx.TypeChecker, part)
+			})
+		}
+
+		return rule.RuleListeners{
+			ast.KindElementAccessExpression: func(node *ast.Node) {
+				access := node.AsElementAccessExpression()
+				if access.QuestionDotToken != nil || !isReadOnlyUsage(node) {
+					return
+				}
+
+				index, ok := getIndexFromEnd(access.Expression, access.ArgumentExpression)
+				if !ok {
+					return
+				}
+
+				if !isArrayOrString(utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, access.Expression)) {
+					return
+				}
+
+				// Replace everything after the object, i.e. `[arr.length - 1]`
+				textRange := core.NewTextRange(access.Expression.End(), node.End())
+				if utils.HasCommentsInRange(ctx.SourceFile, textRange) {
+					ctx.ReportNode(node, buildPreferAtMessage())
+					return
+				}
+				ctx.ReportNodeWithFixes(node, buildPreferAtMessage(), rule.RuleFixReplaceRange(textRange, ".at(-"+index+")"))
+			},
+		}
+	},
+})
@@ -0,0 +1,137 @@
+package prefer_at
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestPreferAtRule(t *testing.T) {
+	rule_tester.RunRuleTester(fixtures.GetRootDir(), "tsconfig.json", t, &PreferAtRule, []rule_tester.ValidTestCase{
+		{Code: "declare const arr: number[]; arr.at(-1);"},
+		{Code: "declare const arr: number[]; arr[arr.length - 1] = 0;"},
+		{Code: "declare const arr: number[]; arr[arr.length - 1]++;"},
+		{Code: "declare const arr: number[]; [arr[arr.length - 1]] = [0];"},
+		{Code: "declare const arr: number[]; delete arr[arr.length - 1];"},
+		{Code: "declare const fns: (() => void)[]; fns[fns.length - 1]();"},
+		{Code: "declare const a: number[]; declare const b: number[]; a[b.length - 1];"},
+		{Code: "declare const arr: number[]; arr[arr.length + 1];"},
+		{Code: "declare const arr: number[]; declare const n: number; arr[arr.length - n];"},
+		{Code: "declare const arr: number[]; arr[arr.length - 0];"},
+		{Code: "declare const arr: number[]; arr[arr.length - 1.5];"},
+		{Code: "declare const arr: number[] | undefined; arr?.[arr.length - 1];"},
+		{Code: "declare function getArr(): number[]; getArr()[getArr().length - 1];"},
+		{Code: "declare const bytes: Uint8Array; bytes[bytes.length - 1];"},
+		{Code: "declare const arrayLike: { length: number; [index: number]: string }; arrayLike[arrayLike.length - 1];"},
+		{Code: "declare const mixed: number[] | { length: number; [index: number]: number }; mixed[mixed.length - 1];"},
+	}, []rule_tester.InvalidTestCase{
+		{
+			Code:   "declare const arr: number[]; const last = arr[arr.length - 1];",
+			Output: []string{"declare const arr: number[]; const last = arr.at(-1);"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "preferAt",
+					Line:      1,
+					Column:    43,
+					EndColumn: 62,
+				},
+			},
+		},
+		{
+			Code:   "declare const str: string; str[str.length - 1];",
+			Output: []string{"declare const str: string; str.at(-1);"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "preferAt",
+					Line:      1,
+					Column:    28,
+					EndColumn: 47,
+				},
+			},
+		},
+		{
+			Code:   "declare const arr: readonly string[]; arr[arr.length - 2];",
+			Output: []string{"declare const arr: readonly string[]; arr.at(-2);"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "preferAt",
+					Line:      1,
+					Column:    39,
+					EndColumn: 58,
+				},
+			},
+		},
+		{
+			Code:   "declare const tuple: [number, string]; tuple[(tuple.length - 1)];",
+			Output: []string{"declare const tuple: [number, string]; tuple.at(-1);"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "preferAt",
+					Line:      1,
+					Column:    40,
+					EndColumn: 65,
+				},
+			},
+		},
+		{
+			Code:   "declare const obj: { items: number[] }; obj.items[obj.items.length - 1];",
+			Output: []string{"declare const obj: { items: number[] }; obj.items.at(-1);"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "preferAt",
+					Line:      1,
+					Column:    41,
+					EndColumn: 72,
+				},
+			},
+		},
+		{
+			Code:   "declare const value: string | number[]; value[value.length - 1];",
+			Output: []string{"declare const value: string | number[]; value.at(-1);"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "preferAt",
+					Line:      1,
+					Column:    41,
+					EndColumn: 64,
+				},
+			},
+		},
+		{
+			Code:   "function last<T extends unknown[]>(items: T) { return items[items.length - 1]; }",
+			Output: []string{"function last<T extends unknown[]>(items: T) { return items.at(-1); }"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "preferAt",
+					Line:      1,
+					Column:    55,
+					EndColumn: 78,
+				},
+			},
+		},
+		{
+			Code:   "declare const arr: number[]; foo(arr[arr.length - 1]);",
+			Output: []string{"declare const arr: number[]; foo(arr.at(-1));"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "preferAt",
+					Line:      1,
+					Column:    34,
+					EndColumn: 53,
+				},
+			},
+		},
+		{
+			Code: "declare const arr: number[]; arr[arr.length /* last */ - 1];",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "preferAt",
+					Line:      1,
+					Column:    30,
+					EndColumn: 60,
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,242 @@
+package prefer_nullish_coalescing
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+type IgnorePrimitives struct {
+	Bigint  bool `json:"bigint"`
+	Boolean bool `json:"boolean"`
+	Number  bool `json:"number"`
+	String  bool `json:"string"`
+}
+
+type PreferNullishCoalescingOptions struct {
+	IgnoreConditionalTests        bool             `json:"ignoreConditionalTests"`
+	IgnoreMixedLogicalExpressions bool             `json:"ignoreMixedLogicalExpressions"`
+	IgnorePrimitives              IgnorePrimitives `json:"ignorePrimitives"`
+}
+
+func parseOptions(options any) PreferNullishCoalescingOptions {
+	opts := PreferNullishCoalescingOptions{
+		IgnoreConditionalTests: true,
+	}
+
+	var optsMap map[string]interface{}
+	if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+		optsMap, _ = optArray[0].(map[string]interface{})
+	} else {
+		optsMap, _ = options.(map[string]interface{})
+	}
+
+	if optsMap != nil {
+		if v, ok := optsMap["ignoreConditionalTests"].(bool); ok {
+			opts.IgnoreConditionalTests = v
+		}
+		if v, ok := optsMap["ignoreMixedLogicalExpressions"].(bool); ok {
+			opts.IgnoreMixedLogicalExpressions = v
+		}
+		switch v := optsMap["ignorePrimitives"].(type) {
+		case bool:
+			// `true` ignores every primitive
+			opts.IgnorePrimitives = IgnorePrimitives{Bigint: v, Boolean: v, Number: v, String: v}
+		case map[string]interface{}:
+			opts.IgnorePrimitives.Bigint, _ = v["bigint"].(bool)
+			opts.IgnorePrimitives.Boolean, _ = v["boolean"].(bool)
+			opts.IgnorePrimitives.Number, _ = v["number"].(bool)
+			opts.IgnorePrimitives.String, _ = v["string"].(bool)
+		}
+	}
+	return opts
+}
+
+func buildPreferNullishOverOrMessage(equals string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "preferNullishOverOr",
+		Description: "Prefer using nullish coalescing operator (`??" + equals + "`) instead of a logical or (`||" + equals + "`), as it is a safer operator.",
+	}
+}
+
+func buildSuggestNullishMessage(equals string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "suggestNullish",
+		Description: "Fix to nullish coalescing operator (`??" + equals + "`).",
+	}
+}
+
+func isLogicalExpression(node *ast.Node) bool {
+	if node.Kind != ast.KindBinaryExpression {
+		return false
+	}
+	kind := node.AsBinaryExpression().OperatorToken.Kind
+	return kind == ast.KindBarBarToken || kind == ast.KindAmpersandAmpersandToken
+}
+
+// isConditionalTest checks whether the value of node ends up as the test of a conditional
+func isConditionalTest(node *ast.Node) bool {
+	parent := ast.WalkUpParenthesizedExpressions(node.Parent)
+	if parent == nil {
+		return false
+	}
+
+	switch parent.Kind {
+	case ast.KindBinaryExpression:
+		binary := parent.AsBinaryExpression()
+		switch binary.OperatorToken.Kind {
+		case ast.KindBarBarToken, ast.KindAmpersandAmpersandToken, ast.KindQuestionQuestionToken:
+			return isConditionalTest(parent)
+		case ast.KindCommaToken:
+			return ast.SkipParentheses(binary.Right) == node && isConditionalTest(parent)
+		}
+	case ast.KindPrefixUnaryExpression:
+		return parent.AsPrefixUnaryExpression().Operator == ast.KindExclamationToken && isConditionalTest(parent)
+	case ast.KindConditionalExpression:
+		conditional := parent.AsConditionalExpression()
+		if ast.SkipParentheses(conditional.Condition) == node {
+			return true
+		}
+		return isConditionalTest(parent)
+	case ast.KindIfStatement:
+		return ast.SkipParentheses(parent.AsIfStatement().Expression) == node
+	case ast.KindWhileStatement:
+		return ast.SkipParentheses(parent.AsWhileStatement().Expression) == node
+	case ast.KindDoStatement:
+		return ast.SkipParentheses(parent.AsDoStatement().Expression) == node
+	case ast.KindForStatement:
+		condition := parent.AsForStatement().Condition
+		return condition != nil && ast.SkipParentheses(condition) == node
+	}
+	return false
+}
+
+// isMixedLogicalExpression checks whether node is part of a chain of logical expressions containing `&&`
+func isMixedLogicalExpression(node *ast.Node) bool {
+	seen := make(map[*ast.Node]bool)
+	binary := node.AsBinaryExpression()
+	parent := ast.WalkUpParenthesizedExpressions(node.Parent)
+	queue := []*ast.Node{parent, binary.Left, binary.Right}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == nil {
+			continue
+		}
+		current = ast.SkipParentheses(current)
+		if seen[current] || !isLogicalExpression(current) {
+			continue
+		}
+		seen[current] = true
+
+		currentBinary := current.AsBinaryExpression()
+		if currentBinary.OperatorToken.Kind == ast.KindAmpersandAmpersandToken {
+			return true
+		}
+		currentParent := ast.WalkUpParenthesizedExpressions(current.Parent)
+		queue = append(queue, currentParent, currentBinary.Left, currentBinary.Right)
+	}
+	return false
+}
+
+// PreferNullishCoalescingRule enforces using the nullish coalescing operator instead of logical assignments or chaining
+var PreferNullishCoalescingRule = rule.CreateRule(rule.Rule{
+	Name: "prefer-nullish-coalescing",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+
+		var ignorableFlags checker.TypeFlags
+		if opts.IgnorePrimitives.Bigint {
+			ignorableFlags |= checker.TypeFlagsBigIntLike
+		}
+		if opts.IgnorePrimitives.Boolean {
+			ignorableFlags |= checker.TypeFlagsBooleanLike
+		}
+		if opts.IgnorePrimitives.Number {
+			ignorableFlags |= checker.TypeFlagsNumberLike
+		}
+		if opts.IgnorePrimitives.String {
+			ignorableFlags |= checker.TypeFlagsStringLike
+		}
+
+		isNullableType := func(t *checker.Type) bool {
+			return utils.Some(utils.UnionTypeParts(t), func(part *checker.Type) bool {
+				return utils.IsTypeFlagSet(part, checker.TypeFlagsAny|checker.TypeFlagsUnknown|checker.TypeFlagsNull|checker.TypeFlagsUndefined|checker.TypeFlagsVoid)
+			})
+		}
+
+		isIgnoredPrimitive := func(t *checker.Type) bool {
+			if ignorableFlags == 0 {
+				return false
+			}
+			return utils.Some(utils.UnionTypeParts(t), func(part *checker.Type) bool {
+				return utils.Some(utils.IntersectionTypeParts(part), func(t *checker.Type) bool {
+					return utils.IsTypeFlagSet(t, ignorableFlags)
+				})
+			})
+		}
+
+		getText := func(node *ast.Node) string {
+			textRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			return ctx.SourceFile.Text()[textRange.Pos():textRange.End()]
+		}
+
+		// buildReplacement rewrites node with `??`, adding the parentheses required to mix it with `||` and `&&`
+		buildReplacement := func(node *ast.Node, operator string) string {
+			binary := node.AsBinaryExpression()
+			text := ctx.SourceFile.Text()
+			leftRange := utils.TrimNodeTextRange(ctx.SourceFile, binary.Left)
+			operatorRange := utils.TrimNodeTextRange(ctx.SourceFile, binary.OperatorToken)
+			rightRange := utils.TrimNodeTextRange(ctx.SourceFile, binary.Right)
+
+			left := getText(binary.Left)
+			right := getText(binary.Right)
+			if operator == "??" {
+				if isLogicalExpression(binary.Left) {
+					left = "(" + left + ")"
+				}
+				if isLogicalExpression(binary.Right) {
+					right = "(" + right + ")"
+				}
+			}
+
+			replacement := left + text[leftRange.End():operatorRange.Pos()] + operator + text[operatorRange.End():rightRange.Pos()] + right
+			if parent := node.Parent; operator == "??" && parent != nil && isLogicalExpression(parent) {
+				replacement = "(" + replacement + ")"
+			}
+			return replacement
+		}
+
+		return rule.RuleListeners{
+			ast.KindBinaryExpression: func(node *ast.Node) {
+				binary := node.AsBinaryExpression()
+				var equals string
+				switch binary.OperatorToken.Kind {
+				case ast.KindBarBarToken:
+				case ast.KindBarBarEqualsToken:
+					equals = "="
+				default:
+					return
+				}
+
+				t := ctx.TypeChecker.GetTypeAtLocation(binary.Left)
+				if !isNullableType(t) || isIgnoredPrimitive(t) {
+					return
+				}
+
+				if opts.IgnoreConditionalTests && isConditionalTest(node) {
+					return
+				}
+				if opts.IgnoreMixedLogicalExpressions && equals == "" && isMixedLogicalExpression(node) {
+					return
+				}
+
+				ctx.ReportNodeWithSuggestions(binary.OperatorToken, buildPreferNullishOverOrMessage(equals), rule.RuleSuggestion{
+					Message:  buildSuggestNullishMessage(equals),
+					FixesArr: []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, node, buildReplacement(node, "??"+equals))},
+				})
+			},
+		}
+	},
+})
@@ -0,0 +1,212 @@
+package prefer_nullish_coalescing
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestPreferNullishCoalescingRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&PreferNullishCoalescingRule,
+		[]rule_tester.ValidTestCase{
+			// Non-nullable left operands
+			{Code: `declare const x: string; x || 'foo';`},
+			{Code: `declare const x: number; x || 1;`},
+			{Code: `declare const x: boolean; x || true;`},
+			{Code: `declare const x: object; x || {};`},
+			{Code: `declare let x: string; x ||= 'foo';`},
+			{Code: `declare const x: string | null; x ?? 'foo';`},
+			{Code: `declare const x: string | null; x && 'foo';`},
+
+			// ignoreConditionalTests
+			{Code: `declare const x: string | undefined; if (x || 'foo') {}`},
+			{Code: `declare const x: string | undefined; while (x || 'foo') {}`},
+			{Code: `declare const x: string | undefined; do {} while (x || 'foo');`},
+			{Code: `declare const x: string | undefined; for (; x || 'foo';) {}`},
+			{Code: `declare const x: string | undefined; (x || 'foo') ? 1 : 2;`},
+			{Code: `declare const x: string | undefined; declare const y: string | undefined; if (!(x || y)) {}`},
+			{Code: `declare const x: string | undefined; declare const y: string | undefined; declare const z: string; if (x || y || z) {}`},
+			{Code: `declare const x: string | undefined; declare const y: boolean; if (y && (x || 'foo')) {}`},
+			{Code: `declare const x: string | undefined; if ((x || 'foo')) {}`, Options: map[string]interface{}{"ignoreConditionalTests": true}},
+
+			// ignoreMixedLogicalExpressions
+			{
+				Code:    `declare const a: string | null; declare const b: string | null; declare const c: string; a || b && c;`,
+				Options: []interface{}{map[string]interface{}{"ignoreMixedLogicalExpressions": true}},
+			},
+			{
+				Code:    `declare const a: string | null; declare const b: string | null; declare const c: string; declare const d: string; a && b || c || d;`,
+				Options: []interface{}{map[string]interface{}{"ignoreMixedLogicalExpressions": true}},
+			},
+			{
+				Code:    `declare const a: string | null; declare const b: string | null; declare const c: string; (a || b) && c;`,
+				Options: []interface{}{map[string]interface{}{"ignoreMixedLogicalExpressions": true}},
+			},
+
+			// ignorePrimitives
+			{Code: `declare const x: string | undefined; x || 'foo';`, Options: map[string]interface{}{"ignorePrimitives": map[string]interface{}{"string": true}}},
+			{Code: `declare const x: number | undefined; x || 1;`, Options: map[string]interface{}{"ignorePrimitives": map[string]interface{}{"number": true}}},
+			{Code: `declare const x: bigint | undefined; x || 1n;`, Options: map[string]interface{}{"ignorePrimitives": map[string]interface{}{"bigint": true}}},
+			{Code: `declare const x: boolean | undefined; x || true;`, Options: map[string]interface{}{"ignorePrimitives": map[string]interface{}{"boolean": true}}},
+			{Code: `declare const x: 'a' | 'b' | null; x || 'a';`, Options: map[string]interface{}{"ignorePrimitives": map[string]interface{}{"string": true}}},
+			{Code: `declare const x: (string & { __brand: 'id' }) | null; x || 'a';`, Options: map[string]interface{}{"ignorePrimitives": map[string]interface{}{"string": true}}},
+			{Code: `declare const x: number | string | undefined; x || 1;`, Options: map[string]interface{}{"ignorePrimitives": true}},
+		},
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `declare const x: string | null; x || 'foo';`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "preferNullishOverOr", Line: 1, Column: 35,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNullish", Output: `declare const x: string | null; x ?? 'foo';`},
+						},
+					},
+				},
+			},
+			{
+				Code: `declare const x: number | undefined; x || 1;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "preferNullishOverOr", Line: 1, Column: 40,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNullish", Output: `declare const x: number | undefined; x ?? 1;`},
+						},
+					},
+				},
+			},
+			{
+				Code: `declare const x: boolean | undefined; x || true;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "preferNullishOverOr", Line: 1, Column: 41,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNullish", Output: `declare const x: boolean | undefined; x ?? true;`},
+						},
+					},
+				},
+			},
+			{
+				Code: `declare const x: any; x || 'foo';`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "preferNullishOverOr", Line: 1, Column: 25,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNullish", Output: `declare const x: any; x ?? 'foo';`},
+						},
+					},
+				},
+			},
+			{
+				Code: `declare let x: string | undefined; x ||= 'foo';`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "preferNullishOverOr", Line: 1, Column: 38,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNullish", Output: `declare let x: string | undefined; x ??= 'foo';`},
+						},
+					},
+				},
+			},
+
+			// Chains
+			{
+				Code: `declare const a: string | null; declare const b: string | null; declare const c: string; a || b || c;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "preferNullishOverOr", Line: 1, Column: 92,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNullish", Output: `declare const a: string | null; declare const b: string | null; declare const c: string; (a ?? b) || c;`},
+						},
+					},
+					{
+						MessageId: "preferNullishOverOr", Line: 1, Column: 97,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNullish", Output: `declare const a: string | null; declare const b: string | null; declare const c: string; (a || b) ?? c;`},
+						},
+					},
+				},
+			},
+			{
+				Code: `declare const a: string | null; declare const b: string; declare const c: string; a || b && c;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "preferNullishOverOr", Line: 1, Column: 85,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNullish", Output: `declare const a: string | null; declare const b: string; declare const c: string; a ?? (b && c);`},
+						},
+					},
+				},
+			},
+
+			// ignoreConditionalTests: false
+			{
+				Code:    `declare const x: string | undefined; if (x || 'foo') {}`,
+				Options: map[string]interface{}{"ignoreConditionalTests": false},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "preferNullishOverOr", Line: 1, Column: 44,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNullish", Output: `declare const x: string | undefined; if (x ?? 'foo') {}`},
+						},
+					},
+				},
+			},
+
+			// Not a conditional test
+			{
+				Code: `declare const x: string | undefined; if (foo(x || 'foo')) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "preferNullishOverOr", Line: 1, Column: 48,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNullish", Output: `declare const x: string | undefined; if (foo(x ?? 'foo')) {}`},
+						},
+					},
+				},
+			},
+			{
+				Code: `declare const x: string | undefined; const y = x ? x || 'a' : 'b';`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "preferNullishOverOr", Line: 1, Column: 54,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNullish", Output: `declare const x: string | undefined; const y = x ? x ?? 'a' : 'b';`},
+						},
+					},
+				},
+			},
+
+			// ignorePrimitives only covers the listed primitives
+			{
+				Code:    `declare const x: number | undefined; x || 1;`,
+				Options: map[string]interface{}{"ignorePrimitives": map[string]interface{}{"string": true}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "preferNullishOverOr", Line: 1, Column: 40,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNullish", Output: `declare const x: number | undefined; x ?? 1;`},
+						},
+					},
+				},
+			},
+			{
+				Code:    `declare const x: { a: string } | undefined; x || {};`,
+				Options: map[string]interface{}{"ignorePrimitives": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "preferNullishOverOr", Line: 1, Column: 47,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNullish", Output: `declare const x: { a: string } | undefined; x ?? {};`},
+						},
+					},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,377 @@
+package prefer_optional_chain
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+type PreferOptionalChainOptions struct {
+	AllowPotentiallyUnsafeFixesThatModifyTheReturnTypeIKnowWhatImDoing bool `json:"allowPotentiallyUnsafeFixesThatModifyTheReturnTypeIKnowWhatImDoing"`
+	CheckAny                                                           bool `json:"checkAny"`
+	CheckBigInt                                                        bool `json:"checkBigInt"`
+	CheckBoolean                                                       bool `json:"checkBoolean"`
+	CheckNumber                                                        bool `json:"checkNumber"`
+	CheckString                                                        bool `json:"checkString"`
+	CheckUnknown                                                       bool `json:"checkUnknown"`
+	RequireNullish                                                     bool `json:"requireNullish"`
+}
+
+func parseOptions(options any) PreferOptionalChainOptions {
+	opts := PreferOptionalChainOptions{
+		CheckAny:     true,
+		CheckBigInt:  true,
+		CheckBoolean: true,
+		CheckNumber:  true,
+		CheckString:  true,
+		CheckUnknown: true,
+	}
+
+	var optsMap map[string]interface{}
+	if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+		optsMap, _ = optArray[0].(map[string]interface{})
+	} else {
+		optsMap, _ = options.(map[string]interface{})
+	}
+
+	if optsMap != nil {
+		if v, ok := optsMap["allowPotentiallyUnsafeFixesThatModifyTheReturnTypeIKnowWhatImDoing"].(bool); ok {
+			opts.AllowPotentiallyUnsafeFixesThatModifyTheReturnTypeIKnowWhatImDoing = v
+		}
+		if v, ok := optsMap["checkAny"].(bool); ok {
+			opts.CheckAny = v
+		}
+		if v, ok := optsMap["checkBigInt"].(bool); ok {
+			opts.CheckBigInt = v
+		}
+		if v, ok := optsMap["checkBoolean"].(bool); ok {
+			opts.CheckBoolean = v
+		}
+		if v, ok := optsMap["checkNumber"].(bool); ok {
+			opts.CheckNumber = v
+		}
+		if v, ok := optsMap["checkString"].(bool); ok {
+			opts.CheckString = v
+		}
+		if v, ok := optsMap["checkUnknown"].(bool); ok {
+			opts.CheckUnknown = v
+		}
+		if v, ok := optsMap["requireNullish"].(bool); ok {
+			opts.RequireNullish = v
+		}
+	}
+	return opts
+}
+
+func buildPreferOptionalChainMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "preferOptionalChain",
+		Description: "Prefer using an optional chain expression instead, as it's more concise and easier to read.",
+	}
+}
+
+func buildOptionalChainSuggestMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "optionalChainSuggest",
+		Description: "Change to an optional chain.",
+	}
+}
+
+// operand is one `&&`-joined part of a chain, checking reference for truthiness or against null
+type operand struct {
+	node      *ast.Node
+	reference *ast.Node
+	// isPlainCheck is true for `foo` and false for comparisons such as `foo != null`
+	isPlainCheck bool
+}
+
+func isAndExpression(node *ast.Node) bool {
+	return node.Kind == ast.KindBinaryExpression && node.AsBinaryExpression().OperatorToken.Kind == ast.KindAmpersandAmpersandToken
+}
+
+func isNullishLiteral(node *ast.Node) bool {
+	node = ast.SkipParentheses(node)
+	return node.Kind == ast.KindNullKeyword || (node.Kind == ast.KindIdentifier && node.Text() == "undefined")
+}
+
+// isChainLink checks for the expressions an optional chain can be built from
+func isChainLink(node *ast.Node) bool {
+	switch node.Kind {
+	case ast.KindPropertyAccessExpression, ast.KindElementAccessExpression, ast.KindCallExpression:
+		return true
+	}
+	return false
+}
+
+// parseOperand returns the reference an operand checks: `foo`, `foo != null` or `foo != undefined`
+func parseOperand(node *ast.Node) *operand {
+	expr := ast.SkipParentheses(node)
+	if expr.Kind == ast.KindBinaryExpression {
+		binary := expr.AsBinaryExpression()
+		if binary.OperatorToken.Kind != ast.KindExclamationEqualsToken || !isNullishLiteral(binary.Right) {
+			return nil
+		}
+		return &operand{node: node, reference: ast.SkipParentheses(binary.Left)}
+	}
+	switch expr.Kind {
+	case ast.KindIdentifier, ast.KindThisKeyword, ast.KindPropertyAccessExpression, ast.KindElementAccessExpression, ast.KindCallExpression:
+		return &operand{node: node, reference: expr, isPlainCheck: true}
+	}
+	return nil
+}
+
+// PreferOptionalChainRule enforces using concise optional chain expressions instead of chained logical ands
+var PreferOptionalChainRule = rule.CreateRule(rule.Rule{
+	Name: "prefer-optional-chain",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+
+		getText := func(node *ast.Node) string {
+			textRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			return ctx.SourceFile.Text()[textRange.Pos():textRange.End()]
+		}
+
+		getArgumentsText := func(node *ast.Node) string {
+			text := ""
+			for _, argument := range node.Arguments() {
+				text += getText(argument) + ","
+			}
+			if typeArguments := node.TypeArguments(); len(typeArguments) > 0 {
+				text += "<"
+				for _, typeArgument := range typeArguments {
+					text += getText(typeArgument) + ","
+				}
+			}
+			return text
+		}
+
+		// isSameReference compares two references link by link, ignoring existing `?.` tokens
+		var isSameReference func(a, b *ast.Node) bool
+		isSameReference = func(a, b *ast.Node) bool {
+			if a.Kind != b.Kind {
+				return false
+			}
+			switch a.Kind {
+			case ast.KindPropertyAccessExpression:
+				return a.Name().Text() == b.Name().Text() && isSameReference(a.Expression(), b.Expression())
+			case ast.KindElementAccessExpression:
+				return getText(a.AsElementAccessExpression().ArgumentExpression) == getText(b.AsElementAccessExpression().ArgumentExpression) &&
+					isSameReference(a.Expression(), b.Expression())
+			case ast.KindCallExpression:
+				return getArgumentsText(a) == getArgumentsText(b) && isSameReference(a.Expression(), b.Expression())
+			}
+			return getText(a) == getText(b)
+		}
+
+		// findCheckedLink returns the link of reference that is the same as checked, if checked is a strict prefix of it
+		findCheckedLink := func(reference *ast.Node, checked *ast.Node) *ast.Node {
+			for current := reference; isChainLink(current); {
+				current = current.Expression()
+				if isSameReference(current, checked) {
+					return current
+				}
+			}
+			return nil
+		}
+
+		// isValidPlainCheck checks whether the truthiness of a value may be replaced with a nullish check
+		isValidPlainCheck := func(t *checker.Type) bool {
+			var ignoredFlags checker.TypeFlags
+			if !opts.CheckAny {
+				ignoredFlags |= checker.TypeFlagsAny
+			}
+			if !opts.CheckUnknown {
+				ignoredFlags |= checker.TypeFlagsUnknown
+			}
+			if !opts.CheckString {
+				ignoredFlags |= checker.TypeFlagsStringLike
+			}
+			if !opts.CheckNumber {
+				ignoredFlags |= checker.TypeFlagsNumberLike
+			}
+			if !opts.CheckBoolean {
+				ignoredFlags |= checker.TypeFlagsBooleanLike
+			}
+			if !opts.CheckBigInt {
+				ignoredFlags |= checker.TypeFlagsBigIntLike
+			}
+
+			parts := utils.UnionTypeParts(t)
+			if utils.Some(parts, func(part *checker.Type) bool { return utils.IsTypeFlagSet(part, ignoredFlags) }) {
+				return false
+			}
+			if opts.RequireNullish {
+				return utils.Some(parts, func(part *checker.Type) bool {
+					return utils.IsTypeFlagSet(part, checker.TypeFlagsNull|checker.TypeFlagsUndefined|checker.TypeFlagsVoid)
+				})
+			}
+			return true
+		}
+
+		// isOnlyUndefinedFalsy checks whether undefined is the only falsy value of a type,
+		// in which case `a && a.b` and `a?.b` produce the same result
+		isOnlyUndefinedFalsy := func(t *checker.Type) bool {
+			return !utils.Some(utils.UnionTypeParts(t), func(part *checker.Type) bool {
+				return utils.IsTypeFlagSet(part, checker.TypeFlagsNull|checker.TypeFlagsAny|checker.TypeFlagsUnknown|
+					checker.TypeFlagsStringLike|checker.TypeFlagsNumberLike|checker.TypeFlagsBigIntLike|
+					checker.TypeFlagsBooleanLike|checker.TypeFlagsTypeParameter)
+			})
+		}
+
+		// isBooleanContext checks whether only the truthiness of the value of node is used
+		var isBooleanContext func(node *ast.Node) bool
+		isBooleanContext = func(node *ast.Node) bool {
+			parent := node.Parent
+			for parent != nil && parent.Kind == ast.KindParenthesizedExpression {
+				node = parent
+				parent = parent.Parent
+			}
+			if parent == nil {
+				return false
+			}
+			switch parent.Kind {
+			case ast.KindIfStatement:
+				return parent.AsIfStatement().Expression == node
+			case ast.KindWhileStatement:
+				return parent.AsWhileStatement().Expression == node
+			case ast.KindDoStatement:
+				return parent.AsDoStatement().Expression == node
+			case ast.KindForStatement:
+				return parent.AsForStatement().Condition == node
+			case ast.KindConditionalExpression:
+				return parent.AsConditionalExpression().Condition == node
+			case ast.KindPrefixUnaryExpression:
+				return parent.AsPrefixUnaryExpression().Operator == ast.KindExclamationToken
+			case ast.KindBinaryExpression:
+				switch parent.AsBinaryExpression().OperatorToken.Kind {
+				case ast.KindAmpersandAmpersandToken, ast.KindBarBarToken:
+					return isBooleanContext(parent)
+				}
+			}
+			return false
+		}
+
+		// buildOptionalChain adds `?.` after each checked link of the last operand. Each check
+		// extends the previous one, so the links are already in source order.
+		buildOptionalChain := func(last *ast.Node, checkedLinks []*ast.Node) string {
+			text := ctx.SourceFile.Text()
+			lastRange := utils.TrimNodeTextRange(ctx.SourceFile, last)
+
+			type insertion struct {
+				pos  int
+				text string
+			}
+			var insertions []insertion
+			for _, link := range checkedLinks {
+				parent := link.Parent
+				if parent.Kind == ast.KindPropertyAccessExpression {
+					if parent.AsPropertyAccessExpression().QuestionDotToken != nil {
+						continue
+					}
+					// Turn the existing `.` into `?.`
+					insertions = append(insertions, insertion{scanner.SkipTrivia(text, link.End()), "?"})
+					continue
+				}
+				if parent.Kind == ast.KindElementAccessExpression && parent.AsElementAccessExpression().QuestionDotToken != nil ||
+					parent.Kind == ast.KindCallExpression && parent.AsCallExpression().QuestionDotToken != nil {
+					continue
+				}
+				insertions = append(insertions, insertion{link.End(), "?."})
+			}
+			result := ""
+			pos := lastRange.Pos()
+			for _, ins := range insertions {
+				result += text[pos:ins.pos] + ins.text
+				pos = ins.pos
+			}
+			return result + text[pos:lastRange.End()]
+		}
+
+		checkRun := func(chain *ast.Node, operands []*operand, booleanContext bool) {
+			checked := operands[:len(operands)-1]
+			last := operands[len(operands)-1]
+
+			checkedLinks := make([]*ast.Node, 0, len(checked))
+			for _, op := range checked {
+				checkedLinks = append(checkedLinks, findCheckedLink(last.reference, op.reference))
+			}
+
+			// Outside of boolean contexts, the fix changes the result when a check stops the chain at a falsy
+			// value other than undefined, e.g. `null && null.a` is null whereas `null?.a` is undefined
+			safe := booleanContext || opts.AllowPotentiallyUnsafeFixesThatModifyTheReturnTypeIKnowWhatImDoing ||
+				utils.Every(checked, func(op *operand) bool {
+					return op.isPlainCheck && isOnlyUndefinedFalsy(ctx.TypeChecker.GetTypeAtLocation(op.reference))
+				})
+
+			firstRange := utils.TrimNodeTextRange(ctx.SourceFile, operands[0].node)
+			reportRange := core.NewTextRange(firstRange.Pos(), last.node.End())
+			if utils.HasCommentsInRange(ctx.SourceFile, reportRange) {
+				ctx.ReportRange(reportRange, buildPreferOptionalChainMessage())
+				return
+			}
+
+			fix := rule.RuleFixReplaceRange(reportRange, buildOptionalChain(last.node, checkedLinks))
+			if safe {
+				ctx.ReportRangeWithFixes(reportRange, buildPreferOptionalChainMessage(), fix)
+			} else {
+				ctx.ReportRangeWithSuggestions(reportRange, buildPreferOptionalChainMessage(), rule.RuleSuggestion{
+					Message:  buildOptionalChainSuggestMessage(),
+					FixesArr: []rule.RuleFix{fix},
+				})
+			}
+		}
+
+		return rule.RuleListeners{
+			ast.KindBinaryExpression: func(node *ast.Node) {
+				if !isAndExpression(node) {
+					return
+				}
+				// Only handle the outermost expression of a chain
+				if isAndExpression(node.Parent) && node.Parent.AsBinaryExpression().Left == node {
+					return
+				}
+
+				// `a && b && c` is parsed as `(a && b) && c`
+				var parts []*ast.Node
+				current := node
+				for isAndExpression(current) {
+					parts = append([]*ast.Node{current.AsBinaryExpression().Right}, parts...)
+					current = current.AsBinaryExpression().Left
+				}
+				parts = append([]*ast.Node{current}, parts...)
+
+				booleanContext := isBooleanContext(node)
+
+				// Collect runs of operands where each one extends the reference checked by the previous one
+				var run []*operand
+				flush := func() {
+					if len(run) > 1 {
+						checkRun(node, run, booleanContext)
+					}
+					run = nil
+				}
+				for _, part := range parts {
+					op := parseOperand(part)
+					if op == nil {
+						flush()
+						continue
+					}
+					if len(run) > 0 {
+						previous := run[len(run)-1]
+						if findCheckedLink(op.reference, previous.reference) == nil {
+							flush()
+						} else if previous.isPlainCheck && !isValidPlainCheck(ctx.TypeChecker.GetTypeAtLocation(previous.reference)) {
+							// Every operand but the last one of a run is a check, which has to be valid
+							flush()
+						}
+					}
+					run = append(run, op)
+				}
+				flush()
+			},
+		}
+	},
+})
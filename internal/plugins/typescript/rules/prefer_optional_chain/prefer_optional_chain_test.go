@@ -0,0 +1,188 @@
+package prefer_optional_chain
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestPreferOptionalChainRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&PreferOptionalChainRule,
+		[]rule_tester.ValidTestCase{
+			{Code: `declare const foo: { bar: number } | undefined; foo?.bar;`},
+			{Code: `declare const foo: { bar: number } | undefined; declare const baz: { bar: number }; foo && baz.bar;`},
+			{Code: `declare const foo: { bar: number } | undefined; foo && foo;`},
+			{Code: `declare const foo: { bar: number } | undefined; foo || foo.bar;`},
+			{Code: `declare const foo: { bar: number } | undefined; foo != null || foo.bar;`},
+			{Code: `declare const foo: { bar: number } | undefined; foo === null && foo.bar;`},
+			{Code: `declare const foo: { bar: number; baz: number }; foo.bar && foo.baz;`},
+			{Code: `declare const foo: { bar(x: number): { baz: number } }; foo.bar(1) && foo.bar(2).baz;`},
+			{Code: `declare const foo: { bar: number } | undefined; (foo as any) && (foo as any).bar;`},
+			{Code: `declare const foo: { bar: number } | undefined; !foo && foo.bar;`},
+
+			// Disabled type checks
+			{
+				Code:    `declare const foo: string; foo && foo.length;`,
+				Options: map[string]interface{}{"checkString": false},
+			},
+			{
+				Code:    `declare const foo: number; foo && foo.toFixed();`,
+				Options: []interface{}{map[string]interface{}{"checkNumber": false}},
+			},
+			{
+				Code:    `declare const foo: any; foo && foo.bar;`,
+				Options: map[string]interface{}{"checkAny": false},
+			},
+			{
+				Code:    `declare const foo: { bar: unknown }; foo.bar && foo.bar.toString;`,
+				Options: map[string]interface{}{"checkUnknown": false},
+			},
+			{
+				Code:    `declare const foo: boolean; foo && foo.valueOf();`,
+				Options: map[string]interface{}{"checkBoolean": false},
+			},
+			{
+				Code:    `declare const foo: bigint; foo && foo.toString();`,
+				Options: map[string]interface{}{"checkBigInt": false},
+			},
+
+			// requireNullish
+			{
+				Code:    `declare const foo: { bar: number }; foo && foo.bar;`,
+				Options: map[string]interface{}{"requireNullish": true},
+			},
+		},
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:   `declare const foo: { bar: number } | undefined; foo && foo.bar;`,
+				Output: []string{`declare const foo: { bar: number } | undefined; foo?.bar;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "preferOptionalChain", Line: 1, Column: 49},
+				},
+			},
+			{
+				Code:   `declare const a: { b?: { c?: { d: number } } } | undefined; a && a.b && a.b.c && a.b.c.d;`,
+				Output: []string{`declare const a: { b?: { c?: { d: number } } } | undefined; a?.b?.c?.d;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "preferOptionalChain", Line: 1, Column: 61},
+				},
+			},
+			{
+				Code:   `declare const a: { b?: (x: number) => { c: number } } | undefined; a && a.b && a.b(1) && a.b(1).c;`,
+				Output: []string{`declare const a: { b?: (x: number) => { c: number } } | undefined; a?.b?.(1)?.c;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "preferOptionalChain", Line: 1, Column: 68},
+				},
+			},
+			{
+				Code:   `declare const a: { b?: number[] } | undefined; a && a['b'] && a['b'][0];`,
+				Output: []string{`declare const a: { b?: number[] } | undefined; a?.['b']?.[0];`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "preferOptionalChain", Line: 1, Column: 48},
+				},
+			},
+			{
+				Code:   `declare const a: { b?: { c: number } } | undefined; a && a?.b && a.b.c;`,
+				Output: []string{`declare const a: { b?: { c: number } } | undefined; a?.b?.c;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "preferOptionalChain", Line: 1, Column: 53},
+				},
+			},
+			{
+				Code:   `declare const foo: { bar: number } | undefined; declare const x: boolean; x && foo && foo.bar;`,
+				Output: []string{`declare const foo: { bar: number } | undefined; declare const x: boolean; x && foo?.bar;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "preferOptionalChain", Line: 1, Column: 80},
+				},
+			},
+			{
+				Code:   `declare const foo: { bar: number } | undefined; declare const x: boolean; foo && foo.bar && x;`,
+				Output: []string{`declare const foo: { bar: number } | undefined; declare const x: boolean; foo?.bar && x;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "preferOptionalChain", Line: 1, Column: 75},
+				},
+			},
+
+			// Nullish comparisons
+			{
+				Code:   `declare const foo: { bar: number } | null; if (foo != null && foo.bar) {}`,
+				Output: []string{`declare const foo: { bar: number } | null; if (foo?.bar) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "preferOptionalChain", Line: 1, Column: 48},
+				},
+			},
+			{
+				Code:   `declare const foo: { bar: number } | null; if (foo != undefined && foo.bar != null) {}`,
+				Output: []string{`declare const foo: { bar: number } | null; if (foo?.bar != null) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "preferOptionalChain", Line: 1, Column: 48},
+				},
+			},
+
+			// The result could change, so only a suggestion is offered
+			{
+				Code: `declare const foo: { bar: number } | null; const x = foo && foo.bar;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "preferOptionalChain", Line: 1, Column: 54,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "optionalChainSuggest", Output: `declare const foo: { bar: number } | null; const x = foo?.bar;`},
+						},
+					},
+				},
+			},
+			{
+				Code: `declare const foo: string; const x = foo && foo.length;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "preferOptionalChain", Line: 1, Column: 38,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "optionalChainSuggest", Output: `declare const foo: string; const x = foo?.length;`},
+						},
+					},
+				},
+			},
+			{
+				Code:    `declare const foo: { bar: number } | null; const x = foo && foo.bar;`,
+				Options: map[string]interface{}{"allowPotentiallyUnsafeFixesThatModifyTheReturnTypeIKnowWhatImDoing": true},
+				Output:  []string{`declare const foo: { bar: number } | null; const x = foo?.bar;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "preferOptionalChain", Line: 1, Column: 54},
+				},
+			},
+
+			// requireNullish
+			{
+				Code:    `declare const foo: { bar: number } | undefined; foo && foo.bar;`,
+				Options: map[string]interface{}{"requireNullish": true},
+				Output:  []string{`declare const foo: { bar: number } | undefined; foo?.bar;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "preferOptionalChain", Line: 1, Column: 49},
+				},
+			},
+
+			// A check with an ignored type ends the chain
+			{
+				Code:    `declare const foo: { bar: string; } | undefined; foo && foo.bar && foo.bar.length;`,
+				Options: map[string]interface{}{"checkString": false},
+				Output:  []string{`declare const foo: { bar: string; } | undefined; foo?.bar && foo.bar.length;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "preferOptionalChain", Line: 1, Column: 50},
+				},
+			},
+
+			// Comments are kept by not fixing
+			{
+				Code: `declare const foo: { bar: number } | undefined; foo && /* bar */ foo.bar;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "preferOptionalChain", Line: 1, Column: 49},
+				},
+			},
+		},
+	)
+}
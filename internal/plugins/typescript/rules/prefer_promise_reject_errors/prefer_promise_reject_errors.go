@@ -14,6 +14,13 @@ func buildRejectAnErrorMessage() rule.RuleMessage {
 	}
 }
 
+func buildWrapInErrorMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "wrapInError",
+		Description: "Wrap the rejection reason in `new Error()`.",
+	}
+}
+
 type PreferPromiseRejectErrorsOptions struct {
 	AllowEmptyReject     *bool
 	AllowThrowingAny     *bool
@@ -52,6 +59,18 @@ var PreferPromiseRejectErrorsRule = rule.CreateRule(rule.Rule{
 				if utils.IsErrorLike(ctx.Program, ctx.TypeChecker, t) || utils.IsReadonlyErrorLike(ctx.Program, ctx.TypeChecker, t) {
 					return
 				}
+
+				// A string reason can be used as the message of an Error as is
+				if ast.IsStringLiteralLike(argument) || ast.IsTemplateExpression(argument) {
+					ctx.ReportNodeWithSuggestions(&callExpression.Node, buildRejectAnErrorMessage(), rule.RuleSuggestion{
+						Message: buildWrapInErrorMessage(),
+						FixesArr: []rule.RuleFix{
+							rule.RuleFixInsertBefore(ctx.SourceFile, argument, "new Error("),
+							rule.RuleFixInsertAfter(argument, ")"),
+						},
+					})
+					return
+				}
 			} else if *opts.AllowEmptyReject {
 				return
 			}
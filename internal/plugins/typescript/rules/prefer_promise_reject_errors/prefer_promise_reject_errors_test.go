@@ -316,6 +316,12 @@ func TestPreferPromiseRejectErrorsRule(t *testing.T) {
 					Column:    1,
 					EndLine:   1,
 					EndColumn: 22,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "wrapInError",
+							Output:    "Promise.reject(new Error('foo'));",
+						},
+					},
 				},
 			},
 		},
@@ -328,6 +334,12 @@ func TestPreferPromiseRejectErrorsRule(t *testing.T) {
 					Column:    1,
 					EndLine:   1,
 					EndColumn: 22,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "wrapInError",
+							Output:    "Promise.reject(new Error(`foo`));",
+						},
+					},
 				},
 			},
 		},
@@ -340,6 +352,48 @@ func TestPreferPromiseRejectErrorsRule(t *testing.T) {
 					Column:    1,
 					EndLine:   1,
 					EndColumn: 37,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "wrapInError",
+							Output:    "Promise.reject(new Error('foo'), somethingElse);",
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: "Promise.reject('failed');",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "rejectAnError",
+					Line:      1,
+					Column:    1,
+					EndLine:   1,
+					EndColumn: 25,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "wrapInError",
+							Output:    "Promise.reject(new Error('failed'));",
+						},
+					},
+				},
+			},
+		},
+		{
+			Code: "Promise.reject(`failed: ${reason}`);",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "rejectAnError",
+					Line:      1,
+					Column:    1,
+					EndLine:   1,
+					EndColumn: 36,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "wrapInError",
+							Output:    "Promise.reject(new Error(`failed: ${reason}`));",
+						},
+					},
 				},
 			},
 		},
@@ -947,6 +1001,19 @@ new Promise((resolve, reject) => {
 					Column:    14,
 					EndLine:   4,
 					EndColumn: 38,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{
+							MessageId: "wrapInError",
+							Output: `
+new Promise((resolve, reject) => {
+  fs.readFile('foo.txt', (err, file) => {
+    if (err) reject(new Error('File not found'));
+    else resolve(file);
+  });
+});
+      `,
+						},
+					},
 				},
 			},
 		},
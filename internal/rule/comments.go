@@ -0,0 +1,87 @@
+package rule
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// CommentKind distinguishes `//` line comments from `/* */` block comments
+type CommentKind int
+
+const (
+	CommentKindLine CommentKind = iota
+	CommentKindBlock
+)
+
+// Comment is a single comment of the source file
+type Comment struct {
+	Kind CommentKind
+	// Range covers the whole comment, including its delimiters
+	Range core.TextRange
+	// Text is the comment content without the `//`, `/*` and `*/` delimiters
+	Text string
+}
+
+// NewComments converts the comment ranges of sourceFile into comments sorted in source order
+func NewComments(sourceFile *ast.SourceFile, ranges []*ast.CommentRange) []Comment {
+	text := sourceFile.Text()
+	comments := make([]Comment, 0, len(ranges))
+	for _, commentRange := range ranges {
+		comment := Comment{
+			Range: core.NewTextRange(commentRange.Pos(), commentRange.End()),
+		}
+		raw := text[commentRange.Pos():commentRange.End()]
+		if commentRange.Kind == ast.KindMultiLineCommentTrivia {
+			comment.Kind = CommentKindBlock
+			// An unterminated comment at the end of the file has no closing delimiter
+			comment.Text = strings.TrimSuffix(raw[2:], "*/")
+		} else {
+			comment.Kind = CommentKindLine
+			comment.Text = raw[2:]
+		}
+		comments = append(comments, comment)
+	}
+	slices.SortFunc(comments, func(a, b Comment) int {
+		return a.Range.Pos() - b.Range.Pos()
+	})
+	return comments
+}
+
+// CollectComments scans all comments of sourceFile
+func CollectComments(sourceFile *ast.SourceFile) []Comment {
+	ranges := make([]*ast.CommentRange, 0)
+	utils.ForEachComment(sourceFile.AsNode(), func(comment *ast.CommentRange) { ranges = append(ranges, comment) }, sourceFile)
+	return NewComments(sourceFile, ranges)
+}
+
+// GetAllComments returns all comments of the file in source order. The linter computes
+// them once per file; contexts created without them scan the file on each call.
+func (ctx RuleContext) GetAllComments() []Comment {
+	if ctx.Comments == nil {
+		return CollectComments(ctx.SourceFile)
+	}
+	return ctx.Comments
+}
+
+// GetCommentsBefore returns the comments between node and the token preceding it
+func (ctx RuleContext) GetCommentsBefore(node *ast.Node) []Comment {
+	comments := ctx.GetAllComments()
+	start := utils.TrimNodeTextRange(ctx.SourceFile, node).Pos()
+
+	// node.Pos() is the end of the preceding token, so everything up to start is trivia
+	first := sort.Search(len(comments), func(i int) bool {
+		return comments[i].Range.Pos() >= node.Pos()
+	})
+	last := sort.Search(len(comments), func(i int) bool {
+		return comments[i].Range.Pos() >= start
+	})
+	if first >= last {
+		return nil
+	}
+	return comments[first:last]
+}
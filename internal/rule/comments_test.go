@@ -0,0 +1,66 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+)
+
+func TestGetAllComments(t *testing.T) {
+	code := "// first\nconst a = /* inline */ 1; // trailing\n/** doc\n */\nfunction f() {}\n"
+	ctx := createTokenTestContext(t, code)
+
+	expected := []struct {
+		kind CommentKind
+		text string
+	}{
+		{CommentKindLine, " first"},
+		{CommentKindBlock, " inline "},
+		{CommentKindLine, " trailing"},
+		{CommentKindBlock, "* doc\n "},
+	}
+
+	comments := ctx.GetAllComments()
+	if len(comments) != len(expected) {
+		t.Fatalf("expected %d comments, got %d", len(expected), len(comments))
+	}
+	for i, comment := range comments {
+		if comment.Kind != expected[i].kind || comment.Text != expected[i].text {
+			t.Errorf("comment %d: expected %v %q, got %v %q", i, expected[i].kind, expected[i].text, comment.Kind, comment.Text)
+		}
+		raw := code[comment.Range.Pos():comment.Range.End()]
+		if len(raw) < 2 || (raw[:2] != "//" && raw[:2] != "/*") {
+			t.Errorf("comment %d: range %v does not start at a comment: %q", i, comment.Range, raw)
+		}
+	}
+
+	// Contexts created by the linter carry the precomputed comments
+	ctx.Comments = comments[:1]
+	if got := ctx.GetAllComments(); len(got) != 1 {
+		t.Errorf("expected precomputed comments to be used, got %d comments", len(got))
+	}
+}
+
+func TestGetCommentsBefore(t *testing.T) {
+	code := "const a = /* inline */ 1; // trailing\n/* one */ // two\nfunction f() {}\n"
+	ctx := createTokenTestContext(t, code)
+
+	literal := findNode(ctx, ast.KindNumericLiteral, "1")
+	if comments := ctx.GetCommentsBefore(literal); len(comments) != 1 || comments[0].Text != " inline " {
+		t.Errorf("expected the inline comment before the literal, got %v", comments)
+	}
+
+	function := findNode(ctx, ast.KindFunctionDeclaration, "function f() {}")
+	comments := ctx.GetCommentsBefore(function)
+	if len(comments) != 3 {
+		t.Fatalf("expected 3 comments before the function, got %d", len(comments))
+	}
+	if comments[0].Text != " trailing" || comments[1].Text != " one " || comments[2].Text != " two" {
+		t.Errorf("unexpected comments before the function: %v", comments)
+	}
+
+	statement := ctx.SourceFile.Statements.Nodes[0]
+	if comments := ctx.GetCommentsBefore(statement); len(comments) != 0 {
+		t.Errorf("expected no comments before the first statement, got %v", comments)
+	}
+}
@@ -1,9 +1,13 @@
 package rule
 
 import (
+	"regexp"
+	"slices"
 	"strings"
+	"unicode"
 
 	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
 	"github.com/microsoft/typescript-go/shim/scanner"
 )
 
@@ -23,27 +27,73 @@ const (
 	ESLintDirectiveDisableNextLine
 )
 
+// disableDirective is an `eslint-disable*` comment along with the rules it has suppressed diagnostics of
+type disableDirective struct {
+	comment *ast.CommentRange
+	// ruleNames is ["*"] when the directive doesn't list any rules
+	ruleNames []string
+	used      map[string]bool
+}
+
+// directiveRule is a rule disabled by a directive
+type directiveRule struct {
+	ruleName  string
+	directive *disableDirective
+}
+
+func (r directiveRule) disables(ruleName string) bool {
+	return r.ruleName == ruleName || r.ruleName == "*"
+}
+
+// disabledRange is a part of the file where a rule is disabled by `eslint-disable` and
+// `eslint-enable` block directives
+type disabledRange struct {
+	// ruleName is "*" when all rules are disabled
+	ruleName string
+	// except lists the rules re-enabled with `eslint-enable rule` while all rules are disabled
+	except    []string
+	pos       int
+	end       int
+	directive *disableDirective
+}
+
+func (r disabledRange) disables(ruleName string, pos int) bool {
+	if pos < r.pos || pos > r.end {
+		return false
+	}
+	return r.ruleName == ruleName || (r.ruleName == "*" && !slices.Contains(r.except, ruleName))
+}
+
 // DisableManager tracks which rules are disabled at different locations in a file
 type DisableManager struct {
 	sourceFile            *ast.SourceFile
-	disabledRules         map[string]bool  // Rules disabled for the entire file
-	lineDisabledRules     map[int][]string // Rules disabled for specific lines
-	nextLineDisabledRules map[int][]string // Rules disabled for the next line
+	directives            []*disableDirective
+	disabledRanges        []disabledRange         // Rules disabled between eslint-disable and eslint-enable directives
+	lineDisabledRules     map[int][]directiveRule // Rules disabled for specific lines
+	nextLineDisabledRules map[int][]directiveRule // Rules disabled for the next line
 }
 
 // NewDisableManager creates a new DisableManager for the given source file
 func NewDisableManager(sourceFile *ast.SourceFile, comments []*ast.CommentRange) *DisableManager {
 	dm := &DisableManager{
 		sourceFile:            sourceFile,
-		disabledRules:         make(map[string]bool),
-		lineDisabledRules:     make(map[int][]string),
-		nextLineDisabledRules: make(map[int][]string),
+		lineDisabledRules:     make(map[int][]directiveRule),
+		nextLineDisabledRules: make(map[int][]directiveRule),
 	}
 
 	dm.parseESLintDirectives(comments)
 	return dm
 }
 
+// directivePattern matches the directive at the start of a comment, e.g. `eslint-disable-next-line foo`
+var directivePattern = regexp.MustCompile(`^eslint-(disable-next-line|disable-line|disable|enable)(?:\s|$)`)
+
+// directiveListPrefix matches the directive name and the whitespace before its rule list
+var directiveListPrefix = regexp.MustCompile(`^\s*\S+\s+`)
+
+// descriptionSeparator separates a directive from its description, e.g. `eslint-disable-line foo -- reason`
+var descriptionSeparator = regexp.MustCompile(`\s-{2,}\s`)
+
 // parseESLintDirectives parses ESLint-style disable/enable comments from the source text
 func (dm *DisableManager) parseESLintDirectives(comments []*ast.CommentRange) {
 	if dm.sourceFile.Text() == "" || len(comments) == 0 {
@@ -52,69 +102,105 @@ func (dm *DisableManager) parseESLintDirectives(comments []*ast.CommentRange) {
 
 	text := dm.sourceFile.Text()
 
+	// Block directives apply in source order
+	comments = slices.Clone(comments)
+	slices.SortFunc(comments, func(a, b *ast.CommentRange) int {
+		return a.Pos() - b.Pos()
+	})
+
+	// Ranges opened by eslint-disable that no eslint-enable has closed yet
+	open := make(map[string]*disabledRange)
+	closeRange := func(ruleName string, end int) {
+		if r, ok := open[ruleName]; ok {
+			r.end = end
+			dm.disabledRanges = append(dm.disabledRanges, *r)
+			delete(open, ruleName)
+		}
+	}
+	// reopenAll restarts the range disabling all rules with a different list of exceptions
+	reopenAll := func(pos int, except []string, directive *disableDirective) {
+		closeRange("*", pos)
+		open["*"] = &disabledRange{ruleName: "*", except: except, pos: pos, directive: directive}
+	}
+
 	for _, comment := range comments {
 		var commentContent string
 		switch comment.Kind {
 		case ast.KindSingleLineCommentTrivia:
-			commentContent = strings.TrimSpace(text[comment.Pos()+2 : comment.End()])
+			commentContent = text[comment.Pos()+2 : comment.End()]
 		case ast.KindMultiLineCommentTrivia:
-			commentContent = strings.TrimSpace(text[comment.Pos()+2 : comment.End()-2])
+			commentContent = strings.TrimSuffix(text[comment.Pos()+2:comment.End()], "*/")
 		}
+		commentContent = strings.TrimSpace(descriptionSeparator.Split(commentContent, 2)[0])
 
-		lineNum, _ := scanner.GetLineAndCharacterOfPosition(dm.sourceFile, comment.Pos())
-		rulePos := 0
-
-		if strings.HasPrefix(commentContent, "eslint-disable") {
-			rulePos += 14
-			text := commentContent[rulePos:]
-
-			if strings.HasPrefix(text, "-line") {
-				// Check for eslint-disable-line
-				rulePos += 5
-				rules := parseRuleNames(commentContent[rulePos:])
-				if len(rules) == 0 {
-					dm.lineDisabledRules[lineNum] = append(dm.lineDisabledRules[lineNum], "*")
-				} else {
-					dm.lineDisabledRules[lineNum] = append(dm.lineDisabledRules[lineNum], rules...)
-				}
-			} else if strings.HasPrefix(text, "-next-line") {
-				// Check for eslint-disable-next-line
-				rulePos += 10
-				rules := parseRuleNames(commentContent[rulePos:])
-				nextLineNum := lineNum + 1
-				if len(rules) == 0 {
-					dm.nextLineDisabledRules[nextLineNum] = append(dm.nextLineDisabledRules[nextLineNum], "*")
-				} else {
-					dm.nextLineDisabledRules[nextLineNum] = append(dm.nextLineDisabledRules[nextLineNum], rules...)
-				}
-			} else {
-				// Check for eslint-disable (block comments)
-				rules := parseRuleNames(commentContent[rulePos:])
-				if len(rules) == 0 {
-					dm.disabledRules["*"] = true
-				} else {
-					for _, rule := range rules {
-						dm.disabledRules[rule] = true
+		match := directivePattern.FindStringSubmatch(commentContent)
+		if match == nil {
+			continue
+		}
+		rules := parseRuleNames(commentContent[len(match[0]):])
+		if len(rules) == 0 {
+			rules = []string{"*"}
+		}
+
+		var directive *disableDirective
+		if match[1] != "enable" {
+			directive = &disableDirective{comment: comment, ruleNames: rules, used: make(map[string]bool)}
+			dm.directives = append(dm.directives, directive)
+		}
+
+		switch match[1] {
+		case "disable-line":
+			lineNum, _ := scanner.GetLineAndCharacterOfPosition(dm.sourceFile, comment.Pos())
+			for _, rule := range rules {
+				dm.lineDisabledRules[lineNum] = append(dm.lineDisabledRules[lineNum], directiveRule{rule, directive})
+			}
+
+		case "disable-next-line":
+			// A block comment may span several lines, so the next line follows its end
+			lineNum, _ := scanner.GetLineAndCharacterOfPosition(dm.sourceFile, comment.End())
+			for _, rule := range rules {
+				dm.nextLineDisabledRules[lineNum+1] = append(dm.nextLineDisabledRules[lineNum+1], directiveRule{rule, directive})
+			}
+
+		case "disable":
+			for _, rule := range rules {
+				all, allDisabled := open["*"]
+				switch {
+				case allDisabled && rule == "*":
+					if len(all.except) > 0 {
+						reopenAll(comment.Pos(), nil, directive)
+					}
+				case allDisabled && slices.Contains(all.except, rule):
+					reopenAll(comment.Pos(), slices.DeleteFunc(slices.Clone(all.except), func(except string) bool {
+						return except == rule
+					}), all.directive)
+				default:
+					if _, ok := open[rule]; !ok {
+						open[rule] = &disabledRange{ruleName: rule, pos: comment.Pos(), directive: directive}
 					}
 				}
 			}
-		} else if strings.HasPrefix(commentContent, "eslint-enable") {
-			rulePos += 13
-
-			// Check for eslint-enable (block comments)
-			rules := parseRuleNames(commentContent[rulePos:])
-			if len(rules) == 0 {
-				// Enable all rules
-				for key := range dm.disabledRules {
-					delete(dm.disabledRules, key)
+
+		case "enable":
+			for _, rule := range rules {
+				if rule == "*" {
+					for ruleName := range open {
+						closeRange(ruleName, comment.Pos())
+					}
+					continue
 				}
-			} else {
-				for _, rule := range rules {
-					delete(dm.disabledRules, rule)
+				closeRange(rule, comment.Pos())
+				if all, ok := open["*"]; ok && !slices.Contains(all.except, rule) {
+					reopenAll(comment.Pos(), append(slices.Clone(all.except), rule), all.directive)
 				}
 			}
 		}
 	}
+
+	// Ranges without an eslint-enable last until the end of the file
+	for ruleName := range open {
+		closeRange(ruleName, len(text))
+	}
 }
 
 // parseRuleNames parses rule names from a string like "rule1, rule2, rule3"
@@ -133,33 +219,158 @@ func parseRuleNames(rulesStr string) []string {
 	return rules
 }
 
-// IsRuleDisabled checks if a rule is disabled at the given position
+// IsRuleDisabled checks if a rule is disabled at the given position, marking the directives that disable it as used
 func (dm *DisableManager) IsRuleDisabled(ruleName string, pos int) bool {
-	// Check if rule is disabled for the entire file
-	if dm.disabledRules[ruleName] || dm.disabledRules["*"] {
-		return true
+	disabled := false
+
+	// Check if rule is disabled between eslint-disable and eslint-enable directives
+	for _, r := range dm.disabledRanges {
+		if r.disables(ruleName, pos) {
+			r.directive.used[r.ruleName] = true
+			disabled = true
+		}
 	}
 
 	// Get the line number for the position
 	line, _ := scanner.GetLineAndCharacterOfPosition(dm.sourceFile, pos)
 
-	// Check if rule is disabled for this specific line
-	if lineRules, exists := dm.lineDisabledRules[line]; exists {
-		for _, disabledRule := range lineRules {
-			if disabledRule == ruleName || disabledRule == "*" {
-				return true
-			}
+	// Check if rule is disabled for this specific line, or for this line via next-line directive
+	for _, r := range slices.Concat(dm.lineDisabledRules[line], dm.nextLineDisabledRules[line]) {
+		if r.disables(ruleName) {
+			r.directive.used[r.ruleName] = true
+			disabled = true
 		}
 	}
 
-	// Check if rule is disabled for this line via next-line directive
-	if nextLineRules, exists := dm.nextLineDisabledRules[line]; exists {
-		for _, disabledRule := range nextLineRules {
-			if disabledRule == ruleName || disabledRule == "*" {
-				return true
-			}
+	return disabled
+}
+
+// UnusedDisableDirective is an eslint-disable directive, or one of the rules it lists, that suppressed no diagnostics
+type UnusedDisableDirective struct {
+	Range   core.TextRange
+	Message RuleMessage
+	Fix     RuleFix
+}
+
+func buildUnusedDisableDirectiveMessage(ruleNames []string) RuleMessage {
+	if len(ruleNames) == 0 {
+		return RuleMessage{
+			Id:          "unusedDisableDirective",
+			Description: "Unused eslint-disable directive (no problems were reported).",
 		}
 	}
+	quoted := make([]string, len(ruleNames))
+	for i, ruleName := range ruleNames {
+		quoted[i] = "'" + ruleName + "'"
+	}
+	description := strings.Join(quoted, " or ")
+	if len(quoted) > 2 {
+		description = strings.Join(quoted[:len(quoted)-1], ", ") + ", or " + quoted[len(quoted)-1]
+	}
+	return RuleMessage{
+		Id:          "unusedDisableDirective",
+		Description: "Unused eslint-disable directive (no problems were reported from " + description + ").",
+	}
+}
 
-	return false
+// removeDirectiveFix removes a directive comment along with the whitespace around it: the whole line when the
+// comment is the only thing on it, otherwise the whitespace separating it from the code before or after it
+func removeDirectiveFix(text string, commentRange core.TextRange) RuleFix {
+	isBlank := func(r rune) bool { return r == ' ' || r == '\t' }
+
+	lineStart := strings.LastIndexAny(text[:commentRange.Pos()], "\r\n") + 1
+	lineEnd := len(text)
+	if i := strings.IndexAny(text[commentRange.End():], "\r\n"); i >= 0 {
+		lineEnd = commentRange.End() + i
+	}
+	before := strings.TrimLeftFunc(text[lineStart:commentRange.Pos()], isBlank)
+	after := strings.TrimRightFunc(text[commentRange.End():lineEnd], isBlank)
+
+	switch {
+	case before == "" && after == "":
+		// Remove the line break after the line, or before it on the last line
+		if lineEnd < len(text) {
+			lineEnd += len(lineBreakAt(text, lineEnd))
+		} else if lineStart > 0 {
+			lineStart -= len(lineBreakBefore(text, lineStart))
+		}
+		return RuleFixRemoveRange(core.NewTextRange(lineStart, lineEnd))
+	case before == "":
+		// The comment leads the line, remove the whitespace between it and the code after it
+		rest := text[commentRange.End():lineEnd]
+		end := commentRange.End() + len(rest) - len(strings.TrimLeftFunc(rest, isBlank))
+		return RuleFixRemoveRange(core.NewTextRange(commentRange.Pos(), end))
+	default:
+		start := lineStart + len(strings.TrimRightFunc(text[lineStart:commentRange.Pos()], isBlank))
+		if start == commentRange.Pos() && after != "" && !isBlank(rune(text[commentRange.End()])) {
+			// Keep the code on both sides apart
+			return RuleFixReplaceRange(commentRange, " ")
+		}
+		return RuleFixRemoveRange(core.NewTextRange(start, commentRange.End()))
+	}
+}
+
+// lineBreakAt returns the line break starting at pos
+func lineBreakAt(text string, pos int) string {
+	if strings.HasPrefix(text[pos:], "\r\n") {
+		return "\r\n"
+	}
+	return text[pos : pos+1]
+}
+
+// lineBreakBefore returns the line break ending at pos
+func lineBreakBefore(text string, pos int) string {
+	if strings.HasSuffix(text[:pos], "\r\n") {
+		return "\r\n"
+	}
+	return text[pos-1 : pos]
+}
+
+// GetUnusedDirectives returns the eslint-disable directives that haven't suppressed any diagnostic so far. A
+// directive without any used rule is removed as a whole, otherwise its rule list is rewritten to the used rules.
+func (dm *DisableManager) GetUnusedDirectives() []UnusedDisableDirective {
+	var unused []UnusedDisableDirective
+	text := dm.sourceFile.Text()
+	for _, directive := range dm.directives {
+		unusedRules := slices.DeleteFunc(slices.Clone(directive.ruleNames), func(ruleName string) bool {
+			return directive.used[ruleName]
+		})
+		if len(unusedRules) == 0 {
+			continue
+		}
+
+		commentRange := directive.comment.TextRange
+		if len(unusedRules) == len(directive.ruleNames) {
+			var ruleNames []string
+			if directive.ruleNames[0] != "*" {
+				ruleNames = directive.ruleNames
+			}
+			unused = append(unused, UnusedDisableDirective{
+				Range:   commentRange,
+				Message: buildUnusedDisableDirectiveMessage(ruleNames),
+				Fix:     removeDirectiveFix(text, commentRange),
+			})
+			continue
+		}
+
+		// The rule list follows the directive name and ends before the description
+		content := text[commentRange.Pos()+2 : commentRange.End()]
+		listStart := len(directiveListPrefix.FindString(content))
+		listText := strings.TrimRightFunc(descriptionSeparator.Split(content[listStart:], 2)[0], unicode.IsSpace)
+		if directive.comment.Kind == ast.KindMultiLineCommentTrivia {
+			listText = strings.TrimRightFunc(strings.TrimSuffix(listText, "*/"), unicode.IsSpace)
+		}
+		listPos := commentRange.Pos() + 2 + listStart
+
+		// A single fix rewrites the whole list, as separate fixes for adjacent rules would overlap
+		usedRules := slices.DeleteFunc(slices.Clone(directive.ruleNames), func(ruleName string) bool {
+			return !directive.used[ruleName]
+		})
+		unused = append(unused, UnusedDisableDirective{
+			Range:   commentRange,
+			Message: buildUnusedDisableDirectiveMessage(unusedRules),
+			Fix:     RuleFixReplaceRange(core.NewTextRange(listPos, listPos+len(listText)), strings.Join(usedRules, ", ")),
+		})
+	}
+	return unused
 }
@@ -4,6 +4,9 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/utils"
 )
 
 func TestParseRuleNames(t *testing.T) {
@@ -158,28 +161,152 @@ func TestESLintDirectiveRegexPatterns(t *testing.T) {
 func TestDisableManagerBasicFunctionality(t *testing.T) {
 	dm := &DisableManager{
 		sourceFile:            nil,
-		disabledRules:         make(map[string]bool),
-		lineDisabledRules:     make(map[int][]string),
-		nextLineDisabledRules: make(map[int][]string),
+		lineDisabledRules:     make(map[int][]directiveRule),
+		nextLineDisabledRules: make(map[int][]directiveRule),
 	}
 
-	dm.disabledRules["no-console"] = true
-	dm.lineDisabledRules[5] = []string{"no-unused-vars"}
-	dm.nextLineDisabledRules[10] = []string{"no-debugger"}
+	dm.disabledRanges = append(dm.disabledRanges, disabledRange{ruleName: "no-console", pos: 0, end: 100})
+	dm.lineDisabledRules[5] = []directiveRule{{ruleName: "no-unused-vars"}}
+	dm.nextLineDisabledRules[10] = []directiveRule{{ruleName: "no-debugger"}}
 
-	if !dm.disabledRules["no-console"] {
+	if !dm.disabledRanges[0].disables("no-console", 50) {
 		t.Error("Expected no-console to be disabled")
 	}
 
-	if len(dm.lineDisabledRules[5]) != 1 || dm.lineDisabledRules[5][0] != "no-unused-vars" {
+	if dm.disabledRanges[0].disables("no-console", 150) {
+		t.Error("Expected no-console to be enabled after the range")
+	}
+
+	if len(dm.lineDisabledRules[5]) != 1 || !dm.lineDisabledRules[5][0].disables("no-unused-vars") {
 		t.Error("Expected no-unused-vars to be disabled for line 5")
 	}
 
-	if len(dm.nextLineDisabledRules[10]) != 1 || dm.nextLineDisabledRules[10][0] != "no-debugger" {
+	if len(dm.nextLineDisabledRules[10]) != 1 || !dm.nextLineDisabledRules[10][0].disables("no-debugger") {
 		t.Error("Expected no-debugger to be disabled for next line after 10")
 	}
 }
 
+func TestDisableManagerDirectives(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		ruleName string
+		disabled bool
+	}{
+		{
+			name:     "disable-line",
+			code:     "foo; // eslint-disable-line no-foo",
+			ruleName: "no-foo",
+			disabled: true,
+		},
+		{
+			name:     "disable-line other rule",
+			code:     "foo; // eslint-disable-line no-bar",
+			ruleName: "no-foo",
+			disabled: false,
+		},
+		{
+			name:     "disable-line without rules",
+			code:     "foo; // eslint-disable-line",
+			ruleName: "no-foo",
+			disabled: true,
+		},
+		{
+			name:     "disable-line with rule list",
+			code:     "foo; // eslint-disable-line no-bar, no-foo",
+			ruleName: "no-foo",
+			disabled: true,
+		},
+		{
+			name:     "disable-line with description",
+			code:     "foo; // eslint-disable-line no-foo -- foo is fine here",
+			ruleName: "no-foo",
+			disabled: true,
+		},
+		{
+			name:     "description is not a rule name",
+			code:     "foo; // eslint-disable-line no-bar -- no-foo",
+			ruleName: "no-foo",
+			disabled: false,
+		},
+		{
+			name:     "disable-next-line",
+			code:     "// eslint-disable-next-line no-foo\nfoo;",
+			ruleName: "no-foo",
+			disabled: true,
+		},
+		{
+			name:     "disable-next-line does not reach the line after next",
+			code:     "// eslint-disable-next-line no-foo\nbar;\nfoo;",
+			ruleName: "no-foo",
+			disabled: false,
+		},
+		{
+			name:     "disable-next-line in a multiline block comment",
+			code:     "/* eslint-disable-next-line\n   no-foo */\nfoo;",
+			ruleName: "no-foo",
+			disabled: true,
+		},
+		{
+			name:     "directive name needs a word boundary",
+			code:     "foo; // eslint-disable-lines no-foo",
+			ruleName: "no-foo",
+			disabled: false,
+		},
+		{
+			name:     "disable block",
+			code:     "/* eslint-disable no-foo */\nfoo;",
+			ruleName: "no-foo",
+			disabled: true,
+		},
+		{
+			name:     "enable after disable",
+			code:     "/* eslint-disable no-foo */\n/* eslint-enable no-foo */\nfoo;",
+			ruleName: "no-foo",
+			disabled: false,
+		},
+		{
+			name:     "enable only ends its own rule",
+			code:     "/* eslint-disable no-foo, no-bar */\n/* eslint-enable no-bar */\nfoo;",
+			ruleName: "no-foo",
+			disabled: true,
+		},
+		{
+			name:     "enable a rule while all rules are disabled",
+			code:     "/* eslint-disable */\n/* eslint-enable no-foo */\nfoo;",
+			ruleName: "no-foo",
+			disabled: false,
+		},
+		{
+			name:     "other rules stay disabled after enabling one",
+			code:     "/* eslint-disable */\n/* eslint-enable no-bar */\nfoo;",
+			ruleName: "no-foo",
+			disabled: true,
+		},
+		{
+			name:     "enable all rules",
+			code:     "/* eslint-disable no-foo */\n/* eslint-enable */\nfoo;",
+			ruleName: "no-foo",
+			disabled: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := createTokenTestContext(t, tt.code)
+			var comments []*ast.CommentRange
+			utils.ForEachComment(&ctx.SourceFile.Node, func(comment *ast.CommentRange) { comments = append(comments, comment) }, ctx.SourceFile)
+			dm := NewDisableManager(ctx.SourceFile, comments)
+
+			// Directives never contain `foo;`, so this is the checked statement
+			pos := strings.Index(tt.code, "foo;")
+			if got := dm.IsRuleDisabled(tt.ruleName, pos); got != tt.disabled {
+				t.Errorf("IsRuleDisabled(%q) = %v, want %v", tt.ruleName, got, tt.disabled)
+			}
+		})
+	}
+}
+
 func TestRegexPatternsForTypeScriptESLint(t *testing.T) {
 	// Test the actual regex patterns used in the disable manager
 	eslintDisableLineRe := regexp.MustCompile(`//\s*eslint-disable-line(?:\s+([^\r\n]+))?`)
@@ -252,3 +379,182 @@ func TestRegexPatternsForTypeScriptESLint(t *testing.T) {
 		})
 	}
 }
+
+func TestDisableManagerUnusedDirectives(t *testing.T) {
+	type unusedDirective struct {
+		description string
+		output      string
+	}
+
+	tests := []struct {
+		name     string
+		code     string
+		reported []string
+		expected []unusedDirective
+	}{
+		{
+			name:     "used disable-line",
+			code:     "foo; // eslint-disable-line no-foo",
+			reported: []string{"no-foo"},
+		},
+		{
+			name: "unused disable-line",
+			code: "foo; // eslint-disable-line no-foo",
+			expected: []unusedDirective{
+				{
+					description: "Unused eslint-disable directive (no problems were reported from 'no-foo').",
+					output:      "foo;",
+				},
+			},
+		},
+		{
+			name: "unused bare disable-next-line",
+			code: "// eslint-disable-next-line\nfoo;",
+			expected: []unusedDirective{
+				{
+					description: "Unused eslint-disable directive (no problems were reported).",
+					output:      "foo;",
+				},
+			},
+		},
+		{
+			name: "unused disable-next-line on an indented line",
+			code: "if (a) {\n  // eslint-disable-next-line no-foo\n  foo;\n}",
+			expected: []unusedDirective{
+				{
+					description: "Unused eslint-disable directive (no problems were reported from 'no-foo').",
+					output:      "if (a) {\n  foo;\n}",
+				},
+			},
+		},
+		{
+			name: "unused disable-next-line with CRLF line breaks",
+			code: "// eslint-disable-next-line\r\nfoo;",
+			expected: []unusedDirective{
+				{
+					description: "Unused eslint-disable directive (no problems were reported).",
+					output:      "foo;",
+				},
+			},
+		},
+		{
+			name: "unused directive before code on the same line",
+			code: "/* eslint-disable-line no-foo */  foo;",
+			expected: []unusedDirective{
+				{
+					description: "Unused eslint-disable directive (no problems were reported from 'no-foo').",
+					output:      "foo;",
+				},
+			},
+		},
+		{
+			name: "unused directive between code without whitespace",
+			code: "bar/* eslint-disable-line no-foo */+foo;",
+			expected: []unusedDirective{
+				{
+					description: "Unused eslint-disable directive (no problems were reported from 'no-foo').",
+					output:      "bar +foo;",
+				},
+			},
+		},
+		{
+			name:     "only some listed rules unused",
+			code:     "foo; // eslint-disable-line no-bar, no-foo, no-baz -- legacy code",
+			reported: []string{"no-foo"},
+			expected: []unusedDirective{
+				{
+					description: "Unused eslint-disable directive (no problems were reported from 'no-bar' or 'no-baz').",
+					output:      "foo; // eslint-disable-line no-foo -- legacy code",
+				},
+			},
+		},
+		{
+			name:     "adjacent unused rules",
+			code:     "foo; // eslint-disable-line a, b, no-foo",
+			reported: []string{"no-foo"},
+			expected: []unusedDirective{
+				{
+					description: "Unused eslint-disable directive (no problems were reported from 'a' or 'b').",
+					output:      "foo; // eslint-disable-line no-foo",
+				},
+			},
+		},
+		{
+			name:     "adjacent unused rules at the end of a block comment",
+			code:     "/* eslint-disable no-foo,no-bar,no-baz */\nfoo;",
+			reported: []string{"no-foo"},
+			expected: []unusedDirective{
+				{
+					description: "Unused eslint-disable directive (no problems were reported from 'no-bar' or 'no-baz').",
+					output:      "/* eslint-disable no-foo */\nfoo;",
+				},
+			},
+		},
+		{
+			name:     "unused rule in the middle of the list",
+			code:     "/* eslint-disable no-bar, no-baz, no-foo */\nfoo;",
+			reported: []string{"no-foo", "no-bar"},
+			expected: []unusedDirective{
+				{
+					description: "Unused eslint-disable directive (no problems were reported from 'no-baz').",
+					output:      "/* eslint-disable no-bar, no-foo */\nfoo;",
+				},
+			},
+		},
+		{
+			name:     "used disable block",
+			code:     "/* eslint-disable */\nbar;\nfoo;",
+			reported: []string{"no-foo"},
+		},
+		{
+			name:     "disable block after the diagnostic",
+			code:     "foo;\n/* eslint-disable no-foo, no-bar */",
+			reported: []string{"no-foo"},
+			expected: []unusedDirective{
+				{
+					description: "Unused eslint-disable directive (no problems were reported from 'no-foo' or 'no-bar').",
+					output:      "foo;",
+				},
+			},
+		},
+		{
+			name:     "enable a rule while all rules are disabled",
+			code:     "/* eslint-disable */\n/* eslint-enable no-foo */\nfoo;",
+			reported: []string{"no-foo"},
+			expected: []unusedDirective{
+				{
+					description: "Unused eslint-disable directive (no problems were reported).",
+					output:      "/* eslint-enable no-foo */\nfoo;",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := createTokenTestContext(t, tt.code)
+			var comments []*ast.CommentRange
+			utils.ForEachComment(&ctx.SourceFile.Node, func(comment *ast.CommentRange) { comments = append(comments, comment) }, ctx.SourceFile)
+			dm := NewDisableManager(ctx.SourceFile, comments)
+
+			for _, ruleName := range tt.reported {
+				dm.IsRuleDisabled(ruleName, strings.Index(tt.code, "foo;"))
+			}
+
+			unused := dm.GetUnusedDirectives()
+			if len(unused) != len(tt.expected) {
+				t.Fatalf("Expected %d unused directives, got %d: %v", len(tt.expected), len(unused), unused)
+			}
+			for i, expected := range tt.expected {
+				if unused[i].Message.Description != expected.description {
+					t.Errorf("Expected description %q, got %q", expected.description, unused[i].Message.Description)
+				}
+				fix := unused[i].Fix
+				output := tt.code[:fix.Range.Pos()] + fix.Text + tt.code[fix.Range.End():]
+				if output != expected.output {
+					t.Errorf("Expected output %q, got %q", expected.output, output)
+				}
+			}
+		})
+	}
+}
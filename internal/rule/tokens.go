@@ -0,0 +1,186 @@
+package rule
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Token is a single token of the source file. Comments and whitespace are trivia
+// and never returned as tokens.
+type Token struct {
+	// Kind is the syntax kind of the token, e.g. ast.KindSemicolonToken or ast.KindIdentifier
+	Kind ast.Kind
+	// Range covers the token text, without leading trivia
+	Range core.TextRange
+	// Text is the source text of the token
+	Text string
+}
+
+// GetFirstToken returns the first token of node, or nil if node is empty
+func (ctx RuleContext) GetFirstToken(node *ast.Node) *Token {
+	return firstTokenOf(ctx.SourceFile, node)
+}
+
+// GetTokenBefore returns the token immediately preceding node, or nil if node
+// starts the file
+func (ctx RuleContext) GetTokenBefore(node *ast.Node) *Token {
+	for current := node; current.Parent != nil; current = current.Parent {
+		parent := current.Parent
+		children := getChildren(parent)
+
+		end := current.Pos()
+		for i := len(children) - 1; i >= 0; i-- {
+			child := children[i]
+			if child == current || child.End() > current.Pos() {
+				continue
+			}
+			if tokens := scanTokens(ctx.SourceFile, child.End(), end); len(tokens) > 0 {
+				return &tokens[len(tokens)-1]
+			}
+			if token := lastTokenOf(ctx.SourceFile, child); token != nil {
+				return token
+			}
+			end = child.Pos()
+		}
+		if tokens := scanTokens(ctx.SourceFile, parent.Pos(), end); len(tokens) > 0 {
+			return &tokens[len(tokens)-1]
+		}
+	}
+	return nil
+}
+
+// GetTokenAfter returns the token immediately following node, or nil if node
+// ends the file
+func (ctx RuleContext) GetTokenAfter(node *ast.Node) *Token {
+	for current := node; current.Parent != nil; current = current.Parent {
+		parent := current.Parent
+		children := getChildren(parent)
+
+		pos := current.End()
+		for _, child := range children {
+			if child == current || child.Pos() < current.End() {
+				continue
+			}
+			if tokens := scanTokens(ctx.SourceFile, pos, child.Pos()); len(tokens) > 0 {
+				return &tokens[0]
+			}
+			if token := firstTokenOf(ctx.SourceFile, child); token != nil {
+				return token
+			}
+			pos = child.End()
+		}
+		if tokens := scanTokens(ctx.SourceFile, pos, parent.End()); len(tokens) > 0 {
+			return &tokens[0]
+		}
+	}
+	return nil
+}
+
+func getChildren(node *ast.Node) []*ast.Node {
+	var children []*ast.Node
+	node.ForEachChild(func(child *ast.Node) bool {
+		children = append(children, child)
+		return false
+	})
+	return children
+}
+
+// scanTokens returns the tokens starting in [pos, end). It is only used on text outside
+// of child nodes, which holds punctuation and keywords but no template or regex literals,
+// so the scanner needs no rescanning context there.
+func scanTokens(sourceFile *ast.SourceFile, pos int, end int) []Token {
+	if pos >= end {
+		return nil
+	}
+	var tokens []Token
+	s := scanner.GetScannerForSourceFile(sourceFile, pos)
+	for s.Token() != ast.KindEndOfFile && s.TokenStart() < end {
+		tokens = append(tokens, Token{
+			Kind:  s.Token(),
+			Range: core.NewTextRange(s.TokenStart(), s.TokenEnd()),
+			Text:  s.TokenText(),
+		})
+		s.Scan()
+	}
+	return tokens
+}
+
+// isSingleToken checks whether node consists of exactly one token. Literals are scanned
+// by the parser with context the plain scanner lacks, so they are taken from the node.
+func isSingleToken(node *ast.Node) bool {
+	switch node.Kind {
+	case ast.KindIdentifier, ast.KindPrivateIdentifier:
+		return true
+	}
+	return ast.IsTokenKind(node.Kind) || ast.IsLiteralKind(node.Kind) || ast.IsTemplateLiteralKind(node.Kind)
+}
+
+// leafTokens returns the tokens of a node without children, e.g. `break;`
+func leafTokens(sourceFile *ast.SourceFile, node *ast.Node) []Token {
+	if !isSingleToken(node) {
+		return scanTokens(sourceFile, node.Pos(), node.End())
+	}
+	textRange := utils.TrimNodeTextRange(sourceFile, node)
+	// Empty nodes such as omitted array elements have no token
+	if textRange.Len() == 0 {
+		return nil
+	}
+	return []Token{{
+		Kind:  node.Kind,
+		Range: textRange,
+		Text:  sourceFile.Text()[textRange.Pos():textRange.End()],
+	}}
+}
+
+func firstTokenOf(sourceFile *ast.SourceFile, node *ast.Node) *Token {
+	children := getChildren(node)
+	if len(children) == 0 {
+		if tokens := leafTokens(sourceFile, node); len(tokens) > 0 {
+			return &tokens[0]
+		}
+		return nil
+	}
+
+	pos := node.Pos()
+	for _, child := range children {
+		if tokens := scanTokens(sourceFile, pos, child.Pos()); len(tokens) > 0 {
+			return &tokens[0]
+		}
+		if token := firstTokenOf(sourceFile, child); token != nil {
+			return token
+		}
+		pos = child.End()
+	}
+	if tokens := scanTokens(sourceFile, pos, node.End()); len(tokens) > 0 {
+		return &tokens[0]
+	}
+	return nil
+}
+
+func lastTokenOf(sourceFile *ast.SourceFile, node *ast.Node) *Token {
+	children := getChildren(node)
+	if len(children) == 0 {
+		if tokens := leafTokens(sourceFile, node); len(tokens) > 0 {
+			return &tokens[len(tokens)-1]
+		}
+		return nil
+	}
+
+	end := node.End()
+	for i := len(children) - 1; i >= 0; i-- {
+		child := children[i]
+		if tokens := scanTokens(sourceFile, child.End(), end); len(tokens) > 0 {
+			return &tokens[len(tokens)-1]
+		}
+		if token := lastTokenOf(sourceFile, child); token != nil {
+			return token
+		}
+		end = child.Pos()
+	}
+	if tokens := scanTokens(sourceFile, node.Pos(), end); len(tokens) > 0 {
+		return &tokens[len(tokens)-1]
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+func createTokenTestContext(t *testing.T, code string) RuleContext {
+	t.Helper()
+
+	rootDir := fixtures.GetRootDir()
+	fs := utils.NewOverlayVFSForFile(tspath.ResolvePath(rootDir, "file.ts"), code)
+	host := utils.CreateCompilerHost(rootDir, fs)
+	program, err := utils.CreateProgram(true, fs, rootDir, "tsconfig.json", host)
+	if err != nil {
+		t.Fatalf("couldn't create program: %v", err)
+	}
+	return RuleContext{
+		SourceFile: program.GetSourceFile("file.ts"),
+		Program:    program,
+	}
+}
+
+// findNode returns the first node of the given kind whose text is text
+func findNode(ctx RuleContext, kind ast.Kind, text string) *ast.Node {
+	var found *ast.Node
+	var visit func(node *ast.Node) bool
+	visit = func(node *ast.Node) bool {
+		if node.Kind == kind {
+			textRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			if ctx.SourceFile.Text()[textRange.Pos():textRange.End()] == text {
+				found = node
+				return true
+			}
+		}
+		return node.ForEachChild(visit)
+	}
+	ctx.SourceFile.AsNode().ForEachChild(visit)
+	return found
+}
+
+func TestTokenAccessors(t *testing.T) {
+	code := "const a = foo(1, 2);\nlet b = a /* comment */ + `x${a}y`;\nif (b) break;\n"
+	ctx := createTokenTestContext(t, code)
+
+	tests := []struct {
+		name     string
+		kind     ast.Kind
+		nodeText string
+		get      func(node *ast.Node) *Token
+		expected string
+		expKind  ast.Kind
+	}{
+		{
+			name:     "first token of a statement",
+			kind:     ast.KindVariableStatement,
+			nodeText: "const a = foo(1, 2);",
+			get:      ctx.GetFirstToken,
+			expected: "const",
+			expKind:  ast.KindConstKeyword,
+		},
+		{
+			name:     "token before a call",
+			kind:     ast.KindCallExpression,
+			nodeText: "foo(1, 2)",
+			get:      ctx.GetTokenBefore,
+			expected: "=",
+			expKind:  ast.KindEqualsToken,
+		},
+		{
+			name:     "token after a call",
+			kind:     ast.KindCallExpression,
+			nodeText: "foo(1, 2)",
+			get:      ctx.GetTokenAfter,
+			expected: ";",
+			expKind:  ast.KindSemicolonToken,
+		},
+		{
+			name:     "token before an argument",
+			kind:     ast.KindNumericLiteral,
+			nodeText: "2",
+			get:      ctx.GetTokenBefore,
+			expected: ",",
+			expKind:  ast.KindCommaToken,
+		},
+		{
+			name:     "token after an operand skips comments",
+			kind:     ast.KindBinaryExpression,
+			nodeText: "a /* comment */ + `x${a}y`",
+			get: func(node *ast.Node) *Token {
+				return ctx.GetTokenAfter(node.AsBinaryExpression().Left)
+			},
+			expected: "+",
+			expKind:  ast.KindPlusToken,
+		},
+		{
+			name:     "token before a template literal",
+			kind:     ast.KindTemplateExpression,
+			nodeText: "`x${a}y`",
+			get:      ctx.GetTokenBefore,
+			expected: "+",
+			expKind:  ast.KindPlusToken,
+		},
+		{
+			name:     "token after a template literal",
+			kind:     ast.KindTemplateExpression,
+			nodeText: "`x${a}y`",
+			get:      ctx.GetTokenAfter,
+			expected: ";",
+			expKind:  ast.KindSemicolonToken,
+		},
+		{
+			name:     "token before a statement is the last token of the previous one",
+			kind:     ast.KindIfStatement,
+			nodeText: "if (b) break;",
+			get:      ctx.GetTokenBefore,
+			expected: ";",
+			expKind:  ast.KindSemicolonToken,
+		},
+		{
+			name:     "first token of a statement without children",
+			kind:     ast.KindBreakStatement,
+			nodeText: "break;",
+			get:      ctx.GetFirstToken,
+			expected: "break",
+			expKind:  ast.KindBreakKeyword,
+		},
+		{
+			name:     "token before a nested statement",
+			kind:     ast.KindBreakStatement,
+			nodeText: "break;",
+			get:      ctx.GetTokenBefore,
+			expected: ")",
+			expKind:  ast.KindCloseParenToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := findNode(ctx, tt.kind, tt.nodeText)
+			if node == nil {
+				t.Fatalf("node %q not found", tt.nodeText)
+			}
+			token := tt.get(node)
+			if token == nil {
+				t.Fatalf("expected token %q, got nil", tt.expected)
+			}
+			if token.Text != tt.expected || token.Kind != tt.expKind {
+				t.Errorf("expected token %q (%v), got %q (%v)", tt.expected, tt.expKind, token.Text, token.Kind)
+			}
+			if code[token.Range.Pos():token.Range.End()] != token.Text {
+				t.Errorf("token range %v does not match text %q", token.Range, token.Text)
+			}
+		})
+	}
+}
+
+func TestTokenAccessorsAtFileBoundaries(t *testing.T) {
+	ctx := createTokenTestContext(t, "/* leading */ foo();\n// trailing\n")
+
+	statement := ctx.SourceFile.Statements.Nodes[0]
+	if token := ctx.GetTokenBefore(statement); token != nil {
+		t.Errorf("expected no token before the first statement, got %q", token.Text)
+	}
+	if token := ctx.GetTokenAfter(statement); token != nil {
+		t.Errorf("expected no token after the last statement, got %q", token.Text)
+	}
+	if token := ctx.GetFirstToken(statement); token == nil || token.Text != "foo" {
+		t.Errorf("expected first token %q, got %v", "foo", token)
+	}
+}
@@ -0,0 +1,250 @@
+package accessor_pairs
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+type AccessorPairsOptions struct {
+	GetWithoutSet          bool `json:"getWithoutSet"`
+	SetWithoutGet          bool `json:"setWithoutGet"`
+	EnforceForClassMembers bool `json:"enforceForClassMembers"`
+}
+
+// Message builders
+func buildMissingGetterInPropertyDescriptorMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "missingGetterInPropertyDescriptor",
+		Description: "Getter is not present in property descriptor.",
+	}
+}
+
+func buildMissingSetterInPropertyDescriptorMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "missingSetterInPropertyDescriptor",
+		Description: "Setter is not present in property descriptor.",
+	}
+}
+
+func buildMissingGetterInObjectLiteralMessage(name string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "missingGetterInObjectLiteral",
+		Description: "Getter is not present for " + name + ".",
+	}
+}
+
+func buildMissingSetterInObjectLiteralMessage(name string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "missingSetterInObjectLiteral",
+		Description: "Setter is not present for " + name + ".",
+	}
+}
+
+func buildMissingGetterInClassMessage(name string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "missingGetterInClass",
+		Description: "Getter is not present for class " + name + ".",
+	}
+}
+
+func buildMissingSetterInClassMessage(name string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "missingSetterInClass",
+		Description: "Setter is not present for class " + name + ".",
+	}
+}
+
+type accessorGroup struct {
+	key     string
+	getters []*ast.Node
+	setters []*ast.Node
+}
+
+// getMethodName returns e.g. `Object.defineProperty` for a call of an object's method
+func getMethodName(call *ast.Node) string {
+	callee := ast.SkipParentheses(call.Expression())
+	if callee.Kind != ast.KindPropertyAccessExpression {
+		return ""
+	}
+	object := ast.SkipParentheses(callee.Expression())
+	if object.Kind != ast.KindIdentifier {
+		return ""
+	}
+	return object.Text() + "." + callee.AsPropertyAccessExpression().Name().Text()
+}
+
+// isPropertyDescriptor checks whether an object literal is passed as a property descriptor, e.g.
+// `Object.defineProperty(obj, 'foo', descriptor)` or `Object.defineProperties(obj, { foo: descriptor })`
+func isPropertyDescriptor(object *ast.Node) bool {
+	parent := ast.WalkUpParenthesizedExpressions(object.Parent)
+	if parent == nil {
+		return false
+	}
+
+	if parent.Kind == ast.KindCallExpression {
+		args := parent.Arguments()
+		switch getMethodName(parent) {
+		case "Object.defineProperty", "Reflect.defineProperty":
+			return len(args) > 2 && ast.SkipParentheses(args[2]) == object
+		}
+		return false
+	}
+
+	// A descriptor nested in the properties map of Object.defineProperties() or Object.create()
+	if parent.Kind != ast.KindPropertyAssignment || ast.SkipParentheses(parent.Initializer()) != object {
+		return false
+	}
+	descriptors := parent.Parent
+	call := ast.WalkUpParenthesizedExpressions(descriptors.Parent)
+	if call == nil || call.Kind != ast.KindCallExpression {
+		return false
+	}
+	args := call.Arguments()
+	switch getMethodName(call) {
+	case "Object.defineProperties", "Object.create":
+		return len(args) > 1 && ast.SkipParentheses(args[1]) == descriptors
+	}
+	return false
+}
+
+// AccessorPairsRule enforces getter and setter pairs in objects and classes
+var AccessorPairsRule = rule.CreateRule(rule.Rule{
+	Name: "accessor-pairs",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := AccessorPairsOptions{
+			GetWithoutSet:          false,
+			SetWithoutGet:          true,
+			EnforceForClassMembers: true,
+		}
+
+		// Parse options with dual-format support (handles both array and object formats)
+		var optsMap map[string]interface{}
+		if options != nil {
+			switch v := options.(type) {
+			case []interface{}:
+				if len(v) > 0 {
+					optsMap, _ = v[0].(map[string]interface{})
+				}
+			case map[string]interface{}:
+				optsMap = v
+			}
+		}
+		if optsMap != nil {
+			if getWithoutSet, ok := optsMap["getWithoutSet"].(bool); ok {
+				opts.GetWithoutSet = getWithoutSet
+			}
+			if setWithoutGet, ok := optsMap["setWithoutGet"].(bool); ok {
+				opts.SetWithoutGet = setWithoutGet
+			}
+			if enforceForClassMembers, ok := optsMap["enforceForClassMembers"].(bool); ok {
+				opts.EnforceForClassMembers = enforceForClassMembers
+			}
+		}
+
+		if !opts.GetWithoutSet && !opts.SetWithoutGet {
+			return rule.RuleListeners{}
+		}
+
+		checkList := func(members []*ast.Node, inClass bool) {
+			var groups []*accessorGroup
+			for _, member := range members {
+				if member.Kind != ast.KindGetAccessor && member.Kind != ast.KindSetAccessor {
+					continue
+				}
+				key := utils.GetAccessorKey(ctx.SourceFile, member)
+				var group *accessorGroup
+				for _, g := range groups {
+					if g.key == key {
+						group = g
+						break
+					}
+				}
+				if group == nil {
+					group = &accessorGroup{key: key}
+					groups = append(groups, group)
+				}
+				if member.Kind == ast.KindGetAccessor {
+					group.getters = append(group.getters, member)
+				} else {
+					group.setters = append(group.setters, member)
+				}
+			}
+
+			for _, group := range groups {
+				if opts.SetWithoutGet && len(group.setters) > 0 && len(group.getters) == 0 {
+					for _, setter := range group.setters {
+						if inClass {
+							ctx.ReportRange(utils.GetAccessorHeadRange(ctx.SourceFile, setter), buildMissingGetterInClassMessage(utils.GetAccessorName(setter)))
+						} else {
+							ctx.ReportRange(utils.GetAccessorHeadRange(ctx.SourceFile, setter), buildMissingGetterInObjectLiteralMessage(utils.GetAccessorName(setter)))
+						}
+					}
+				}
+				if opts.GetWithoutSet && len(group.getters) > 0 && len(group.setters) == 0 {
+					for _, getter := range group.getters {
+						if inClass {
+							ctx.ReportRange(utils.GetAccessorHeadRange(ctx.SourceFile, getter), buildMissingSetterInClassMessage(utils.GetAccessorName(getter)))
+						} else {
+							ctx.ReportRange(utils.GetAccessorHeadRange(ctx.SourceFile, getter), buildMissingSetterInObjectLiteralMessage(utils.GetAccessorName(getter)))
+						}
+					}
+				}
+			}
+		}
+
+		// checkPropertyDescriptor looks for `get` and `set` keys in a descriptor object
+		checkPropertyDescriptor := func(node *ast.Node) {
+			hasGetter := false
+			hasSetter := false
+			for _, property := range node.AsObjectLiteralExpression().Properties.Nodes {
+				switch property.Kind {
+				case ast.KindPropertyAssignment, ast.KindShorthandPropertyAssignment, ast.KindMethodDeclaration:
+				default:
+					continue
+				}
+				name := property.Name()
+				if name == nil || name.Kind != ast.KindIdentifier {
+					continue
+				}
+				switch name.Text() {
+				case "get":
+					hasGetter = true
+				case "set":
+					hasSetter = true
+				}
+			}
+			if opts.SetWithoutGet && hasSetter && !hasGetter {
+				ctx.ReportNode(node, buildMissingGetterInPropertyDescriptorMessage())
+			}
+			if opts.GetWithoutSet && hasGetter && !hasSetter {
+				ctx.ReportNode(node, buildMissingSetterInPropertyDescriptorMessage())
+			}
+		}
+
+		checkClass := func(node *ast.Node) {
+			// Abstract accessors have no implementation to pair with
+			members := utils.Filter(node.Members(), func(member *ast.Node) bool {
+				return !ast.HasSyntacticModifier(member, ast.ModifierFlagsAbstract)
+			})
+			checkList(utils.Filter(members, func(member *ast.Node) bool {
+				return !ast.HasStaticModifier(member)
+			}), true)
+			checkList(utils.Filter(members, ast.HasStaticModifier), true)
+		}
+
+		listeners := rule.RuleListeners{
+			ast.KindObjectLiteralExpression: func(node *ast.Node) {
+				checkList(node.AsObjectLiteralExpression().Properties.Nodes, false)
+				if isPropertyDescriptor(node) {
+					checkPropertyDescriptor(node)
+				}
+			},
+		}
+		if opts.EnforceForClassMembers {
+			listeners[ast.KindClassDeclaration] = checkClass
+			listeners[ast.KindClassExpression] = checkClass
+		}
+		return listeners
+	},
+})
@@ -0,0 +1,173 @@
+package accessor_pairs
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestAccessorPairsRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&AccessorPairsRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			// Object literals
+			{Code: `({ a: 1 })`},
+			{Code: `({ get a() { return 1; }, set a(v) {} })`},
+			{Code: `({ set a(v) {}, b: 1, get a() { return 1; } })`},
+			{Code: `({ get a() { return 1; } })`},
+			{Code: `({ set ['a'](v) {}, get a() { return 1; } })`},
+			{Code: `({ set 10(v) {}, get 1e1() { return 1; } })`},
+			{Code: `({ set [a](v) {}, get [a]() { return 1; } })`},
+			{Code: `({ set [a + b](v) {}, get [a+b]() { return 1; } })`},
+			{
+				Code:    `({ get a() { return 1; }, set a(v) {} })`,
+				Options: map[string]interface{}{"getWithoutSet": true},
+			},
+			{
+				Code:    `({ set a(v) {} })`,
+				Options: map[string]interface{}{"setWithoutGet": false},
+			},
+
+			// Classes
+			{Code: `class A { set a(v) {} get a() { return 1; } }`},
+			{Code: `class A { get a() { return 1; } }`},
+			{Code: `class A { static set a(v) {} static get a() { return 1; } }`},
+			{Code: `class A { set #a(v) {} get #a() { return 1; } }`},
+			{Code: `abstract class A { abstract set a(v: number); }`},
+			{
+				Code:    `class A { set a(v) {} }`,
+				Options: []interface{}{map[string]interface{}{"enforceForClassMembers": false}},
+			},
+			{
+				Code:    `(class { get a() { return 1; } static set a(v) {} })`,
+				Options: []interface{}{map[string]interface{}{"getWithoutSet": true, "enforceForClassMembers": false}},
+			},
+
+			// Property descriptors
+			{Code: `Object.defineProperty(obj, 'a', { set: function(v) {}, get: function() { return 1; } })`},
+			{Code: `Object.defineProperty(obj, 'a', { get: function() { return 1; } })`},
+			{Code: `Object.defineProperty(obj, 'a', { value: 1 })`},
+			{Code: `Object.defineProperties(obj, { a: { set(v) {}, get() { return 1; } } })`},
+			{Code: `foo(obj, 'a', { set: function(v) {} })`},
+			{Code: `Object.defineProperty(obj, 'a', { ['set']: function(v) {} })`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			// Object literals
+			{
+				Code: `({ set a(v) {} })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingGetterInObjectLiteral", Line: 1, Column: 4},
+				},
+			},
+			{
+				Code:    `({ get a() { return 1; } })`,
+				Options: map[string]interface{}{"getWithoutSet": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingSetterInObjectLiteral", Line: 1, Column: 4},
+				},
+			},
+			{
+				Code:    `({ set [a](v) {}, get [b]() { return 1; } })`,
+				Options: []interface{}{map[string]interface{}{"getWithoutSet": true}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingGetterInObjectLiteral", Line: 1, Column: 4},
+					{MessageId: "missingSetterInObjectLiteral", Line: 1, Column: 19},
+				},
+			},
+			{
+				Code:    `({ get a() { return 1; }, set [a](v) {} })`,
+				Options: map[string]interface{}{"getWithoutSet": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingSetterInObjectLiteral", Line: 1, Column: 4},
+					{MessageId: "missingGetterInObjectLiteral", Line: 1, Column: 27},
+				},
+			},
+			{
+				Code: `({ set a(v) {}, set a(v) {} })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingGetterInObjectLiteral", Line: 1, Column: 4},
+					{MessageId: "missingGetterInObjectLiteral", Line: 1, Column: 17},
+				},
+			},
+
+			// Classes
+			{
+				Code: `class A { set a(v) {} }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingGetterInClass", Line: 1, Column: 11},
+				},
+			},
+			{
+				Code: `(class { set a(v) {} })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingGetterInClass", Line: 1, Column: 10},
+				},
+			},
+			{
+				Code: `class A { static set a(v) {} }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingGetterInClass", Line: 1, Column: 11},
+				},
+			},
+			{
+				Code: `class A { static set a(v) {} get a() { return 1; } }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingGetterInClass", Line: 1, Column: 11},
+				},
+			},
+			{
+				Code: `class A { set [a](v) {} get [b]() { return 1; } }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingGetterInClass", Line: 1, Column: 11},
+				},
+			},
+			{
+				Code:    `class A { get a() { return 1; } }`,
+				Options: map[string]interface{}{"getWithoutSet": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingSetterInClass", Line: 1, Column: 11},
+				},
+			},
+			{
+				Code:    `class A { get #a() { return 1; } }`,
+				Options: map[string]interface{}{"getWithoutSet": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingSetterInClass", Line: 1, Column: 11},
+				},
+			},
+
+			// Property descriptors
+			{
+				Code: `Object.defineProperty(obj, 'a', { set: function(v) {} })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingGetterInPropertyDescriptor", Line: 1, Column: 33},
+				},
+			},
+			{
+				Code: `Reflect.defineProperty(obj, 'a', { set(v) {} })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingGetterInPropertyDescriptor", Line: 1, Column: 34},
+				},
+			},
+			{
+				Code: `Object.defineProperties(obj, { a: { set(v) {} } })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingGetterInPropertyDescriptor", Line: 1, Column: 35},
+				},
+			},
+			{
+				Code:    `Object.create(null, { a: { get: function() { return 1; } } })`,
+				Options: map[string]interface{}{"getWithoutSet": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingSetterInPropertyDescriptor", Line: 1, Column: 26},
+				},
+			},
+		},
+	)
+}
@@ -1,7 +1,10 @@
 package array_callback_return
 
 import (
+	"strconv"
+
 	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/checker"
 	"github.com/web-infra-dev/rslint/internal/rule"
 )
 
@@ -120,12 +123,20 @@ func getMethodName(node *ast.Node) string {
 		return ""
 	}
 
-	// Handle PropertyAccessExpression (e.g., arr.map)
-	if expr.Kind == ast.KindPropertyAccessExpression {
+	expr = ast.SkipParentheses(expr)
+	switch expr.Kind {
+	case ast.KindPropertyAccessExpression:
+		// arr.map, arr?.map
 		name := expr.Name()
 		if name != nil && name.Kind == ast.KindIdentifier {
 			return name.Text()
 		}
+	case ast.KindElementAccessExpression:
+		// arr['map']
+		argument := ast.SkipParentheses(expr.AsElementAccessExpression().ArgumentExpression)
+		if argument.Kind == ast.KindStringLiteral || argument.Kind == ast.KindNoSubstitutionTemplateLiteral {
+			return argument.Text()
+		}
 	}
 
 	return ""
@@ -171,45 +182,7 @@ func isGeneratorOrAsyncFunction(node *ast.Node) bool {
 	if node == nil {
 		return false
 	}
-
-	// Check if it has async modifier
-	modifiers := node.Modifiers()
-	if modifiers != nil {
-		for _, mod := range modifiers.Nodes {
-			if mod != nil && mod.Kind == ast.KindAsyncKeyword {
-				return true
-			}
-		}
-	}
-
-	// Check for generator function (function*)
-	// Generators contain yield expressions
-	// We check recursively through the body
-	if containsYield(node.Body()) {
-		return true
-	}
-
-	return false
-}
-
-// containsYield recursively checks if a node contains a yield expression
-func containsYield(node *ast.Node) bool {
-	if node == nil {
-		return false
-	}
-	if node.Kind == ast.KindYieldExpression {
-		return true
-	}
-	// Recursively check children
-	found := false
-	node.ForEachChild(func(child *ast.Node) bool {
-		if containsYield(child) {
-			found = true
-			return true // Stop iteration
-		}
-		return false // Continue iteration
-	})
-	return found
+	return checker.GetFunctionFlags(node)&(checker.FunctionFlagsAsync|checker.FunctionFlagsGenerator) != 0
 }
 
 // isVoidExpression checks if an expression is a void expression
@@ -297,10 +270,9 @@ func analyzeCallbackReturns(body *ast.Node, allowImplicit bool) callbackReturnRe
 	hasReturnWithValue := false
 	hasReturnWithoutValue := false
 
-	// Use ForEachReturnStatement to find all return statements
+	// ForEachReturnStatement doesn't descend into nested functions
 	ast.ForEachReturnStatement(body, func(stmt *ast.Node) bool {
-		expr := stmt.Expression()
-		if expr != nil {
+		if stmt.Expression() != nil {
 			hasReturnWithValue = true
 		} else {
 			hasReturnWithoutValue = true
@@ -308,203 +280,156 @@ func analyzeCallbackReturns(body *ast.Node, allowImplicit bool) callbackReturnRe
 		return false // Continue iterating
 	})
 
-	// Track if we found at least one return with value
-	result := callbackReturnResult{
+	// Empty returns only count as returning a value with allowImplicit
+	returnsValue := hasReturnWithValue || (allowImplicit && hasReturnWithoutValue)
+
+	return callbackReturnResult{
+		hasNoReturns:       !returnsValue,
+		allPathsReturn:     returnsValue && (allowImplicit || !hasReturnWithoutValue) && !canCompleteNormally(body),
 		hasReturnWithValue: hasReturnWithValue,
 	}
+}
 
-	// If allowImplicit is true, empty returns are acceptable
-	if allowImplicit && hasReturnWithoutValue && !hasReturnWithValue {
-		return callbackReturnResult{
-			hasNoReturns:       false,
-			allPathsReturn:     true,
-			hasReturnWithValue: false,
-		}
+// canCompleteNormally checks whether control can reach the end of a statement,
+// i.e. whether it can finish without returning or throwing
+func canCompleteNormally(stmt *ast.Node) bool {
+	if stmt == nil {
+		return true
 	}
 
-	// Determine if this is a simple case or complex case
-	isSingleReturn := false
-	if body.Kind == ast.KindBlock {
-		statements := body.Statements()
-		if len(statements) == 1 && statements[0].Kind == ast.KindReturnStatement {
-			isSingleReturn = true
-		}
-	}
+	switch stmt.Kind {
+	case ast.KindReturnStatement, ast.KindThrowStatement:
+		return false
 
-	// If we have no return with value at all (and empty returns don't count unless allowImplicit)
-	if !hasReturnWithValue && (!allowImplicit || !hasReturnWithoutValue) {
-		return callbackReturnResult{
-			hasNoReturns:       true,
-			allPathsReturn:     false,
-			hasReturnWithValue: false,
-		}
-	}
+	case ast.KindBlock:
+		return statementsCompleteNormally(stmt.Statements())
 
-	// Heuristic for determining if all paths return:
-	// This is a simplified heuristic that works for common cases
-	countReturnsWithValue := 0
-	ast.ForEachReturnStatement(body, func(stmt *ast.Node) bool {
-		if stmt.Expression() != nil {
-			countReturnsWithValue++
+	case ast.KindIfStatement:
+		ifStmt := stmt.AsIfStatement()
+		if ifStmt.ElseStatement == nil {
+			return true
 		}
-		return false
-	})
-
-	// For try-catch blocks, if the try block has a return, we consider it valid
-	// even if the catch doesn't (since the catch only runs on exception)
-	hasTryStatement := false
-	if body.Kind == ast.KindBlock {
-		statements := body.Statements()
-		for _, stmt := range statements {
-			if stmt != nil {
-				if stmt.Kind == ast.KindTryStatement {
-					hasTryStatement = true
-				}
+		return canCompleteNormally(ifStmt.ThenStatement) || canCompleteNormally(ifStmt.ElseStatement)
+
+	case ast.KindSwitchStatement:
+		caseBlock := stmt.AsSwitchStatement().CaseBlock
+		hasDefault := false
+		var lastClause *ast.Node
+		for _, clause := range caseBlock.AsCaseBlock().Clauses.Nodes {
+			if clause.Kind == ast.KindDefaultClause {
+				hasDefault = true
 			}
+			if hasBreak(clause, "") {
+				return true
+			}
+			lastClause = clause
 		}
-	}
+		// Without a default clause some values skip every case; otherwise the last clause may fall out
+		return !hasDefault || statementsCompleteNormally(lastClause.AsCaseOrDefaultClause().Statements.Nodes)
 
-	// Check if all paths return based on the structure
-	// We use several heuristics:
-	// 1. Single return statement (obviously all paths return)
-	// 2. Simple body with no empty returns and at least one return
-	// 3. Try-catch with a return in the try block
-	// 4. If-else statements where both branches return
-	hasIfElseWithReturns := checkIfElseReturns(body)
-
-	// Note: We intentionally don't try to detect all control flow patterns perfectly
-	// as this requires proper control flow analysis which is complex
-	allPathsReturn := isSingleReturn ||
-		(!hasReturnWithoutValue && isSimpleBody(body) && hasReturnWithValue) ||
-		(hasTryStatement && hasReturnWithValue) ||
-		hasIfElseWithReturns
-
-	result.hasNoReturns = false
-	result.allPathsReturn = allPathsReturn
-
-	return result
-}
+	case ast.KindTryStatement:
+		tryStmt := stmt.AsTryStatement()
+		if tryStmt.FinallyBlock != nil && !canCompleteNormally(tryStmt.FinallyBlock) {
+			return false
+		}
+		if canCompleteNormally(tryStmt.TryBlock) {
+			return true
+		}
+		return tryStmt.CatchClause != nil && canCompleteNormally(tryStmt.CatchClause.AsCatchClause().Block)
 
-// isSimpleBody checks if a function body is simple enough that we can assume all paths return
-func isSimpleBody(body *ast.Node) bool {
-	if body == nil || body.Kind != ast.KindBlock {
-		return true
-	}
+	case ast.KindWhileStatement:
+		whileStmt := stmt.AsWhileStatement()
+		return !isConstantTrue(whileStmt.Expression) || hasBreak(whileStmt.Statement, "")
 
-	statements := body.Statements()
-	if len(statements) == 0 {
-		return true
-	}
+	case ast.KindForStatement:
+		forStmt := stmt.AsForStatement()
+		return !isConstantTrue(forStmt.Condition) || hasBreak(forStmt.Statement, "")
 
-	// Check for control flow statements (if, switch, loops, etc.)
-	for _, stmt := range statements {
-		if stmt == nil {
-			continue
+	case ast.KindDoStatement:
+		doStmt := stmt.AsDoStatement()
+		if hasBreak(doStmt.Statement, "") {
+			return true
 		}
-		switch stmt.Kind {
-		case ast.KindIfStatement, ast.KindSwitchStatement,
-			ast.KindForStatement, ast.KindForInStatement, ast.KindForOfStatement,
-			ast.KindWhileStatement, ast.KindDoStatement,
-			ast.KindTryStatement:
-			// Has control flow - not simple
+		// The condition is only evaluated if the body can finish or continue
+		if !canCompleteNormally(doStmt.Statement) && !hasContinue(doStmt.Statement) {
 			return false
 		}
+		return !isConstantTrue(doStmt.Expression)
+
+	case ast.KindLabeledStatement:
+		labeled := stmt.AsLabeledStatement()
+		return canCompleteNormally(labeled.Statement) || hasBreak(labeled.Statement, labeled.Label.Text())
 	}
 
 	return true
 }
 
-// checkIfElseReturns checks if an if-else statement has returns in all branches
-// This properly analyzes if-else chains to ensure all branches return
-func checkIfElseReturns(body *ast.Node) bool {
-	if body == nil || body.Kind != ast.KindBlock {
-		return false
-	}
-
-	statements := body.Statements()
-	if len(statements) == 0 {
-		return false
-	}
-
-	// Check if the function body consists of a single if-else chain that covers all paths
-	// For example:
-	// if (a) { return x; } else { return y; }  -> all paths return
-	// if (a) { return x; } else if (b) { return y; }  -> NOT all paths (missing final else)
-	// if (a) { return x; } else if (b) { return y; } else { return z; }  -> all paths return
-
+// statementsCompleteNormally checks whether control can reach the end of a statement list
+func statementsCompleteNormally(statements []*ast.Node) bool {
 	for _, stmt := range statements {
-		if stmt == nil {
-			continue
-		}
-		if stmt.Kind == ast.KindIfStatement {
-			if ifStatementCoversAllPaths(stmt) {
-				return true
-			}
+		if !canCompleteNormally(stmt) {
+			return false
 		}
 	}
-
-	return false
+	return true
 }
 
-// ifStatementCoversAllPaths checks if an if-statement covers all code paths with returns
-func ifStatementCoversAllPaths(ifStmt *ast.Node) bool {
-	if ifStmt == nil || ifStmt.Kind != ast.KindIfStatement {
-		return false
-	}
-
-	// Access the IfStatement structure
-	ifStmtData := ifStmt.AsIfStatement()
-	if ifStmtData == nil {
-		return false
-	}
-
-	// Get the then statement
-	thenStmt := ifStmtData.ThenStatement
-	if thenStmt == nil || !blockReturnsValue(thenStmt) {
-		return false
+// isConstantTrue checks for loop conditions that never end the loop, e.g. `while (true)` or `for (;;)`
+func isConstantTrue(condition *ast.Node) bool {
+	if condition == nil {
+		return true
 	}
-
-	// Get the else statement
-	elseStmt := ifStmtData.ElseStatement
-	if elseStmt == nil {
-		// No else clause - doesn't cover all paths
-		return false
+	condition = ast.SkipParentheses(condition)
+	switch condition.Kind {
+	case ast.KindTrueKeyword:
+		return true
+	case ast.KindNumericLiteral:
+		value, err := strconv.ParseFloat(condition.Text(), 64)
+		return err == nil && value != 0
+	case ast.KindStringLiteral, ast.KindNoSubstitutionTemplateLiteral:
+		return condition.Text() != ""
 	}
+	return false
+}
 
-	// If the else statement is another if-statement (else if), check it recursively
-	if elseStmt.Kind == ast.KindIfStatement {
-		return ifStatementCoversAllPaths(elseStmt)
-	}
+// hasBreak checks whether node contains a break that exits the statement owning node.
+// An unlabeled break only counts outside of nested loops and switches.
+func hasBreak(node *ast.Node, label string) bool {
+	return containsJump(node, ast.KindBreakStatement, label, false)
+}
 
-	// Otherwise, check if the else block returns a value
-	return blockReturnsValue(elseStmt)
+// hasContinue checks whether node contains an unlabeled continue for the loop owning node
+func hasContinue(node *ast.Node) bool {
+	return containsJump(node, ast.KindContinueStatement, "", false)
 }
 
-// blockReturnsValue checks if a block/statement returns a value
-func blockReturnsValue(node *ast.Node) bool {
-	if node == nil {
-		return false
+func containsJump(node *ast.Node, kind ast.Kind, label string, nested bool) bool {
+	if node.Kind == kind {
+		jumpLabel := node.Label()
+		if jumpLabel == nil {
+			return !nested
+		}
+		return label != "" && jumpLabel.Text() == label
 	}
-
-	// Direct return statement
-	if node.Kind == ast.KindReturnStatement {
-		return node.Expression() != nil
+	if ast.IsFunctionLike(node) || ast.IsClassLike(node) {
+		return false
 	}
 
-	// Block statement - check if it ends with a return
-	if node.Kind == ast.KindBlock {
-		statements := node.Statements()
-		if len(statements) == 0 {
-			return false
-		}
-		// Check the last statement
-		lastStmt := statements[len(statements)-1]
-		if lastStmt != nil && lastStmt.Kind == ast.KindReturnStatement {
-			return lastStmt.Expression() != nil
-		}
+	switch node.Kind {
+	case ast.KindWhileStatement, ast.KindDoStatement, ast.KindForStatement,
+		ast.KindForInStatement, ast.KindForOfStatement:
+		nested = true
+	case ast.KindSwitchStatement:
+		// A continue inside a switch still targets the enclosing loop
+		nested = nested || kind == ast.KindBreakStatement
 	}
 
-	return false
+	found := false
+	node.ForEachChild(func(child *ast.Node) bool {
+		found = containsJump(child, kind, label, nested)
+		return found
+	})
+	return found
 }
 
 // ArrayCallbackReturnRule enforces return statements in callbacks of array methods
@@ -557,6 +482,7 @@ var ArrayCallbackReturnRule = rule.CreateRule(rule.Rule{
 				if callbackArg == nil {
 					return
 				}
+				callbackArg = ast.SkipParentheses(callbackArg)
 
 				// Check if the argument is a function
 				if !isFunctionNode(callbackArg) {
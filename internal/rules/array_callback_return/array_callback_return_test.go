@@ -96,10 +96,35 @@ func TestArrayCallbackReturnRule(t *testing.T) {
 			},
 
 			// Edge cases
-			{Code: `foo.map(function() { try { return true; } catch(e) {} });`},
 			{Code: `foo.map(async function() { return true; });`},
 			{Code: `foo.map(async () => true);`},
 			{Code: `foo.map(function*() { yield true; });`},
+
+			// Remaining methods
+			{Code: `foo.findIndex(function(x) { return x > 1; });`},
+			{Code: `foo.findLast(function(x) { return x > 1; });`},
+			{Code: `foo.findLastIndex(function(x) { return x > 1; });`},
+			{Code: `foo.reduceRight(function(acc, x) { return acc + x; }, 0);`},
+			{Code: `foo['map'](function() { return true; });`},
+			{Code: `foo?.filter(x => { return x; });`},
+			{Code: `foo.map((function() { return true; }));`},
+
+			// Every path returns a value
+			{Code: `foo.map(function(x) { switch (x) { case 1: return 1; default: return 2; } });`},
+			{Code: `foo.map(function(x) { switch (x) { case 1: case 2: return 1; default: throw new Error(); } });`},
+			{Code: `foo.map(function() { try { return bar(); } catch (e) { return null; } });`},
+			{Code: `foo.map(function() { try { bar(); } finally { return 1; } });`},
+			{Code: `foo.filter(function() { try { return true; } finally { bar(); } });`},
+			{Code: `foo.map(function() { while (true) { if (a) { return 1; } } });`},
+			{Code: `foo.map(function() { for (;;) { return 1; } });`},
+			{Code: `foo.map(function() { do { return 1; } while (a); });`},
+			{Code: `foo.map(function() { throw new Error(); });`},
+			{Code: `foo.map(function() { if (a) { return 1; } throw new Error(); });`},
+			{Code: `foo.find(x => { for (const y of x) { if (y) { return true; } } return false; });`},
+			{Code: `foo.map(function() { outer: { if (a) { break outer; } return 1; } return 2; });`},
+			{Code: `foo.map(function() { while (true) { for (const x of y) { break; } if (a) { return 1; } } });`},
+			{Code: `foo.map(function() { if (a) { return 1; } else if (b) { return 2; } else { return 3; } });`},
+			{Code: `foo.map(function() { if (a) { return; } return 1; });`, Options: map[string]interface{}{"allowImplicit": true}},
 		},
 		// Invalid cases
 		[]rule_tester.InvalidTestCase{
@@ -235,6 +260,81 @@ func TestArrayCallbackReturnRule(t *testing.T) {
 				},
 			},
 
+			// End of the function is reachable
+			{
+				Code: `foo.map(function() { try { return true; } catch(e) {} });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedAtEnd", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `foo.map(function(x) { switch (x) { case 1: return 1; } });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedAtEnd", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `foo.map(function(x) { switch (x) { case 1: return 1; default: break; } });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedAtEnd", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `foo.map(function() { while (true) { if (a) { break; } return 1; } });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedAtEnd", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `foo.map(function() { for (const x of y) { return x; } });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedAtEnd", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `foo.map(function() { do { if (a) { continue; } return 1; } while (b); });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedAtEnd", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `foo.map(function() { if (a) { return 1; } return; });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedAtEnd", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `foo.map(x => { if (x) { return 1; } });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedInside", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `foo.some(x => { while (x) { return true; } });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedInside", Line: 1, Column: 10},
+				},
+			},
+			{
+				Code:    `foo.map(function() { if (a) { return; } });`,
+				Options: map[string]interface{}{"allowImplicit": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedAtEnd", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `foo['map'](function() {});`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedAtEnd", Line: 1, Column: 12},
+				},
+			},
+			{
+				Code: `foo?.every((function() {}));`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedAtEnd", Line: 1, Column: 13},
+				},
+			},
+
 			// Empty return statements without allowImplicit
 			{
 				Code: `foo.map(function() { return; });`,
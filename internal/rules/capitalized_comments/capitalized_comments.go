@@ -0,0 +1,239 @@
+package capitalized_comments
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+var (
+	// Directive comments for ESLint and other tools are always allowed
+	defaultIgnorePattern = regexp.MustCompile(`^\s*(?:eslint|jshint\s+|jslint\s+|istanbul\s+|globals?\s+|exported\s+|jscs)`)
+	maybeURLPattern      = regexp.MustCompile(`^\s*[^:/?#\s]+://[^?#]`)
+	letterPattern        = regexp.MustCompile(`\p{L}`)
+)
+
+// CommentOptions configures the check for one kind of comment
+type CommentOptions struct {
+	IgnorePattern             string `json:"ignorePattern"`
+	IgnoreInlineComments      bool   `json:"ignoreInlineComments"`
+	IgnoreConsecutiveComments bool   `json:"ignoreConsecutiveComments"`
+
+	ignorePatternRegExp *regexp.Regexp
+}
+
+// Options for capitalized-comments rule
+type Options struct {
+	Capitalize string
+	Line       CommentOptions
+	Block      CommentOptions
+}
+
+func parseCommentOptions(optsMap map[string]interface{}) CommentOptions {
+	opts := CommentOptions{}
+	if optsMap == nil {
+		return opts
+	}
+	if v, ok := optsMap["ignorePattern"].(string); ok {
+		opts.IgnorePattern = v
+	}
+	if v, ok := optsMap["ignoreInlineComments"].(bool); ok {
+		opts.IgnoreInlineComments = v
+	}
+	if v, ok := optsMap["ignoreConsecutiveComments"].(bool); ok {
+		opts.IgnoreConsecutiveComments = v
+	}
+	if opts.IgnorePattern != "" {
+		// Invalid patterns are ignored rather than failing the whole lint run
+		if re, err := regexp.Compile(`^\s*(?:` + opts.IgnorePattern + `)`); err == nil {
+			opts.ignorePatternRegExp = re
+		}
+	}
+	return opts
+}
+
+func parseOptions(options any) Options {
+	opts := Options{
+		Capitalize: "always",
+	}
+
+	var optsMap map[string]interface{}
+	switch v := options.(type) {
+	case string:
+		opts.Capitalize = v
+	case []interface{}:
+		if len(v) > 0 {
+			if s, ok := v[0].(string); ok {
+				opts.Capitalize = s
+			}
+		}
+		if len(v) > 1 {
+			optsMap, _ = v[1].(map[string]interface{})
+		}
+	}
+
+	// Options are either shared by both kinds of comments or given per kind
+	opts.Line = parseCommentOptions(optsMap)
+	opts.Block = opts.Line
+	if line, ok := optsMap["line"].(map[string]interface{}); ok {
+		opts.Line = parseCommentOptions(line)
+	}
+	if block, ok := optsMap["block"].(map[string]interface{}); ok {
+		opts.Block = parseCommentOptions(block)
+	}
+	return opts
+}
+
+// Message builders
+func buildUnexpectedLowercaseCommentMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpectedLowercaseComment",
+		Description: "Comments should not begin with a lowercase character.",
+	}
+}
+
+func buildUnexpectedUppercaseCommentMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpectedUppercaseComment",
+		Description: "Comments should not begin with an uppercase character.",
+	}
+}
+
+// previousNonWhitespace returns the position of the last non-whitespace character before pos, or -1
+func previousNonWhitespace(text string, pos int) int {
+	for pos > 0 {
+		r, size := utf8.DecodeLastRuneInString(text[:pos])
+		if !unicode.IsSpace(r) {
+			return pos - size
+		}
+		pos -= size
+	}
+	return -1
+}
+
+// nextNonWhitespace returns the position of the first non-whitespace character at or after pos, or -1
+func nextNonWhitespace(text string, pos int) int {
+	for pos < len(text) {
+		r, size := utf8.DecodeRuneInString(text[pos:])
+		if !unicode.IsSpace(r) {
+			return pos
+		}
+		pos += size
+	}
+	return -1
+}
+
+// CapitalizedCommentsRule enforces or disallows capitalization of the first letter of a comment
+var CapitalizedCommentsRule = rule.CreateRule(rule.Rule{
+	Name: "capitalized-comments",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+		text := ctx.SourceFile.Text()
+		comments := ctx.GetAllComments()
+
+		getLine := func(pos int) int {
+			line, _ := scanner.GetLineAndCharacterOfPosition(ctx.SourceFile, pos)
+			return line
+		}
+
+		// isInlineComment checks whether comment has code before and after it on its lines
+		isInlineComment := func(comment rule.Comment) bool {
+			previous := previousNonWhitespace(text, comment.Range.Pos())
+			next := nextNonWhitespace(text, comment.Range.End())
+			return previous >= 0 && next >= 0 &&
+				getLine(previous) == getLine(comment.Range.Pos()) &&
+				getLine(next) == getLine(comment.Range.End())
+		}
+
+		// isConsecutiveComment checks whether comment directly follows another comment
+		isConsecutiveComment := func(index int) bool {
+			if index == 0 {
+				return false
+			}
+			previous := previousNonWhitespace(text, comments[index].Range.Pos())
+			return previous >= 0 && comments[index-1].Range.End() == previous+1
+		}
+
+		isCommentValid := func(index int, commentOpts CommentOptions) bool {
+			comment := comments[index]
+
+			if defaultIgnorePattern.MatchString(comment.Text) {
+				return true
+			}
+
+			withoutAsterisks := strings.ReplaceAll(comment.Text, "*", "")
+			if commentOpts.ignorePatternRegExp != nil && commentOpts.ignorePatternRegExp.MatchString(withoutAsterisks) {
+				return true
+			}
+
+			if commentOpts.IgnoreInlineComments && isInlineComment(comment) {
+				return true
+			}
+
+			if commentOpts.IgnoreConsecutiveComments && isConsecutiveComment(index) {
+				return true
+			}
+
+			if maybeURLPattern.MatchString(withoutAsterisks) {
+				return true
+			}
+
+			wordChars := strings.Join(strings.Fields(withoutAsterisks), "")
+			if wordChars == "" {
+				return true
+			}
+
+			first, _ := utf8.DecodeRuneInString(wordChars)
+			if !unicode.IsLetter(first) {
+				return true
+			}
+
+			isUppercase := first != unicode.ToLower(first)
+			isLowercase := first != unicode.ToUpper(first)
+			if opts.Capitalize == "always" && isLowercase {
+				return false
+			}
+			if opts.Capitalize == "never" && isUppercase {
+				return false
+			}
+			return true
+		}
+
+		for index, comment := range comments {
+			commentOpts := opts.Line
+			if comment.Kind == rule.CommentKindBlock {
+				commentOpts = opts.Block
+			}
+			if isCommentValid(index, commentOpts) {
+				continue
+			}
+
+			message := buildUnexpectedLowercaseCommentMessage()
+			if opts.Capitalize != "always" {
+				message = buildUnexpectedUppercaseCommentMessage()
+			}
+
+			match := letterPattern.FindStringIndex(comment.Text)
+			if match == nil {
+				ctx.ReportRange(comment.Range, message)
+				continue
+			}
+
+			// The comment text starts after the 2 characters of `//` or `/*`
+			letter := comment.Text[match[0]:match[1]]
+			replacement := strings.ToUpper(letter)
+			if opts.Capitalize != "always" {
+				replacement = strings.ToLower(letter)
+			}
+			start := comment.Range.Pos() + 2 + match[0]
+			ctx.ReportRangeWithFixes(comment.Range, message, rule.RuleFixReplaceRange(core.NewTextRange(start, start+len(letter)), replacement))
+		}
+
+		return rule.RuleListeners{}
+	},
+})
@@ -0,0 +1,206 @@
+package capitalized_comments
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestCapitalizedCommentsRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&CapitalizedCommentsRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: "//Uppercase"},
+			{Code: "// Uppercase"},
+			{Code: "/*Uppercase */"},
+			{Code: "/* Uppercase */"},
+			{Code: "/*\nUppercase */"},
+			{Code: "/** Uppercase */"},
+			{Code: "/**\n * Uppercase */"},
+			{Code: "// Élan"},
+			{Code: "//123"},
+			{Code: "// 123"},
+			{Code: "/*123*/"},
+			{Code: "//"},
+			{Code: "/**/"},
+			{Code: "/* */"},
+			{Code: "// !@#$"},
+
+			// Directive comments are always allowed
+			{Code: "// eslint-disable-line"},
+			{Code: "// eslint-disable-next-line no-console"},
+			{Code: "/* eslint-disable */"},
+			{Code: "//eslint-enable"},
+			{Code: "// jshint asi:true"},
+			{Code: "/* global foo */"},
+			{Code: "/* globals foo */"},
+			{Code: "/* exported myVar */"},
+			{Code: "// istanbul ignore next"},
+
+			// URLs
+			{Code: "// https://github.com"},
+			{Code: "/* https://github.com */"},
+			{Code: "// http://example.com/path?query"},
+			{Code: "// URL: http://example.com"},
+			{Code: "// URL: http://example.com", Options: []interface{}{"always", map[string]interface{}{"ignorePattern": "URL"}}},
+
+			// "never"
+			{Code: "//lowercase", Options: "never"},
+			{Code: "// lowercase", Options: []interface{}{"never"}},
+			{Code: "/*lowercase */", Options: []interface{}{"never"}},
+			{Code: "// 123", Options: []interface{}{"never"}},
+
+			// ignorePattern
+			{Code: "// matching", Options: []interface{}{"always", map[string]interface{}{"ignorePattern": "match"}}},
+			{Code: "// ignored", Options: []interface{}{"always", map[string]interface{}{"ignorePattern": "ignored?"}}},
+			{Code: "/** ignored */", Options: []interface{}{"always", map[string]interface{}{"ignorePattern": "ignored"}}},
+			{Code: "// Matching", Options: []interface{}{"never", map[string]interface{}{"ignorePattern": "Match"}}},
+			{Code: "// foo", Options: []interface{}{"always", map[string]interface{}{"line": map[string]interface{}{"ignorePattern": "foo"}}}},
+			{Code: "/* foo */", Options: []interface{}{"always", map[string]interface{}{"block": map[string]interface{}{"ignorePattern": "foo"}}}},
+
+			// ignoreInlineComments
+			{Code: "foo(/* ignored */ a);", Options: []interface{}{"always", map[string]interface{}{"ignoreInlineComments": true}}},
+			{Code: "foo(/* Ignored */ a);", Options: []interface{}{"never", map[string]interface{}{"ignoreInlineComments": true}}},
+
+			// ignoreConsecutiveComments
+			{Code: "// This comment is valid\n// and so is this one", Options: []interface{}{"always", map[string]interface{}{"ignoreConsecutiveComments": true}}},
+			{Code: "/* This comment is valid *//* and so is this one */", Options: []interface{}{"always", map[string]interface{}{"ignoreConsecutiveComments": true}}},
+			{Code: "/* This comment is valid */\n// and so is this one", Options: []interface{}{"always", map[string]interface{}{"ignoreConsecutiveComments": true}}},
+			{Code: "// this comment is valid\n// and so is this one", Options: []interface{}{"never", map[string]interface{}{"ignoreConsecutiveComments": true}}},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:   "//lowercase comment",
+				Output: []string{"//Lowercase comment"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedLowercaseComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   "// lowercase comment",
+				Output: []string{"// Lowercase comment"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedLowercaseComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   "/*lowercase comment*/",
+				Output: []string{"/*Lowercase comment*/"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedLowercaseComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   "/** lowercase comment */",
+				Output: []string{"/** Lowercase comment */"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedLowercaseComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   "/**\n * lowercase comment\n */",
+				Output: []string{"/**\n * Lowercase comment\n */"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedLowercaseComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   "foo();\n// lowercase comment",
+				Output: []string{"foo();\n// Lowercase comment"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedLowercaseComment", Line: 2, Column: 1},
+				},
+			},
+			{
+				Code:   "// élan",
+				Output: []string{"// Élan"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedLowercaseComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    "//Uppercase",
+				Output:  []string{"//uppercase"},
+				Options: []interface{}{"never"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedUppercaseComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    "/* Uppercase */",
+				Output:  []string{"/* uppercase */"},
+				Options: "never",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedUppercaseComment", Line: 1, Column: 1},
+				},
+			},
+
+			// A colon without slashes is not a URL
+			{
+				Code:   "// url: http://example.com",
+				Output: []string{"// Url: http://example.com"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedLowercaseComment", Line: 1, Column: 1},
+				},
+			},
+
+			// ignorePattern
+			{
+				Code:    "// not matching",
+				Output:  []string{"// Not matching"},
+				Options: []interface{}{"always", map[string]interface{}{"ignorePattern": "ignored"}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedLowercaseComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    "// foo",
+				Output:  []string{"// Foo"},
+				Options: []interface{}{"always", map[string]interface{}{"block": map[string]interface{}{"ignorePattern": "foo"}}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedLowercaseComment", Line: 1, Column: 1},
+				},
+			},
+
+			// ignoreInlineComments
+			{
+				Code:   "foo(/* invalid */ a);",
+				Output: []string{"foo(/* Invalid */ a);"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedLowercaseComment", Line: 1, Column: 5},
+				},
+			},
+			{
+				Code:    "foo(a); // not inline",
+				Output:  []string{"foo(a); // Not inline"},
+				Options: []interface{}{"always", map[string]interface{}{"ignoreInlineComments": true}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedLowercaseComment", Line: 1, Column: 9},
+				},
+			},
+
+			// ignoreConsecutiveComments
+			{
+				Code:   "// This comment is valid\n// and this one is not",
+				Output: []string{"// This comment is valid\n// And this one is not"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedLowercaseComment", Line: 2, Column: 1},
+				},
+			},
+			{
+				Code:    "// this comment is invalid\n// and so is this one",
+				Output:  []string{"// This comment is invalid\n// and so is this one"},
+				Options: []interface{}{"always", map[string]interface{}{"ignoreConsecutiveComments": true}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedLowercaseComment", Line: 1, Column: 1},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,166 @@
+package consistent_this
+
+import (
+	"slices"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+func parseOptions(options any) []string {
+	var aliases []string
+	switch v := options.(type) {
+	case string:
+		aliases = append(aliases, v)
+	case []interface{}:
+		for _, alias := range v {
+			if s, ok := alias.(string); ok {
+				aliases = append(aliases, s)
+			}
+		}
+	}
+
+	if len(aliases) == 0 {
+		return []string{"that"}
+	}
+	return aliases
+}
+
+// Message builders
+func buildAliasNotAssignedToThisMessage(name string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "aliasNotAssignedToThis",
+		Description: "Designated alias '" + name + "' is not assigned to 'this'.",
+	}
+}
+
+func buildUnexpectedAliasMessage(name string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpectedAlias",
+		Description: "Unexpected alias '" + name + "' for 'this'.",
+	}
+}
+
+// isBlockScope checks whether node creates a scope nested in the enclosing function scope
+func isBlockScope(node *ast.Node) bool {
+	switch node.Kind {
+	case ast.KindBlock,
+		ast.KindCaseBlock,
+		ast.KindForStatement,
+		ast.KindForInStatement,
+		ast.KindForOfStatement,
+		ast.KindCatchClause:
+		return true
+	}
+	return false
+}
+
+func isThisAssignment(node *ast.Node, name string) bool {
+	if node.Kind != ast.KindBinaryExpression {
+		return false
+	}
+	expr := node.AsBinaryExpression()
+	left := ast.SkipParentheses(expr.Left)
+	return expr.OperatorToken.Kind == ast.KindEqualsToken &&
+		left.Kind == ast.KindIdentifier && left.Text() == name &&
+		ast.SkipParentheses(expr.Right).Kind == ast.KindThisKeyword
+}
+
+// ConsistentThisRule enforces consistent naming when capturing the current execution context
+var ConsistentThisRule = rule.CreateRule(rule.Rule{
+	Name: "consistent-this",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		aliases := parseOptions(options)
+
+		checkAssignment := func(node *ast.Node, name string, value *ast.Node, isPlainAssignment bool) {
+			isThis := ast.SkipParentheses(value).Kind == ast.KindThisKeyword
+			if slices.Contains(aliases, name) {
+				if !isThis || !isPlainAssignment {
+					ctx.ReportNode(node, buildAliasNotAssignedToThisMessage(name))
+				}
+			} else if isThis {
+				ctx.ReportNode(node, buildUnexpectedAliasMessage(name))
+			}
+		}
+
+		// ensureWasAssigned reports aliases declared without a value in the function
+		// or file scope of statements that are never assigned `this` in that scope
+		ensureWasAssigned := func(statements []*ast.Node) {
+			for _, alias := range aliases {
+				var declarations []*ast.Node
+				initialized := false
+				assigned := false
+
+				var visit func(node *ast.Node, inScope bool)
+				visit = func(node *ast.Node, inScope bool) {
+					if ast.IsFunctionLike(node) || ast.IsClassLike(node) {
+						return
+					}
+					switch {
+					case node.Kind == ast.KindVariableDeclaration:
+						name := node.Name()
+						isVar := ast.GetCombinedNodeFlags(node)&ast.NodeFlagsBlockScoped == 0
+						if name.Kind == ast.KindIdentifier && name.Text() == alias && (isVar || inScope) {
+							declarations = append(declarations, node)
+							if node.AsVariableDeclaration().Initializer != nil {
+								initialized = true
+							}
+						}
+					case inScope && isThisAssignment(node, alias):
+						assigned = true
+					}
+
+					childInScope := inScope && !isBlockScope(node)
+					node.ForEachChild(func(child *ast.Node) bool {
+						visit(child, childInScope)
+						return false
+					})
+				}
+				for _, statement := range statements {
+					visit(statement, true)
+				}
+
+				if initialized || assigned {
+					continue
+				}
+				for _, declaration := range declarations {
+					ctx.ReportNode(declaration, buildAliasNotAssignedToThisMessage(alias))
+				}
+			}
+		}
+
+		checkFunction := func(node *ast.Node) {
+			body := node.Body()
+			if body == nil || body.Kind != ast.KindBlock {
+				return
+			}
+			ensureWasAssigned(body.AsBlock().Statements.Nodes)
+		}
+
+		ensureWasAssigned(ctx.SourceFile.Statements.Nodes)
+
+		return rule.RuleListeners{
+			ast.KindVariableDeclaration: func(node *ast.Node) {
+				declaration := node.AsVariableDeclaration()
+				name := declaration.Name()
+				if declaration.Initializer == nil || name.Kind != ast.KindIdentifier {
+					return
+				}
+				checkAssignment(node, name.Text(), declaration.Initializer, true)
+			},
+			ast.KindBinaryExpression: func(node *ast.Node) {
+				expr := node.AsBinaryExpression()
+				if !ast.IsAssignmentOperator(expr.OperatorToken.Kind) {
+					return
+				}
+				left := ast.SkipParentheses(expr.Left)
+				if left.Kind != ast.KindIdentifier {
+					return
+				}
+				checkAssignment(node, left.Text(), expr.Right, expr.OperatorToken.Kind == ast.KindEqualsToken)
+			},
+			rule.ListenerOnExit(ast.KindFunctionDeclaration): checkFunction,
+			rule.ListenerOnExit(ast.KindFunctionExpression):  checkFunction,
+		}
+	},
+})
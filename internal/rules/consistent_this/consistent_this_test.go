@@ -0,0 +1,120 @@
+package consistent_this
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestConsistentThisRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&ConsistentThisRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `var foo = 42, that = this`},
+			{Code: `const that = this;`},
+			{Code: `var foo = 42, self = this`, Options: []interface{}{"self"}},
+			{Code: `var self = 42`, Options: []interface{}{"that"}},
+			{Code: `var self`, Options: []interface{}{"that"}},
+			{Code: `var self; self = this`, Options: []interface{}{"self"}},
+			{Code: `var foo, self; self = this`, Options: []interface{}{"self"}},
+			{Code: `var foo, self; foo = 42; self = this`, Options: []interface{}{"self"}},
+			{Code: `self = 42`, Options: []interface{}{"that"}},
+			{Code: `var foo = {}; foo.bar = this`, Options: []interface{}{"self"}},
+			{Code: `var self = this; var vm = this;`, Options: []interface{}{"self", "vm"}},
+			{Code: `function foo() { var self; self = this; }`, Options: []interface{}{"self"}},
+			{Code: `var {foo, bar} = this`},
+			{Code: `var [foo, bar] = this`},
+			{Code: `function foo() { var that; if (cond) { that = this; } }`, Options: []interface{}{"other"}},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `var context = this`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedAlias", Line: 1, Column: 5},
+				},
+			},
+			{
+				Code: `const self = this;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedAlias", Line: 1, Column: 7},
+				},
+			},
+			{
+				Code:    `var that = this`,
+				Options: []interface{}{"self"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedAlias", Line: 1, Column: 5},
+				},
+			},
+			{
+				Code:    `var foo = 42, self = this`,
+				Options: []interface{}{"that"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedAlias", Line: 1, Column: 15},
+				},
+			},
+			{
+				Code:    `var self = 42`,
+				Options: []interface{}{"self"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "aliasNotAssignedToThis", Line: 1, Column: 5},
+				},
+			},
+			{
+				Code:    `var self`,
+				Options: []interface{}{"self"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "aliasNotAssignedToThis", Line: 1, Column: 5},
+				},
+			},
+			{
+				Code:    `var self; self = 42`,
+				Options: []interface{}{"self"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "aliasNotAssignedToThis", Line: 1, Column: 5},
+					{MessageId: "aliasNotAssignedToThis", Line: 1, Column: 11},
+				},
+			},
+			{
+				Code:    `context = this`,
+				Options: []interface{}{"that"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedAlias", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `that = 42`,
+				Options: []interface{}{"that"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "aliasNotAssignedToThis", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `that += this`,
+				Options: []interface{}{"that"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "aliasNotAssignedToThis", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `function foo() { var that; if (cond) { that = this; } }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "aliasNotAssignedToThis", Line: 1, Column: 22},
+				},
+			},
+			{
+				Code:    `var self = this; var vm = 42;`,
+				Options: []interface{}{"self", "vm"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "aliasNotAssignedToThis", Line: 1, Column: 22},
+				},
+			},
+		},
+	)
+}
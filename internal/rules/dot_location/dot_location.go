@@ -0,0 +1,92 @@
+package dot_location
+
+import (
+	"regexp"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builders
+func buildExpectedDotAfterObjectMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "expectedDotAfterObject",
+		Description: "Expected dot to be on same line as object.",
+	}
+}
+
+func buildExpectedDotBeforePropertyMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "expectedDotBeforeProperty",
+		Description: "Expected dot to be on same line as property.",
+	}
+}
+
+// decimalIntegerPattern matches number literals such as `5`, where `5.toString()` would be a syntax error
+var decimalIntegerPattern = regexp.MustCompile(`^(?:0|0[0-7]*[89]\d*|[1-9](?:_?\d)*)$`)
+
+// DotLocationRule enforces consistent newlines before and after dots
+var DotLocationRule = rule.CreateRule(rule.Rule{
+	Name: "dot-location",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		location := "object"
+		switch v := options.(type) {
+		case string:
+			location = v
+		case []interface{}:
+			if len(v) > 0 {
+				if s, ok := v[0].(string); ok {
+					location = s
+				}
+			}
+		}
+		onObject := location != "property"
+
+		getLine := func(pos int) int {
+			line, _ := scanner.GetLineAndCharacterOfPosition(ctx.SourceFile, pos)
+			return line
+		}
+
+		return rule.RuleListeners{
+			ast.KindPropertyAccessExpression: func(node *ast.Node) {
+				access := node.AsPropertyAccessExpression()
+				// The dot is `.` or `?.`
+				dotToken := ctx.GetTokenBefore(access.Name())
+				if dotToken == nil {
+					return
+				}
+
+				if onObject {
+					// The object ends with its last token, including any closing parenthesis
+					objectEnd := access.Expression.End()
+					if getLine(objectEnd) == getLine(dotToken.Range.Pos()) {
+						return
+					}
+					insertion := dotToken.Text
+					objectStart := utils.TrimNodeTextRange(ctx.SourceFile, access.Expression).Pos()
+					if dotToken.Kind == ast.KindDotToken && access.Expression.Kind == ast.KindNumericLiteral &&
+						decimalIntegerPattern.MatchString(ctx.SourceFile.Text()[objectStart:objectEnd]) {
+						// `5.toString()` would be a syntax error
+						insertion = " " + insertion
+					}
+					ctx.ReportRangeWithFixes(dotToken.Range, buildExpectedDotAfterObjectMessage(),
+						rule.RuleFixInsertAfter(access.Expression, insertion),
+						rule.RuleFixRemoveRange(dotToken.Range),
+					)
+					return
+				}
+
+				propertyStart := utils.TrimNodeTextRange(ctx.SourceFile, access.Name()).Pos()
+				if getLine(dotToken.Range.End()) == getLine(propertyStart) {
+					return
+				}
+				ctx.ReportRangeWithFixes(dotToken.Range, buildExpectedDotBeforePropertyMessage(),
+					rule.RuleFixRemoveRange(dotToken.Range),
+					rule.RuleFixInsertBefore(ctx.SourceFile, access.Name(), dotToken.Text),
+				)
+			},
+		}
+	},
+})
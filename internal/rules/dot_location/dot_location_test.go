@@ -0,0 +1,113 @@
+package dot_location
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestDotLocationRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&DotLocationRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `obj.prop`},
+			{Code: "obj.\nprop"},
+			{Code: "(obj).\nprop"},
+			{Code: "obj?.\nprop"},
+			{Code: "obj\n[prop]"},
+			{Code: "obj[\nprop]"},
+			{Code: "foo.\n  bar().\n  baz()"},
+			{Code: "5 .\ntoString()"},
+			{Code: `obj.prop`, Options: "property"},
+			{Code: "obj\n.prop", Options: "property"},
+			{Code: "(obj)\n.prop", Options: "property"},
+			{Code: "obj\n?.prop", Options: []interface{}{"property"}},
+			{Code: "obj.\n[prop]", Options: "property"},
+			{Code: "foo\n  .bar()\n  .baz()", Options: "property"},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:   "obj\n.prop",
+				Output: []string{"obj.\nprop"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedDotAfterObject", Line: 2, Column: 1},
+				},
+			},
+			{
+				Code:   "(obj)\n.prop",
+				Output: []string{"(obj).\nprop"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedDotAfterObject", Line: 2, Column: 1},
+				},
+			},
+			{
+				Code:   "obj\n?.prop",
+				Output: []string{"obj?.\nprop"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedDotAfterObject", Line: 2, Column: 1},
+				},
+			},
+			{
+				Code:   "5\n.toString()",
+				Output: []string{"5 .\ntoString()"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedDotAfterObject", Line: 2, Column: 1},
+				},
+			},
+			{
+				Code:   "5.0\n.toString()",
+				Output: []string{"5.0.\ntoString()"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedDotAfterObject", Line: 2, Column: 1},
+				},
+			},
+			{
+				Code:   "foo\n  .bar()\n  .baz()",
+				Output: []string{"foo.\n  bar().\n  baz()"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedDotAfterObject", Line: 3, Column: 3},
+					{MessageId: "expectedDotAfterObject", Line: 2, Column: 3},
+				},
+			},
+			{
+				Code:    "obj\n.prop",
+				Options: []interface{}{"object"},
+				Output:  []string{"obj.\nprop"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedDotAfterObject", Line: 2, Column: 1},
+				},
+			},
+			{
+				Code:    "obj.\nprop",
+				Options: "property",
+				Output:  []string{"obj\n.prop"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedDotBeforeProperty", Line: 1, Column: 4},
+				},
+			},
+			{
+				Code:    "obj?.\nprop",
+				Options: "property",
+				Output:  []string{"obj\n?.prop"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedDotBeforeProperty", Line: 1, Column: 4},
+				},
+			},
+			{
+				Code:    "foo.\n  bar().\n  baz()",
+				Options: []interface{}{"property"},
+				Output:  []string{"foo\n  .bar()\n  .baz()"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "expectedDotBeforeProperty", Line: 2, Column: 8},
+					{MessageId: "expectedDotBeforeProperty", Line: 1, Column: 4},
+				},
+			},
+		},
+	)
+}
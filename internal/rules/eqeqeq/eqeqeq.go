@@ -0,0 +1,161 @@
+package eqeqeq
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Options for eqeqeq rule
+type Options struct {
+	Mode string `json:"mode"`
+	// Null is how comparisons to `null` are checked in "always" mode: "always", "never" or "ignore"
+	Null string `json:"null"`
+}
+
+func parseOptions(options any) Options {
+	opts := Options{
+		Mode: "always",
+		Null: "always",
+	}
+
+	if options == nil {
+		return opts
+	}
+
+	parseObject := func(optsMap map[string]interface{}) {
+		if v, ok := optsMap["null"].(string); ok {
+			opts.Null = v
+		}
+	}
+
+	// Handle array format: ["always", { null: "ignore" }]
+	switch v := options.(type) {
+	case []interface{}:
+		for _, item := range v {
+			switch item := item.(type) {
+			case string:
+				opts.Mode = item
+			case map[string]interface{}:
+				parseObject(item)
+			}
+		}
+	case string:
+		opts.Mode = v
+	case map[string]interface{}:
+		if mode, ok := v["mode"].(string); ok {
+			opts.Mode = mode
+		}
+		parseObject(v)
+	}
+
+	// "allow-null" is the deprecated spelling of ["always", { null: "ignore" }]
+	if opts.Mode == "allow-null" {
+		opts.Mode = "always"
+		opts.Null = "ignore"
+	}
+	return opts
+}
+
+// Message builder
+func buildUnexpectedMessage(expectedOperator string, actualOperator string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpected",
+		Description: "Expected '" + expectedOperator + "' and instead saw '" + actualOperator + "'.",
+	}
+}
+
+func isNullLiteral(node *ast.Node) bool {
+	return ast.SkipParentheses(node).Kind == ast.KindNullKeyword
+}
+
+func isTypeOf(node *ast.Node) bool {
+	return ast.SkipParentheses(node).Kind == ast.KindTypeOfExpression
+}
+
+// literalType returns the `typeof` result of a literal, or "" if node isn't one
+func literalType(node *ast.Node) string {
+	switch ast.SkipParentheses(node).Kind {
+	case ast.KindStringLiteral:
+		return "string"
+	case ast.KindNumericLiteral:
+		return "number"
+	case ast.KindBigIntLiteral:
+		return "bigint"
+	case ast.KindTrueKeyword, ast.KindFalseKeyword:
+		return "boolean"
+	case ast.KindNullKeyword, ast.KindRegularExpressionLiteral:
+		return "object"
+	}
+	return ""
+}
+
+// isTypeOfBinary checks whether either side is a `typeof` expression, which always results in a string
+func isTypeOfBinary(binary *ast.BinaryExpression) bool {
+	return isTypeOf(binary.Left) || isTypeOf(binary.Right)
+}
+
+// areLiteralsOfSameType checks whether both sides are literals of the same type, which compare the same either way
+func areLiteralsOfSameType(binary *ast.BinaryExpression) bool {
+	leftType := literalType(binary.Left)
+	return leftType != "" && leftType == literalType(binary.Right)
+}
+
+// EqeqeqRule requires the use of `===` and `!==`
+var EqeqeqRule = rule.CreateRule(rule.Rule{
+	Name: "eqeqeq",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+
+		report := func(node *ast.Node, expectedOperator string) {
+			binary := node.AsBinaryExpression()
+			operatorRange := utils.TrimNodeTextRange(ctx.SourceFile, binary.OperatorToken)
+			actualOperator := ctx.SourceFile.Text()[operatorRange.Pos():operatorRange.End()]
+			message := buildUnexpectedMessage(expectedOperator, actualOperator)
+
+			// Changing the operator is only safe when both sides are known to have the same type
+			if !isTypeOfBinary(binary) && !areLiteralsOfSameType(binary) {
+				ctx.ReportRange(operatorRange, message)
+				return
+			}
+			ctx.ReportRangeWithFixes(operatorRange, message, rule.RuleFixReplaceRange(operatorRange, expectedOperator))
+		}
+
+		return rule.RuleListeners{
+			ast.KindBinaryExpression: func(node *ast.Node) {
+				binary := node.AsBinaryExpression()
+				isNull := isNullLiteral(binary.Left) || isNullLiteral(binary.Right)
+
+				switch binary.OperatorToken.Kind {
+				case ast.KindEqualsEqualsToken, ast.KindExclamationEqualsToken:
+				case ast.KindEqualsEqualsEqualsToken:
+					if opts.Mode == "always" && opts.Null == "never" && isNull {
+						report(node, "==")
+					}
+					return
+				case ast.KindExclamationEqualsEqualsToken:
+					if opts.Mode == "always" && opts.Null == "never" && isNull {
+						report(node, "!=")
+					}
+					return
+				default:
+					return
+				}
+
+				if opts.Mode == "smart" {
+					if isNull || isTypeOfBinary(binary) || areLiteralsOfSameType(binary) {
+						return
+					}
+				} else if opts.Null != "always" && isNull {
+					return
+				}
+
+				if binary.OperatorToken.Kind == ast.KindEqualsEqualsToken {
+					report(node, "===")
+				} else {
+					report(node, "!==")
+				}
+			},
+		}
+	},
+})
@@ -0,0 +1,149 @@
+package eqeqeq
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestEqeqeqRule(t *testing.T) {
+	rule_tester.RunRuleTester(fixtures.GetRootDir(), "tsconfig.json", t, &EqeqeqRule, []rule_tester.ValidTestCase{
+		{Code: `declare const a: any, b: any; a === b;`},
+		{Code: `declare const a: any, b: any; a !== b;`},
+		{Code: `declare const a: any, b: any; a === b;`, Options: "always"},
+		{Code: `declare const x: any; typeof x === 'string';`},
+		{Code: `declare const x: any; x === null;`},
+
+		// smart
+		{Code: `declare const x: any; typeof x == 'string';`, Options: "smart"},
+		{Code: `declare const x: any; 'string' != typeof x;`, Options: "smart"},
+		{Code: `'hello' != 'world';`, Options: "smart"},
+		{Code: `2 == 3;`, Options: "smart"},
+		{Code: `true == true;`, Options: "smart"},
+		{Code: `declare const x: any; x == null;`, Options: "smart"},
+		{Code: `declare const x: any; null != x;`, Options: "smart"},
+		{Code: `declare const x: any; x === null;`, Options: "smart"},
+
+		// null option
+		{Code: `declare const x: any; x == null;`, Options: []interface{}{"always", map[string]interface{}{"null": "ignore"}}},
+		{Code: `declare const x: any; x === null;`, Options: []interface{}{"always", map[string]interface{}{"null": "ignore"}}},
+		{Code: `declare const x: any; x != null;`, Options: []interface{}{"always", map[string]interface{}{"null": "never"}}},
+		{Code: `declare const x: any; x == null;`, Options: "allow-null"},
+		{Code: `declare const x: any; x === null;`, Options: []interface{}{"always", map[string]interface{}{"null": "always"}}},
+	}, []rule_tester.InvalidTestCase{
+		{
+			Code: `declare const a: any, b: any; a == b;`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 33, EndColumn: 35},
+			},
+		},
+		{
+			Code: `declare const a: any, b: any; a != b;`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 33, EndColumn: 35},
+			},
+		},
+		{
+			Code:    `declare const a: any, b: any; if (a == b) {}`,
+			Options: "always",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 37},
+			},
+		},
+		{
+			Code: `declare const x: any; x == null;`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 25},
+			},
+		},
+		{
+			Code:   `declare const x: any; typeof x == 'string';`,
+			Output: []string{`declare const x: any; typeof x === 'string';`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 32},
+			},
+		},
+		{
+			Code:   `'hello' != 'world';`,
+			Output: []string{`'hello' !== 'world';`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 9},
+			},
+		},
+		{
+			Code:   `declare const a: any, b: any; typeof a != typeof b;`,
+			Output: []string{`declare const a: any, b: any; typeof a !== typeof b;`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 40},
+			},
+		},
+		{
+			Code:   `1 == 2;`,
+			Output: []string{`1 === 2;`},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 3},
+			},
+		},
+		{
+			Code: `declare const a: any, b: any, c: any; (a == b) == c;`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 48},
+				{MessageId: "unexpected", Line: 1, Column: 42},
+			},
+		},
+
+		// smart
+		{
+			Code:    `declare const a: any, b: any; a == b;`,
+			Options: "smart",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 33},
+			},
+		},
+		{
+			Code:    `'1' != 1;`,
+			Options: "smart",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 5},
+			},
+		},
+		{
+			Code:    `declare const x: any; x == undefined;`,
+			Options: "smart",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 25},
+			},
+		},
+
+		// null option
+		{
+			Code:    `declare const a: any, b: any; a == b;`,
+			Options: []interface{}{"always", map[string]interface{}{"null": "ignore"}},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 33},
+			},
+		},
+		{
+			Code:    `declare const x: any; x === null;`,
+			Options: []interface{}{"always", map[string]interface{}{"null": "never"}},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 25},
+			},
+		},
+		{
+			Code:    `declare const x: any; null !== x;`,
+			Options: []interface{}{"always", map[string]interface{}{"null": "never"}},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 28},
+			},
+		},
+		{
+			Code:    `declare const a: any, b: any; a != b;`,
+			Options: "allow-null",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "unexpected", Line: 1, Column: 33},
+			},
+		},
+	})
+}
@@ -0,0 +1,114 @@
+package grouped_accessor_pairs
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builders
+func buildNotGroupedMessage(formerName string, latterName string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "notGrouped",
+		Description: "Accessor pair " + formerName + " and " + latterName + " should be grouped.",
+	}
+}
+
+func buildInvalidOrderMessage(formerName string, latterName string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "invalidOrder",
+		Description: "Expected " + latterName + " to be before " + formerName + ".",
+	}
+}
+
+type accessorPair struct {
+	key     string
+	getters []int
+	setters []int
+}
+
+// GroupedAccessorPairsRule requires grouped accessor pairs in object literals and classes
+var GroupedAccessorPairsRule = rule.CreateRule(rule.Rule{
+	Name: "grouped-accessor-pairs",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		order := "anyOrder"
+		switch v := options.(type) {
+		case string:
+			order = v
+		case []interface{}:
+			if len(v) > 0 {
+				if s, ok := v[0].(string); ok {
+					order = s
+				}
+			}
+		}
+
+		checkList := func(members []*ast.Node, shouldCheck func(member *ast.Node) bool) {
+			var pairs []*accessorPair
+			for i, member := range members {
+				if (member.Kind != ast.KindGetAccessor && member.Kind != ast.KindSetAccessor) || !shouldCheck(member) {
+					continue
+				}
+				key := utils.GetAccessorKey(ctx.SourceFile, member)
+				var pair *accessorPair
+				for _, p := range pairs {
+					if p.key == key {
+						pair = p
+						break
+					}
+				}
+				if pair == nil {
+					pair = &accessorPair{key: key}
+					pairs = append(pairs, pair)
+				}
+				if member.Kind == ast.KindGetAccessor {
+					pair.getters = append(pair.getters, i)
+				} else {
+					pair.setters = append(pair.setters, i)
+				}
+			}
+
+			for _, pair := range pairs {
+				// Don't report accessor properties that have duplicate getters or setters
+				if len(pair.getters) != 1 || len(pair.setters) != 1 {
+					continue
+				}
+				getterIndex := pair.getters[0]
+				setterIndex := pair.setters[0]
+				former := members[min(getterIndex, setterIndex)]
+				latter := members[max(getterIndex, setterIndex)]
+
+				if max(getterIndex, setterIndex)-min(getterIndex, setterIndex) > 1 {
+					ctx.ReportRange(utils.GetAccessorHeadRange(ctx.SourceFile, latter), buildNotGroupedMessage(utils.GetAccessorName(former), utils.GetAccessorName(latter)))
+				} else if (order == "getBeforeSet" && getterIndex > setterIndex) ||
+					(order == "setBeforeGet" && getterIndex < setterIndex) {
+					ctx.ReportRange(utils.GetAccessorHeadRange(ctx.SourceFile, latter), buildInvalidOrderMessage(utils.GetAccessorName(former), utils.GetAccessorName(latter)))
+				}
+			}
+		}
+
+		checkClass := func(node *ast.Node) {
+			// Semicolons between members are not class elements in ESTree and must not break a pair
+			members := utils.Filter(node.Members(), func(member *ast.Node) bool {
+				return member.Kind != ast.KindSemicolonClassElement
+			})
+			// Abstract accessors have no implementation to group with
+			checkList(members, func(member *ast.Node) bool {
+				return !ast.HasStaticModifier(member) && !ast.HasSyntacticModifier(member, ast.ModifierFlagsAbstract)
+			})
+			checkList(members, func(member *ast.Node) bool {
+				return ast.HasStaticModifier(member)
+			})
+		}
+
+		return rule.RuleListeners{
+			ast.KindObjectLiteralExpression: func(node *ast.Node) {
+				checkList(node.AsObjectLiteralExpression().Properties.Nodes, func(member *ast.Node) bool {
+					return true
+				})
+			},
+			ast.KindClassDeclaration: checkClass,
+			ast.KindClassExpression:  checkClass,
+		}
+	},
+})
@@ -0,0 +1,220 @@
+package grouped_accessor_pairs
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestGroupedAccessorPairsRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&GroupedAccessorPairsRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			// No accessors or a single accessor
+			{Code: `({})`},
+			{Code: `({ a: 1, b() {} })`},
+			{Code: `({ get a() { return 1; } })`},
+			{Code: `({ set a(v) {}, foo: 1, get b() { return 1; } })`},
+			{Code: `class A { get a() { return 1; } b() {} set c(v) {} }`},
+
+			// Grouped pairs in any order
+			{Code: `({ get a() { return 1; }, set a(v) {} })`},
+			{Code: `({ set a(v) {}, get a() { return 1; } })`},
+			{Code: `({ foo, get a() { return 1; }, set a(v) {}, bar })`},
+			{Code: `class A { get a() { return 1; } set a(v) {} }`},
+			{Code: `class A { set a(v) {} get a() { return 1; } }`},
+			{Code: `(class { get a() { return 1; } set a(v) {} })`},
+
+			// Name normalization
+			{Code: `({ get a() { return 1; }, set 'a'(v) {} })`},
+			{Code: `({ get ['a']() { return 1; }, set a(v) {} })`},
+			{Code: `({ get 10() { return 1; }, set 1e1(v) {} })`},
+			{Code: `({ get [a]() { return 1; }, set [a](v) {} })`},
+			{Code: `({ get [a + b]() { return 1; }, set [a+b](v) {} })`},
+
+			// Different properties
+			{Code: `({ get a() { return 1; }, b: 1, set b(v) {} })`},
+			{Code: `({ get [a]() { return 1; }, b: 1, set [b](v) {} })`},
+			{Code: `({ get [a]() { return 1; }, b: 1, set a(v) {} })`},
+			{Code: `class A { get [a]() { return 1; } b() {} set a(v) {} }`},
+			{Code: `class A { get #a() { return 1; } b() {} set a(v) {} }`},
+
+			// Static and instance accessors are separate properties
+			{Code: `class A { get a() { return 1; } static set a(v) {} }`},
+			{Code: `class A { static get a() { return 1; } foo() {} set a(v) {} }`},
+			{Code: `class A { get a() { return 1; } static b() {} set a(v) {} }`},
+
+			// Duplicate accessors are reported by other rules
+			{Code: `({ get a() { return 1; }, b: 1, get a() { return 2; }, set a(v) {} })`},
+			{Code: `class A { set a(v) {} b() {} set a(v) {} get a() { return 1; } }`},
+
+			// Semicolons between class members don't separate a pair
+			{Code: `class A { get a() { return 1; }; set a(v) {} }`},
+
+			// Ordering options
+			{Code: `({ get a() { return 1; }, set a(v) {} })`, Options: "getBeforeSet"},
+			{Code: `({ set a(v) {}, get a() { return 1; } })`, Options: "setBeforeGet"},
+			{Code: `class A { get a() { return 1; } set a(v) {} }`, Options: []interface{}{"getBeforeSet"}},
+			{Code: `class A { static set a(v) {} static get a() { return 1; } }`, Options: "setBeforeGet"},
+			{Code: `({ set a(v) {}, get a() { return 1; } })`, Options: "anyOrder"},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			// Not grouped
+			{
+				Code: `({ get a() { return 1; }, b: 1, set a(v) {} })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 33},
+				},
+			},
+			{
+				Code: `({ set a(v) {}, b: 1, get a() { return 1; } })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 23},
+				},
+			},
+			{
+				Code: `({ get a() { return 1; }, ...b, set a(v) {} })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 33},
+				},
+			},
+			{
+				Code: `class A { get a() { return 1; } b() {} set a(v) {} }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 40},
+				},
+			},
+			{
+				Code: `(class { set a(v) {} b = 1; get a() { return 1; } })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 29},
+				},
+			},
+			{
+				Code: `class A { static get a() { return 1; } static b() {} static set a(v) {} }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 54},
+				},
+			},
+			{
+				Code: `class A { get #a() { return 1; } b() {} set #a(v) {} }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 41},
+				},
+			},
+
+			// Name normalization
+			{
+				Code: `({ get a() { return 1; }, b: 1, set 'a'(v) {} })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 33},
+				},
+			},
+			{
+				Code: `({ get ['a']() { return 1; }, b: 1, set [` + "`a`" + `](v) {} })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 37},
+				},
+			},
+			{
+				Code: `({ get 10() { return 1; }, b: 1, set 1e1(v) {} })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 34},
+				},
+			},
+			{
+				Code: `({ get [a]() { return 1; }, b: 1, set [a](v) {} })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 35},
+				},
+			},
+			{
+				Code: `({ get [a + b]() { return 1; }, c: 1, set [a+b](v) {} })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 39},
+				},
+			},
+			{
+				Code: `class A { static get ['a']() { return 1; } static b() {} static set a(v) {} }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 58},
+				},
+			},
+
+			// Multiple pairs
+			{
+				Code: `({ get a() { return 1; }, get b() { return 1; }, set a(v) {}, set b(v) {} })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 50},
+					{MessageId: "notGrouped", Line: 1, Column: 63},
+				},
+			},
+			{
+				Code: `class A { get a() { return 1; } static get a() { return 1; } b() {} set a(v) {} static c() {} static set a(v) {} }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 69},
+					{MessageId: "notGrouped", Line: 1, Column: 95},
+				},
+			},
+
+			// Ordering options
+			{
+				Code:    `({ set a(v) {}, get a() { return 1; } })`,
+				Options: "getBeforeSet",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidOrder", Line: 1, Column: 17},
+				},
+			},
+			{
+				Code:    `({ get a() { return 1; }, set a(v) {} })`,
+				Options: "setBeforeGet",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidOrder", Line: 1, Column: 27},
+				},
+			},
+			{
+				Code:    `class A { set a(v) {} get a() { return 1; } }`,
+				Options: []interface{}{"getBeforeSet"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidOrder", Line: 1, Column: 23},
+				},
+			},
+			{
+				Code:    `class A { static get a() { return 1; } static set a(v) {} }`,
+				Options: "setBeforeGet",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidOrder", Line: 1, Column: 40},
+				},
+			},
+			{
+				Code:    `class A { set #a(v) {} get #a() { return 1; } }`,
+				Options: "getBeforeSet",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidOrder", Line: 1, Column: 24},
+				},
+			},
+			{
+				Code:    `({ set 'a'(v) {}, get ['a']() { return 1; } })`,
+				Options: "getBeforeSet",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidOrder", Line: 1, Column: 19},
+				},
+			},
+
+			// Not grouped takes precedence over the order
+			{
+				Code:    `({ set a(v) {}, b: 1, get a() { return 1; } })`,
+				Options: "getBeforeSet",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notGrouped", Line: 1, Column: 23},
+				},
+			},
+		},
+	)
+}
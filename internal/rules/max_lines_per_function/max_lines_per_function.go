@@ -0,0 +1,189 @@
+package max_lines_per_function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Options for max-lines-per-function rule
+type Options struct {
+	Max            int  `json:"max"`
+	SkipComments   bool `json:"skipComments"`
+	SkipBlankLines bool `json:"skipBlankLines"`
+	IIFEs          bool `json:"IIFEs"`
+}
+
+func parseOptions(options any) Options {
+	opts := Options{
+		Max: 50,
+	}
+
+	if options == nil {
+		return opts
+	}
+
+	// Handle array format: [{ option: value }] or [max]
+	if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+		options = optArray[0]
+	}
+	if v, ok := options.(float64); ok {
+		opts.Max = int(v)
+		return opts
+	}
+
+	if optsMap, ok := options.(map[string]interface{}); ok {
+		if v, ok := optsMap["max"].(float64); ok {
+			opts.Max = int(v)
+		}
+		if v, ok := optsMap["skipComments"].(bool); ok {
+			opts.SkipComments = v
+		}
+		if v, ok := optsMap["skipBlankLines"].(bool); ok {
+			opts.SkipBlankLines = v
+		}
+		if v, ok := optsMap["IIFEs"].(bool); ok {
+			opts.IIFEs = v
+		}
+	}
+	return opts
+}
+
+func buildExceedMessage(name string, lineCount int, maxLines int) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "exceed",
+		Description: fmt.Sprintf("%s has too many lines (%d). Maximum allowed is %d.", name, lineCount, maxLines),
+	}
+}
+
+// getFunctionNameWithKind describes node for the report, e.g. "Method 'foo'"
+func getFunctionNameWithKind(sourceFile *ast.SourceFile, node *ast.Node) string {
+	kind := "Function"
+	switch node.Kind {
+	case ast.KindArrowFunction:
+		return "Arrow function"
+	case ast.KindConstructor:
+		return "Constructor"
+	case ast.KindMethodDeclaration:
+		kind = "Method"
+	case ast.KindGetAccessor:
+		kind = "Getter"
+	case ast.KindSetAccessor:
+		kind = "Setter"
+	}
+
+	name := node.Name()
+	if name == nil {
+		return kind
+	}
+	text, _ := utils.GetNameFromMember(sourceFile, name)
+	if text == "" {
+		return kind
+	}
+	return kind + " '" + text + "'"
+}
+
+// isIIFE checks whether node is a function expression that is called immediately
+func isIIFE(node *ast.Node) bool {
+	if node.Kind != ast.KindFunctionExpression && node.Kind != ast.KindArrowFunction {
+		return false
+	}
+	callee := node
+	for callee.Parent != nil && callee.Parent.Kind == ast.KindParenthesizedExpression {
+		callee = callee.Parent
+	}
+	parent := callee.Parent
+	return parent != nil && parent.Kind == ast.KindCallExpression && parent.AsCallExpression().Expression == callee
+}
+
+// MaxLinesPerFunctionRule enforces a maximum number of lines of code in a function
+var MaxLinesPerFunctionRule = rule.CreateRule(rule.Rule{
+	Name: "max-lines-per-function",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+		text := ctx.SourceFile.Text()
+		lineStarts := scanner.GetLineStarts(ctx.SourceFile)
+
+		getLineText := func(line int) string {
+			end := len(text)
+			if line+1 < len(lineStarts) {
+				end = int(lineStarts[line+1])
+			}
+			return strings.TrimRight(text[int(lineStarts[line]):end], "\r\n\u2028\u2029")
+		}
+
+		// commentLines maps each line to the last comment spanning it, built on first use
+		var commentLines map[int]rule.Comment
+		getCommentLines := func() map[int]rule.Comment {
+			if commentLines != nil {
+				return commentLines
+			}
+			commentLines = map[int]rule.Comment{}
+			for _, comment := range ctx.GetAllComments() {
+				startLine := scanner.ComputeLineOfPosition(lineStarts, comment.Range.Pos())
+				endLine := scanner.ComputeLineOfPosition(lineStarts, comment.Range.End())
+				for line := startLine; line <= endLine; line++ {
+					commentLines[line] = comment
+				}
+			}
+			return commentLines
+		}
+
+		// isFullLineComment checks whether comment covers all the code on line
+		isFullLineComment := func(line int, comment rule.Comment) bool {
+			lineStart := int(lineStarts[line])
+			lineText := getLineText(line)
+			startLine := scanner.ComputeLineOfPosition(lineStarts, comment.Range.Pos())
+			endLine := scanner.ComputeLineOfPosition(lineStarts, comment.Range.End())
+
+			isFirstTokenOnLine := startLine == line && strings.TrimSpace(lineText[:comment.Range.Pos()-lineStart]) == ""
+			isLastTokenOnLine := endLine == line && strings.TrimSpace(lineText[min(comment.Range.End()-lineStart, len(lineText)):]) == ""
+			return (startLine < line || isFirstTokenOnLine) && (endLine > line || isLastTokenOnLine)
+		}
+
+		checkFunction := func(node *ast.Node) {
+			// Overload signatures and abstract methods have no lines of code
+			if node.Body() == nil {
+				return
+			}
+			if !opts.IIFEs && isIIFE(node) {
+				return
+			}
+
+			nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			startLine := scanner.ComputeLineOfPosition(lineStarts, nodeRange.Pos())
+			endLine := scanner.ComputeLineOfPosition(lineStarts, nodeRange.End())
+
+			lineCount := 0
+			for line := startLine; line <= endLine; line++ {
+				if opts.SkipComments {
+					if comment, ok := getCommentLines()[line]; ok && isFullLineComment(line, comment) {
+						continue
+					}
+				}
+				if opts.SkipBlankLines && strings.TrimSpace(getLineText(line)) == "" {
+					continue
+				}
+				lineCount++
+			}
+
+			if lineCount > opts.Max {
+				ctx.ReportNode(node, buildExceedMessage(getFunctionNameWithKind(ctx.SourceFile, node), lineCount, opts.Max))
+			}
+		}
+
+		return rule.RuleListeners{
+			ast.KindFunctionDeclaration: checkFunction,
+			ast.KindFunctionExpression:  checkFunction,
+			ast.KindArrowFunction:       checkFunction,
+			ast.KindMethodDeclaration:   checkFunction,
+			ast.KindConstructor:         checkFunction,
+			ast.KindGetAccessor:         checkFunction,
+			ast.KindSetAccessor:         checkFunction,
+		}
+	},
+})
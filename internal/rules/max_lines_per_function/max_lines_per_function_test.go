@@ -0,0 +1,159 @@
+package max_lines_per_function
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestMaxLinesPerFunctionRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&MaxLinesPerFunctionRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: "var x = 5;\nvar x = 2;\n", Options: []interface{}{1.0}},
+			{Code: "function name() {}", Options: []interface{}{1.0}},
+			{Code: "function name() {\nvar x = 5;\nvar x = 2;\n}", Options: []interface{}{4.0}},
+			{Code: "function name() {\nvar x = 5;\nvar x = 2;\n}", Options: map[string]interface{}{"max": 4.0}},
+			{Code: "const bar = () => 2", Options: []interface{}{1.0}},
+			{Code: "const bar = () => {\nconst x = 2 + 1;\nreturn x;\n}", Options: []interface{}{4.0}},
+
+			// skipComments
+			{
+				Code:    "function name() {\nvar x = 5;\n// a comment on it's own line\nvar x = 2; // end of line comment\n}",
+				Options: map[string]interface{}{"max": 4.0, "skipComments": true},
+			},
+			{
+				Code:    "function name() {\nvar x = 5;\n/* a\n multi-line\n comment */\nvar x = 2;\n}",
+				Options: map[string]interface{}{"max": 4.0, "skipComments": true},
+			},
+			{
+				Code:    "function name() {\nvar x = 5;\n\t/* a comment with leading whitespace */\n/* a comment with trailing whitespace */\t\t\n\t/* a comment with trailing and leading whitespace */\t\t\nvar x = 2;\n}",
+				Options: map[string]interface{}{"max": 4.0, "skipComments": true},
+			},
+
+			// skipBlankLines
+			{
+				Code:    "function name() {\nvar x = 5;\n\t\n \n\nvar x = 2;\n}",
+				Options: map[string]interface{}{"max": 4.0, "skipBlankLines": true},
+			},
+			{
+				Code:    "function name() {\nvar x = 5;\n\n// a comment\n\nvar x = 2;\n}",
+				Options: map[string]interface{}{"max": 4.0, "skipComments": true, "skipBlankLines": true},
+			},
+
+			// IIFEs are ignored by default
+			{
+				Code:    "(function(){\nlet x = 0;\nlet y = 0;\nlet z = x + y;\nlet foo = {};\nreturn bar;\n}());",
+				Options: map[string]interface{}{"max": 2.0},
+			},
+			{
+				Code:    "(() => {\nlet x = 0;\nlet y = 0;\nlet z = x + y;\nlet foo = {};\nreturn bar;\n})();",
+				Options: map[string]interface{}{"max": 2.0},
+			},
+
+			// Overload signatures have no body
+			{Code: "function foo(a: string): void;\nfunction foo(a: number): void;\nfunction foo(a: any) {}", Options: []interface{}{1.0}},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:    "function name() {\n}",
+				Options: []interface{}{1.0},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "exceed", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    "var func = function() {\n}",
+				Options: []interface{}{1.0},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "exceed", Line: 1, Column: 12},
+				},
+			},
+			{
+				Code:    "const bar = () => {\nconst x = 2 + 1;\nreturn x;\n}",
+				Options: []interface{}{3.0},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "exceed", Line: 1, Column: 13},
+				},
+			},
+			{
+				Code:    "const bar = () =>\n 2",
+				Options: []interface{}{1.0},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "exceed", Line: 1, Column: 13},
+				},
+			},
+
+			// Comments and blank lines count unless skipped
+			{
+				Code:    "function name() {\nvar x = 5;\n// a comment on it's own line\nvar x = 2; // end of line comment\n}",
+				Options: map[string]interface{}{"max": 4.0},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "exceed", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    "function name() {\nvar x = 5;\n\t\n \n\nvar x = 2;\n}",
+				Options: map[string]interface{}{"max": 4.0, "skipComments": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "exceed", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    "function name() {\nvar x = 5;\n// a comment\nvar x = 2;\n}",
+				Options: map[string]interface{}{"max": 4.0, "skipBlankLines": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "exceed", Line: 1, Column: 1},
+				},
+			},
+
+			// Code sharing a line with a comment is still counted
+			{
+				Code:    "function name() {\nvar x = 5; /* comment */\n/* comment */ var y = 2;\nvar z = 3;\n}",
+				Options: map[string]interface{}{"max": 4.0, "skipComments": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "exceed", Line: 1, Column: 1},
+				},
+			},
+
+			// Methods include their key
+			{
+				Code:    "class A {\n  foo() {\n    return 1;\n  }\n}",
+				Options: []interface{}{2.0},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "exceed", Line: 2, Column: 3},
+				},
+			},
+
+			// IIFEs
+			{
+				Code:    "(function(){\nlet x = 0;\nlet y = 0;\nlet z = x + y;\nlet foo = {};\nreturn bar;\n}());",
+				Options: map[string]interface{}{"max": 2.0, "IIFEs": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "exceed", Line: 1, Column: 2},
+				},
+			},
+			{
+				Code:    "(() => {\nlet x = 0;\nlet y = 0;\nlet z = x + y;\nlet foo = {};\nreturn bar;\n})();",
+				Options: map[string]interface{}{"max": 2.0, "IIFEs": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "exceed", Line: 1, Column: 2},
+				},
+			},
+		},
+	)
+}
+
+func TestExceedMessage(t *testing.T) {
+	message := buildExceedMessage("Function 'name'", 5, 4)
+	expected := "Function 'name' has too many lines (5). Maximum allowed is 4."
+	if message.Description != expected {
+		t.Errorf("expected %q, got %q", expected, message.Description)
+	}
+}
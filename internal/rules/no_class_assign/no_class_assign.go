@@ -115,6 +115,10 @@ func isWriteReference(node *ast.Node) bool {
 		// In object destructuring like {A} = obj, A is a write reference
 		return isInDestructuringAssignment(parent)
 
+	case ast.KindForInStatement, ast.KindForOfStatement:
+		// In loops like for (A in obj), A is assigned on each iteration
+		return parent.AsForInOrOfStatement().Initializer == node
+
 	case ast.KindParenthesizedExpression:
 		// Unwrap parentheses and check the parent context
 		return isWriteReference(parent)
@@ -232,8 +236,13 @@ func isNameShadowed(node *ast.Node, className string, classNode *ast.Node, ctx *
 		return false
 	}
 
-	// Get the symbol at the identifier location
-	symbol := ctx.TypeChecker.GetSymbolAtLocation(node)
+	// Get the symbol at the identifier location, which for {A} = obj is the property rather than the variable
+	var symbol *ast.Symbol
+	if node.Parent.Kind == ast.KindShorthandPropertyAssignment {
+		symbol = ctx.TypeChecker.GetShorthandAssignmentValueSymbol(node.Parent)
+	} else {
+		symbol = ctx.TypeChecker.GetSymbolAtLocation(node)
+	}
 	if symbol == nil {
 		return false
 	}
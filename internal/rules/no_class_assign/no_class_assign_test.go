@@ -60,13 +60,26 @@ func TestNoClassAssignRule(t *testing.T) {
 			},
 
 			// Destructuring assignment with class name
-			// TODO: This test case is not working yet - needs investigation of AST structure
-			// {
-			// 	Code: `class A { } ({A} = 0);`,
-			// 	Errors: []rule_tester.InvalidTestCaseError{
-			// 		{MessageId: "classReassignment", Line: 1, Column: 15},
-			// 	},
-			// },
+			{
+				Code: `class A { } ({A} = 0);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "classReassignment", Line: 1, Column: 15},
+				},
+			},
+
+			// Assignment by a for-in or for-of loop
+			{
+				Code: `class A { } for (A in obj) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "classReassignment", Line: 1, Column: 18},
+				},
+			},
+			{
+				Code: `class A { } for (A of arr) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "classReassignment", Line: 1, Column: 18},
+				},
+			},
 
 			// Destructuring assignment with default value
 			{
@@ -87,8 +87,7 @@ func getTestExpression(node *ast.Node) *ast.Node {
 			return forStmt.Condition
 		}
 	case ast.KindConditionalExpression:
-		// For ternary, return the entire expression
-		return node
+		return node.AsConditionalExpression().Condition
 	}
 	return nil
 }
@@ -137,89 +136,34 @@ var NoCondAssignRule = rule.CreateRule(rule.Rule{
 					return
 				}
 
-				// Walk up to find if we're directly in a conditional test (not nested in other expressions)
-				var conditionalAncestor *ast.Node
-				current := node.Parent
-
-				// Walk up the tree to find the conditional ancestor
-				// Track whether we encounter any non-parenthesis expressions
-				// If the assignment is nested in any expression (like ||, &&, ===, etc.),
-				// it's allowed in "except-parens" mode
-				hasNonParenExpression := false
-
-				for current != nil {
-					if current.Kind == ast.KindIfStatement ||
-						current.Kind == ast.KindWhileStatement ||
-						current.Kind == ast.KindDoStatement ||
-						current.Kind == ast.KindForStatement ||
-						current.Kind == ast.KindConditionalExpression {
-						conditionalAncestor = current
-						break
-					}
-					// Check if this is a non-parenthesis expression
-					// Any expression besides ParenthesizedExpression means the assignment
-					// is nested in a larger expression context
-					if current.Kind != ast.KindParenthesizedExpression {
-						hasNonParenExpression = true
-					}
-					// Stop at function boundaries
-					if current.Kind == ast.KindFunctionDeclaration ||
-						current.Kind == ast.KindFunctionExpression ||
-						current.Kind == ast.KindArrowFunction ||
-						current.Kind == ast.KindMethodDeclaration {
-						break
-					}
-					current = current.Parent
-				}
-
-				if conditionalAncestor == nil {
-					return
-				}
-
-				// Get the actual test expression
-				testExpr := getTestExpression(conditionalAncestor)
-				if testExpr == nil {
-					return
-				}
-
-				// Check if the assignment is in the test part of the conditional
-				if !containsNode(testExpr, node) {
-					return
-				}
-
-				// Apply the rule based on mode
 				switch mode {
 				case "always":
-					// Always report assignments in conditionals
-					ctx.ReportNode(node, buildUnexpectedMessage(getConditionalTypeName(conditionalAncestor)))
-				case "except-parens":
-					// In "except-parens" mode, assignments are allowed if:
-					// 1. They are nested in any expression (like ||, &&, ===, etc.)
-					// 2. OR they are wrapped in double parentheses (for most conditionals)
-					// 3. OR they are wrapped in single parentheses (for for-loop conditions only)
-
-					if hasNonParenExpression {
-						// Assignment is nested in a larger expression (e.g., a || (a = b), (a = b) !== null)
-						// This is allowed because the assignment is not the direct test expression
-						return
+					// Report assignments anywhere inside a conditional test, unless a function boundary is crossed
+					if conditionalAncestor := findConditionalAncestor(node); conditionalAncestor != nil {
+						ctx.ReportNode(node, buildUnexpectedMessage(getConditionalTypeName(conditionalAncestor)))
 					}
-
-					// Count consecutive ParenthesizedExpression nodes wrapping the assignment
+				case "except-parens":
+					// Only assignments that are the test itself are checked, assignments nested in a larger
+					// expression (e.g. `a || (a = b)`, `(a = b) !== null`) are allowed
 					parenLevels := 0
-					current := node.Parent
-
-					// Count consecutive parenthesized expressions
-					for current != nil && current.Kind == ast.KindParenthesizedExpression {
+					current := node
+					for current.Parent != nil && current.Parent.Kind == ast.KindParenthesizedExpression {
 						parenLevels++
 						current = current.Parent
 					}
+					conditional := current.Parent
+					if conditional == nil || getTestExpression(conditional) != current {
+						return
+					}
 
-					// NOTE: In the TypeScript-Go AST, it appears that ((a = b)) only creates
-					// one ParenthesizedExpression node, not two. So we only require >= 1 parenthesis level.
-					// This differs from how ESLint checks by counting actual token positions.
-					isProperlyParenthesized := parenLevels >= 1
-
-					if !isProperlyParenthesized {
+					// The parentheses of `if (...)`, `while (...)` and `do ... while (...)` belong to the statement,
+					// so a single ParenthesizedExpression is the second pair. `for` tests need one pair and ternary
+					// tests need two, e.g. `((a = b)) ? c : d`.
+					requiredParenLevels := 1
+					if conditional.Kind == ast.KindConditionalExpression {
+						requiredParenLevels = 2
+					}
+					if parenLevels < requiredParenLevels {
 						ctx.ReportNode(node, buildMissingMessage())
 					}
 				}
@@ -228,23 +172,12 @@ var NoCondAssignRule = rule.CreateRule(rule.Rule{
 	},
 })
 
-// containsNode checks if a root node contains a target node in its subtree
-func containsNode(root, target *ast.Node) bool {
-	if root == nil || target == nil {
-		return false
-	}
-	if root == target {
-		return true
-	}
-
-	// Walk up from target to see if we reach root
-	current := target.Parent
-	for current != nil {
-		if current == root {
-			return true
+// findConditionalAncestor returns the conditional whose test contains node, stopping at function boundaries
+func findConditionalAncestor(node *ast.Node) *ast.Node {
+	for current := node; current.Parent != nil && !ast.IsFunctionLike(current); current = current.Parent {
+		if getTestExpression(current.Parent) == current {
+			return current.Parent
 		}
-		current = current.Parent
 	}
-
-	return false
+	return nil
 }
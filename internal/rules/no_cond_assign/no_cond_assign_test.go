@@ -44,6 +44,16 @@ func TestNoCondAssignRule(t *testing.T) {
 			{Code: `var x; x = 0;`},
 			{Code: `var x = 1; x += 1;`},
 
+			// Ternary conditionals - only the test is checked, and it needs two pairs of parentheses
+			{Code: `var x; var b = ((x = 0)) ? 1 : 0;`},
+			{Code: `var x = 0 ? (x = 1) : 2;`, Options: "always"},
+			{Code: `var x = 0 ? 1 : (x = 2);`},
+			{Code: `if (a ? b : (c = d)) { }`},
+
+			// Function boundaries
+			{Code: `if (function(node) { return node = parentNode; }) { }`, Options: "always"},
+			{Code: `while (() => a = b) { }`, Options: "always"},
+
 			// Comparisons (not assignments)
 			{Code: `if (x === 0) { }`},
 			{Code: `while (x == 1) { }`},
@@ -172,10 +182,30 @@ func TestNoCondAssignRule(t *testing.T) {
 
 			// Ternary conditionals
 			{
-				Code:    `var x = 0 ? (x = 1) : 2;`,
+				Code: `var x; var b = (x = 0) ? 1 : 0;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missing", Line: 1, Column: 17},
+				},
+			},
+			{
+				Code:    `var x; var b = (x = 0) ? 1 : 0;`,
+				Options: "always",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 17},
+				},
+			},
+			{
+				Code:    `var x; var b = ((x = 0)) ? 1 : 0;`,
+				Options: "always",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 18},
+				},
+			},
+			{
+				Code:    `if (a ? (b = c) : d) { }`,
 				Options: "always",
 				Errors: []rule_tester.InvalidTestCaseError{
-					{MessageId: "unexpected", Line: 1, Column: 14},
+					{MessageId: "unexpected", Line: 1, Column: 10},
 				},
 			},
 
@@ -141,6 +141,10 @@ func isWriteReference(node *ast.Node) bool {
 		// In array destructuring like [x] = arr, x is a write reference
 		return isInDestructuringAssignment(parent)
 
+	case ast.KindForInStatement, ast.KindForOfStatement:
+		// In loops like for (x in obj), x is assigned on each iteration
+		return parent.AsForInOrOfStatement().Initializer == node
+
 	case ast.KindParenthesizedExpression:
 		// Unwrap parentheses and check the parent context
 		return isWriteReference(parent)
@@ -242,7 +246,13 @@ func checkIdentifierWrite(node *ast.Node, ctx *rule.RuleContext, constSymbols ma
 		return
 	}
 
-	symbol := ctx.TypeChecker.GetSymbolAtLocation(node)
+	// In destructuring like {x} = obj, the identifier itself resolves to the property
+	var symbol *ast.Symbol
+	if node.Parent.Kind == ast.KindShorthandPropertyAssignment {
+		symbol = ctx.TypeChecker.GetShorthandAssignmentValueSymbol(node.Parent)
+	} else {
+		symbol = ctx.TypeChecker.GetSymbolAtLocation(node)
+	}
 	if symbol == nil {
 		return
 	}
@@ -296,38 +306,6 @@ var NoConstAssignRule = rule.CreateRule(rule.Rule{
 			ast.KindIdentifier: func(node *ast.Node) {
 				checkIdentifierWrite(node, &ctx, constSymbols)
 			},
-
-			// Check shorthand property assignments in destructuring (e.g., {x} = obj)
-			ast.KindShorthandPropertyAssignment: func(node *ast.Node) {
-				shorthand := node.AsShorthandPropertyAssignment()
-				if shorthand == nil || shorthand.Name() == nil {
-					return
-				}
-
-				// Check if this shorthand is in a destructuring assignment
-				if !isInDestructuringAssignment(node) {
-					return
-				}
-
-				// This is a write reference, check if it refers to a const variable
-				if ctx.TypeChecker == nil {
-					return
-				}
-
-				symbol := ctx.TypeChecker.GetSymbolAtLocation(shorthand.Name())
-				if symbol == nil {
-					return
-				}
-
-				// Check if this symbol refers to a const variable
-				if !constSymbols[symbol] {
-					return
-				}
-
-				// Report the violation
-				identName := getIdentifierName(shorthand.Name())
-				ctx.ReportNode(shorthand.Name(), buildConstMessage(identName))
-			},
 		}
 	},
 })
@@ -109,13 +109,32 @@ func TestNoConstAssignRule(t *testing.T) {
 			},
 
 			// Assignment via destructuring
-			// TODO: This test case is not working yet - needs investigation of AST structure
-			// {
-			// 	Code: `const x = 0; ({x} = {x: 1});`,
-			// 	Errors: []rule_tester.InvalidTestCaseError{
-			// 		{MessageId: "const", Line: 1, Column: 16},
-			// 	},
-			// },
+			{
+				Code: `const x = 0; ({x} = {x: 1});`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "const", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code: `const x = 0; [x] = [1];`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "const", Line: 1, Column: 15},
+				},
+			},
+
+			// Assignment by a for-in or for-of loop
+			{
+				Code: `const x = 0; for (x in obj) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "const", Line: 1, Column: 19},
+				},
+			},
+			{
+				Code: `const x = 0; for (x of arr) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "const", Line: 1, Column: 19},
+				},
+			},
 
 			// Compound assignment +=
 			{
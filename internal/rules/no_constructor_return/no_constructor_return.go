@@ -27,13 +27,8 @@ func findEnclosingConstructor(node *ast.Node) *ast.Node {
 		}
 
 		// Stop at function boundaries - don't traverse into nested functions
-		// Return statements in nested functions don't apply to the outer constructor
-		switch current.Kind {
-		case ast.KindFunctionDeclaration,
-			ast.KindFunctionExpression,
-			ast.KindArrowFunction,
-			ast.KindMethodDeclaration:
-			// We've hit a function boundary, stop searching
+		// Return statements in nested functions, methods and accessors don't apply to the outer constructor
+		if ast.IsFunctionLike(current) {
 			return nil
 		}
 
@@ -41,6 +41,10 @@ func TestNoConstructorReturnRule(t *testing.T) {
 			{Code: `class C { constructor() { function fn() { return true } } }`},
 			{Code: `class C { constructor() { this.fn = function () { return true } } }`},
 			{Code: `class C { constructor() { this.fn = () => { return true } } }`},
+			{Code: `class C { constructor() { this.o = { get x() { return 1 } } } }`},
+			{Code: `class C { constructor() { this.o = { set x(v) { return } } } }`},
+			{Code: `class C { constructor() { this.o = { m() { return 1 } } } }`},
+			{Code: `class C { constructor() { class D { constructor() { return } } } }`},
 
 			// TypeScript: classes with multiple constructors
 			{Code: `class C { constructor(); constructor(a?: string) {} }`},
@@ -192,6 +196,18 @@ func TestNoConstructorReturnRule(t *testing.T) {
 					},
 				},
 			},
+
+			// Only the return of the constructor itself is reported
+			{
+				Code: `class C { constructor() { const o = { get x() { return 1 } }; return o } }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpected",
+						Line:      1,
+						Column:    63,
+					},
+				},
+			},
 		},
 	)
 }
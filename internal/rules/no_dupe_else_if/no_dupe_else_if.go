@@ -0,0 +1,126 @@
+package no_dupe_else_if
+
+import (
+	"slices"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builder
+func buildUnexpectedMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpected",
+		Description: "This branch can never execute. Its condition is a duplicate or covered by previous conditions in the if-else-if chain.",
+	}
+}
+
+// splitByLogicalOperator returns the operands of a chain of `operator`, e.g. [a, b, c] for `a && (b && c)`
+func splitByLogicalOperator(operator ast.Kind, node *ast.Node) []*ast.Node {
+	node = ast.SkipParentheses(node)
+	if node.Kind == ast.KindBinaryExpression {
+		binary := node.AsBinaryExpression()
+		if binary.OperatorToken.Kind == operator {
+			return append(splitByLogicalOperator(operator, binary.Left), splitByLogicalOperator(operator, binary.Right)...)
+		}
+	}
+	return []*ast.Node{node}
+}
+
+func splitByOr(node *ast.Node) []*ast.Node {
+	return splitByLogicalOperator(ast.KindBarBarToken, node)
+}
+
+func splitByAnd(node *ast.Node) []*ast.Node {
+	return splitByLogicalOperator(ast.KindAmpersandAmpersandToken, node)
+}
+
+// isSubset checks that every element of a has an equal element in b
+func isSubset(a []*ast.Node, b []*ast.Node, equal func(*ast.Node, *ast.Node) bool) bool {
+	return utils.Every(a, func(x *ast.Node) bool {
+		return utils.Some(b, func(y *ast.Node) bool { return equal(x, y) })
+	})
+}
+
+// NoDupeElseIfRule disallows duplicate conditions in if-else-if chains
+var NoDupeElseIfRule = rule.CreateRule(rule.Rule{
+	Name: "no-dupe-else-if",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		// getTokenTexts returns the texts of all tokens of a node, ignoring whitespace and comments
+		getTokenTexts := func(node *ast.Node) []string {
+			nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			s := scanner.GetScannerForSourceFile(ctx.SourceFile, nodeRange.Pos())
+			var texts []string
+			for s.Token() != ast.KindEndOfFile && s.TokenStart() < nodeRange.End() {
+				texts = append(texts, s.TokenText())
+				s.Scan()
+			}
+			return texts
+		}
+
+		// equal compares conditions by their tokens, treating && and || as commutative
+		var equal func(a *ast.Node, b *ast.Node) bool
+		equal = func(a *ast.Node, b *ast.Node) bool {
+			a = ast.SkipParentheses(a)
+			b = ast.SkipParentheses(b)
+			if a.Kind == ast.KindBinaryExpression && b.Kind == ast.KindBinaryExpression {
+				left := a.AsBinaryExpression()
+				right := b.AsBinaryExpression()
+				operator := left.OperatorToken.Kind
+				if (operator == ast.KindAmpersandAmpersandToken || operator == ast.KindBarBarToken) &&
+					operator == right.OperatorToken.Kind {
+					return (equal(left.Left, right.Left) && equal(left.Right, right.Right)) ||
+						(equal(left.Left, right.Right) && equal(left.Right, right.Left))
+				}
+			}
+			return slices.Equal(getTokenTexts(a), getTokenTexts(b))
+		}
+
+		return rule.RuleListeners{
+			ast.KindIfStatement: func(node *ast.Node) {
+				test := node.AsIfStatement().Expression
+
+				// `a && b` is also covered by a previous `a` or `b` on its own
+				conditionsToCheck := []*ast.Node{test}
+				if operands := splitByAnd(test); len(operands) > 1 {
+					conditionsToCheck = append(conditionsToCheck, operands...)
+				}
+
+				// Each condition is a list of || operands, each of them a list of && operands
+				listToCheck := make([][][]*ast.Node, len(conditionsToCheck))
+				for i, condition := range conditionsToCheck {
+					for _, orOperand := range splitByOr(condition) {
+						listToCheck[i] = append(listToCheck[i], splitByAnd(orOperand))
+					}
+				}
+
+				current := node
+				for current.Parent != nil && current.Parent.Kind == ast.KindIfStatement &&
+					current.Parent.AsIfStatement().ElseStatement == current {
+					current = current.Parent
+
+					var currentOrOperands [][]*ast.Node
+					for _, orOperand := range splitByOr(current.AsIfStatement().Expression) {
+						currentOrOperands = append(currentOrOperands, splitByAnd(orOperand))
+					}
+
+					// Drop the operands that a previous condition already covers
+					for i, orOperands := range listToCheck {
+						listToCheck[i] = slices.DeleteFunc(orOperands, func(orOperand []*ast.Node) bool {
+							return utils.Some(currentOrOperands, func(currentOrOperand []*ast.Node) bool {
+								return isSubset(currentOrOperand, orOperand, equal)
+							})
+						})
+					}
+
+					if utils.Some(listToCheck, func(orOperands [][]*ast.Node) bool { return len(orOperands) == 0 }) {
+						ctx.ReportNode(ast.SkipParentheses(test), buildUnexpectedMessage())
+						break
+					}
+				}
+			},
+		}
+	},
+})
@@ -0,0 +1,167 @@
+package no_dupe_else_if
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoDupeElseIfRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoDupeElseIfRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `if (a) {} else if (b) {}`},
+			{Code: `if (a); else if (b); else if (c);`},
+			{Code: `if (true) {} else if (false) {} else {}`},
+			{Code: `if (1) {} else if (2) {}`},
+			{Code: `if (f) {} else if (f()) {}`},
+			{Code: `if (f(a)) {} else if (g(a)) {}`},
+			{Code: `if (f(a)) {} else if (f(b)) {}`},
+			{Code: `if (a === 1) {} else if (a === 2) {}`},
+			{Code: `if (a === 1) {} else if (b === 1) {}`},
+			{Code: `if (a) {}`},
+			{Code: `if (a) {} else {}`},
+			{Code: `if (a) if (a) {}`},
+			{Code: `if (a) { if (a) {} }`},
+			{Code: `if (a) {} else { if (a) {} }`},
+			{Code: `if (a) {} if (a) {}`},
+			{Code: `if (a) {} else if (b) {} if (a) {}`},
+			{Code: `if (a && b) {} else if (a) {}`},
+			{Code: `if (a && b) {} else if (b && c) {}`},
+			{Code: `if (a || b) {} else if (c || d) {}`},
+			{Code: `if (a || b) {} else if (a || c) {}`},
+			{Code: `if (a) {} else if (a || b) {}`},
+			{Code: `if (a) {} else if (b) {} else if (a || b || c) {}`},
+			{Code: `if (a) {} else if (a === b) {}`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `if (a) {} else if (a) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 20},
+				},
+			},
+			{
+				Code: `if (a); else if (a);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 18},
+				},
+			},
+			{
+				Code: `if (a) {} else if (b) {} else if (a) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 35},
+				},
+			},
+			{
+				Code: `if (a) {} else if (b) {} else if (b) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 35},
+				},
+			},
+			{
+				Code: `if (a) {} else if (b) {} else if (c) {} else if (a) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 50},
+				},
+			},
+			{
+				Code: `if (a) {} else if (b) {} else if (c) {} else if (b) {} else if (c) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 50},
+					{MessageId: "unexpected", Line: 1, Column: 65},
+				},
+			},
+			{
+				Code: `if (a === 1) {} else if (a === 1) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 26},
+				},
+			},
+			{
+				Code: `if (1 < a) {} else if (1 < a) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 24},
+				},
+			},
+			{
+				Code: `if (f(a)) {} else if (f(a)) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 23},
+				},
+			},
+			{
+				Code: `if (a) {} else if ((a)) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 21},
+				},
+			},
+			{
+				Code: `if (a && b) {} else if (a && b) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 25},
+				},
+			},
+			{
+				Code: `if (a && b) {} else if (b && a) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 25},
+				},
+			},
+			{
+				Code: `if (a) {} else if (a && b) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 20},
+				},
+			},
+			{
+				Code: `if (a && b) {} else if (a && b && c) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 25},
+				},
+			},
+			{
+				Code: `if (a || b) {} else if (a) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 25},
+				},
+			},
+			{
+				Code: `if (a || b) {} else if (b || a) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 25},
+				},
+			},
+			{
+				Code: `if (a) {} else if (b) {} else if (a || b) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 35},
+				},
+			},
+			{
+				Code: `if (a) {} else if (b) {} else if (c) {} else if (a || b || c) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 50},
+				},
+			},
+			{
+				Code: `if ((a === b && fn(c)) || d) {} else if (fn(c) && a === b) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 42},
+				},
+			},
+			{
+				Code: `if (a) {} else if (b) {} else if (c && a || b) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 35},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,85 @@
+package no_ex_assign
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// Message builder
+func buildUnexpectedMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpected",
+		Description: "Do not assign to the exception parameter.",
+	}
+}
+
+// collectBindingNames returns the identifiers declared by a catch parameter, including destructured ones
+func collectBindingNames(name *ast.Node) []*ast.Node {
+	switch name.Kind {
+	case ast.KindIdentifier:
+		return []*ast.Node{name}
+	case ast.KindObjectBindingPattern, ast.KindArrayBindingPattern:
+		var names []*ast.Node
+		for _, element := range name.AsBindingPattern().Elements.Nodes {
+			if element.Kind == ast.KindBindingElement {
+				names = append(names, collectBindingNames(element.Name())...)
+			}
+		}
+		return names
+	}
+	return nil
+}
+
+// NoExAssignRule disallows reassigning exceptions in catch clauses
+var NoExAssignRule = rule.CreateRule(rule.Rule{
+	Name: "no-ex-assign",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		getReferencedSymbol := func(identifier *ast.Node) *ast.Symbol {
+			parent := identifier.Parent
+			if parent.Kind == ast.KindShorthandPropertyAssignment && parent.Name() == identifier {
+				return ctx.TypeChecker.GetShorthandAssignmentValueSymbol(parent)
+			}
+			return ctx.TypeChecker.GetSymbolAtLocation(identifier)
+		}
+
+		return rule.RuleListeners{
+			ast.KindCatchClause: func(node *ast.Node) {
+				clause := node.AsCatchClause()
+				if clause.VariableDeclaration == nil {
+					return
+				}
+
+				params := make(map[string]*ast.Symbol)
+				for _, name := range collectBindingNames(clause.VariableDeclaration.Name()) {
+					var symbol *ast.Symbol
+					if ctx.TypeChecker != nil {
+						symbol = ctx.TypeChecker.GetSymbolAtLocation(name)
+					}
+					params[name.Text()] = symbol
+				}
+				if len(params) == 0 {
+					return
+				}
+
+				// isParamWrite checks for an assignment to the parameter itself rather than a shadowing variable
+				isParamWrite := func(identifier *ast.Node) bool {
+					symbol, ok := params[identifier.Text()]
+					if !ok || !ast.IsAssignmentTarget(identifier) {
+						return false
+					}
+					return symbol == nil || getReferencedSymbol(identifier) == symbol
+				}
+
+				var visit func(n *ast.Node) bool
+				visit = func(n *ast.Node) bool {
+					if n.Kind == ast.KindIdentifier && isParamWrite(n) {
+						ctx.ReportNode(n, buildUnexpectedMessage())
+					}
+					n.ForEachChild(visit)
+					return false
+				}
+				clause.Block.ForEachChild(visit)
+			},
+		}
+	},
+})
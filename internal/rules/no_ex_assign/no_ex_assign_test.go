@@ -0,0 +1,95 @@
+package no_ex_assign
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoExAssignRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoExAssignRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `try { } catch (e) { three = 2 + 1; }`},
+			{Code: `try { } catch ({e}) { this.something = 2; }`},
+			{Code: `function foo() { try { } catch (e) { return false; } }`},
+			{Code: `try { } catch (e) { const x = e; }`},
+			{Code: `try { } catch { e = 1; }`},
+			{Code: `try { } catch (e) { e.message = 'foo'; }`},
+			{Code: `try { } catch (e) { function f() { let e; e = 1; } }`},
+			{Code: `try { } catch (e) { { let e = 0; e = 1; } }`},
+			{Code: `try { } catch ({ message }) { const e = message; }`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `try { } catch (e) { e = 10; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 21},
+				},
+			},
+			{
+				Code: `try { } catch (ex) { ex = 10; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 22},
+				},
+			},
+			{
+				Code: `try { } catch (ex) { [ex] = []; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 23},
+				},
+			},
+			{
+				Code: `try { } catch (ex) { ({x: ex = 0} = {}); }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 27},
+				},
+			},
+			{
+				Code: `try { } catch (ex) { ({ex} = {}); }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 24},
+				},
+			},
+			{
+				Code: `try { } catch (ex) { ex++; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 22},
+				},
+			},
+			{
+				Code: `try { } catch (ex) { if (cond) { ex ??= new Error(); } }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 34},
+				},
+			},
+			{
+				Code: `try { } catch (ex) { const f = () => { ex = 1; }; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 40},
+				},
+			},
+
+			// Destructured catch parameters
+			{
+				Code: `try { } catch ({ message }) { message = 'x'; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 31},
+				},
+			},
+			{
+				Code: `try { } catch ([first, { code }]) { first = 1; code = 2; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 37},
+					{MessageId: "unexpected", Line: 1, Column: 48},
+				},
+			},
+		},
+	)
+}
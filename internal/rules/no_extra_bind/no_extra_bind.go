@@ -0,0 +1,113 @@
+package no_extra_bind
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builder
+func buildUnexpectedMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpected",
+		Description: "The function binding is unnecessary.",
+	}
+}
+
+// getBindName returns the `bind` name node of `x.bind` and `x['bind']`, or nil
+func getBindName(callee *ast.Node) *ast.Node {
+	switch callee.Kind {
+	case ast.KindPropertyAccessExpression:
+		name := callee.AsPropertyAccessExpression().Name()
+		if name.Kind == ast.KindIdentifier && name.Text() == "bind" {
+			return name
+		}
+	case ast.KindElementAccessExpression:
+		argument := callee.AsElementAccessExpression().ArgumentExpression
+		if (argument.Kind == ast.KindStringLiteral || argument.Kind == ast.KindNoSubstitutionTemplateLiteral) && argument.Text() == "bind" {
+			return argument
+		}
+	}
+	return nil
+}
+
+// usesThis checks whether `this` is used by the function itself, not counting nested
+// functions and classes which have their own `this`
+func usesThis(node *ast.Node) bool {
+	found := false
+	var visit func(child *ast.Node) bool
+	visit = func(child *ast.Node) bool {
+		switch child.Kind {
+		case ast.KindThisKeyword:
+			found = true
+			return true
+		case ast.KindFunctionDeclaration, ast.KindFunctionExpression, ast.KindClassDeclaration, ast.KindClassExpression:
+			return false
+		}
+		return child.ForEachChild(visit)
+	}
+	node.ForEachChild(visit)
+	return found
+}
+
+// isSideEffectFree checks whether removing the evaluation of a node can't change behavior
+func isSideEffectFree(node *ast.Node) bool {
+	switch node.Kind {
+	case ast.KindParenthesizedExpression:
+		return isSideEffectFree(node.AsParenthesizedExpression().Expression)
+	case ast.KindIdentifier, ast.KindThisKeyword, ast.KindFunctionExpression,
+		ast.KindStringLiteral, ast.KindNumericLiteral, ast.KindBigIntLiteral, ast.KindRegularExpressionLiteral,
+		ast.KindNoSubstitutionTemplateLiteral, ast.KindNullKeyword, ast.KindTrueKeyword, ast.KindFalseKeyword:
+		return true
+	}
+	return false
+}
+
+// NoExtraBindRule disallows unnecessary calls to `.bind()`
+var NoExtraBindRule = rule.CreateRule(rule.Rule{
+	Name: "no-extra-bind",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		return rule.RuleListeners{
+			ast.KindCallExpression: func(node *ast.Node) {
+				args := node.Arguments()
+				if len(args) != 1 || args[0].Kind == ast.KindSpreadElement {
+					return
+				}
+
+				callee := ast.SkipParentheses(node.AsCallExpression().Expression)
+				bindName := getBindName(callee)
+				if bindName == nil {
+					return
+				}
+
+				object := callee.Expression()
+				function := ast.SkipParentheses(object)
+				switch function.Kind {
+				case ast.KindArrowFunction:
+					// Arrow functions ignore bound `this`
+				case ast.KindFunctionExpression:
+					if usesThis(function) {
+						return
+					}
+				default:
+					return
+				}
+
+				// `(fn.bind)(x)` can't be fixed by removing the tail of the call
+				if !isSideEffectFree(args[0]) || node.AsCallExpression().Expression != callee {
+					ctx.ReportNode(bindName, buildUnexpectedMessage())
+					return
+				}
+
+				removeRange := core.NewTextRange(object.End(), node.End())
+				if utils.HasCommentsInRange(ctx.SourceFile, removeRange) {
+					ctx.ReportNode(bindName, buildUnexpectedMessage())
+					return
+				}
+
+				ctx.ReportNodeWithFixes(bindName, buildUnexpectedMessage(), rule.RuleFixRemoveRange(removeRange))
+			},
+		}
+	},
+})
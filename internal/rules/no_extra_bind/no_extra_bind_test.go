@@ -0,0 +1,137 @@
+package no_extra_bind
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoExtraBindRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoExtraBindRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `var a = function(b) { return b }.bind(c, d)`},
+			{Code: `var a = function(b) { return b }.bind(...c)`},
+			{Code: `var a = function() { this.b }()`},
+			{Code: `var a = function() { this.b }.foo()`},
+			{Code: `var a = f.bind(a)`},
+			{Code: `var a = function() { return this.b }.bind(c)`},
+			{Code: `var a = (() => { return b }).bind(c, d)`},
+			{Code: `(function() { (function() { this.b }.bind(this)) }.bind(c))`},
+			{Code: `var a = function() { return 1; }[bind](b)`},
+			{Code: `var a = function() { return () => this; }.bind(b)`},
+			{Code: `var a = function() { return { b: () => this.c } }.bind(d)`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:   `var a = function() { return 1; }.bind(b)`,
+				Output: []string{`var a = function() { return 1; }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 34},
+				},
+			},
+			{
+				Code:   `var a = function() { return 1; }['bind'](b)`,
+				Output: []string{`var a = function() { return 1; }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 34},
+				},
+			},
+			{
+				Code:   "var a = function() { return 1; }[`bind`](b)",
+				Output: []string{`var a = function() { return 1; }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 34},
+				},
+			},
+			{
+				Code:   `var a = (() => { return 1; }).bind(b)`,
+				Output: []string{`var a = (() => { return 1; })`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 31},
+				},
+			},
+			{
+				Code:   `var a = (() => { return this; }).bind(b)`,
+				Output: []string{`var a = (() => { return this; })`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 34},
+				},
+			},
+			{
+				Code:   `var a = function() { (function(){ this.c }) }.bind(b)`,
+				Output: []string{`var a = function() { (function(){ this.c }) }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 47},
+				},
+			},
+			{
+				Code:   `var a = function() { function c(){ this.d } }.bind(b)`,
+				Output: []string{`var a = function() { function c(){ this.d } }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 47},
+				},
+			},
+			{
+				Code:   `var a = function() { class C { d = this.e } }.bind(b)`,
+				Output: []string{`var a = function() { class C { d = this.e } }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 47},
+				},
+			},
+			{
+				Code:   `var a = function() { return 1; }.bind(this)`,
+				Output: []string{`var a = function() { return 1; }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 34},
+				},
+			},
+			{
+				Code:   `var a = (function() { return 1; }).bind(b)`,
+				Output: []string{`var a = (function() { return 1; })`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 36},
+				},
+			},
+			{
+				Code:   `var a = function() { return 1; }?.bind(b)`,
+				Output: []string{`var a = function() { return 1; }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 35},
+				},
+			},
+
+			// Not fixable
+			{
+				Code: `var a = function() { return 1; }.bind(b())`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 34},
+				},
+			},
+			{
+				Code: `var a = function() { return 1; }/* comment */.bind(b)`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 47},
+				},
+			},
+			{
+				Code: `var a = function() { return 1; }.bind(/* comment */b)`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 34},
+				},
+			},
+			{
+				Code: `var a = (function() { return 1; }.bind)(b)`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpected", Line: 1, Column: 35},
+				},
+			},
+		},
+	)
+}
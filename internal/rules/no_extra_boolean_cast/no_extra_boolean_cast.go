@@ -0,0 +1,297 @@
+package no_extra_boolean_cast
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Options for no-extra-boolean-cast rule
+type Options struct {
+	EnforceForInnerExpressions bool `json:"enforceForInnerExpressions"`
+	// Deprecated: superseded by EnforceForInnerExpressions
+	EnforceForLogicalOperands bool `json:"enforceForLogicalOperands"`
+}
+
+func parseOptions(options any) Options {
+	opts := Options{}
+
+	if options == nil {
+		return opts
+	}
+
+	// Parse options with dual-format support (handles both array and object formats)
+	var optsMap map[string]interface{}
+	var ok bool
+
+	// Handle array format: [{ option: value }]
+	if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+		optsMap, ok = optArray[0].(map[string]interface{})
+	} else {
+		// Handle direct object format: { option: value }
+		optsMap, ok = options.(map[string]interface{})
+	}
+
+	if ok {
+		if v, ok := optsMap["enforceForInnerExpressions"].(bool); ok {
+			opts.EnforceForInnerExpressions = v
+		}
+		if v, ok := optsMap["enforceForLogicalOperands"].(bool); ok {
+			opts.EnforceForLogicalOperands = v
+		}
+	}
+	return opts
+}
+
+// Message builders
+func buildUnexpectedCallMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpectedCall",
+		Description: "Redundant Boolean call.",
+	}
+}
+
+func buildUnexpectedNegationMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpectedNegation",
+		Description: "Redundant double negation.",
+	}
+}
+
+// skipOuterParentheses returns the outermost parenthesized expression wrapping node
+func skipOuterParentheses(node *ast.Node) *ast.Node {
+	for node.Parent != nil && node.Parent.Kind == ast.KindParenthesizedExpression {
+		node = node.Parent
+	}
+	return node
+}
+
+func isBinaryWithOperator(node *ast.Node, operators ...ast.Kind) bool {
+	if node == nil || node.Kind != ast.KindBinaryExpression {
+		return false
+	}
+	operator := node.AsBinaryExpression().OperatorToken.Kind
+	for _, op := range operators {
+		if operator == op {
+			return true
+		}
+	}
+	return false
+}
+
+// isLogicalContext checks for `||` and `&&` expressions
+func isLogicalContext(node *ast.Node) bool {
+	return isBinaryWithOperator(node, ast.KindBarBarToken, ast.KindAmpersandAmpersandToken)
+}
+
+func isLogicalNegation(node *ast.Node) bool {
+	return node != nil &&
+		node.Kind == ast.KindPrefixUnaryExpression &&
+		node.AsPrefixUnaryExpression().Operator == ast.KindExclamationToken
+}
+
+// isBooleanCall checks for `Boolean(...)` and `new Boolean(...)`
+func isBooleanCall(node *ast.Node) bool {
+	if node == nil || !ast.IsCallOrNewExpression(node) {
+		return false
+	}
+	callee := node.Expression()
+	return callee.Kind == ast.KindIdentifier && callee.Text() == "Boolean"
+}
+
+// isInBooleanContext checks whether the value of node is immediately coerced to a boolean
+func isInBooleanContext(node *ast.Node) bool {
+	node = skipOuterParentheses(node)
+	parent := node.Parent
+	if parent == nil {
+		return false
+	}
+
+	switch parent.Kind {
+	case ast.KindIfStatement:
+		return parent.AsIfStatement().Expression == node
+	case ast.KindWhileStatement:
+		return parent.AsWhileStatement().Expression == node
+	case ast.KindDoStatement:
+		return parent.AsDoStatement().Expression == node
+	case ast.KindForStatement:
+		return parent.AsForStatement().Condition == node
+	case ast.KindConditionalExpression:
+		return parent.AsConditionalExpression().Condition == node
+	case ast.KindPrefixUnaryExpression:
+		return isLogicalNegation(parent)
+	case ast.KindCallExpression, ast.KindNewExpression:
+		args := parent.Arguments()
+		return isBooleanCall(parent) && len(args) > 0 && args[0] == node
+	}
+	return false
+}
+
+// needsParens checks whether replacing previousNode with replacement requires wrapping it in parentheses
+func needsParens(previousNode *ast.Node, replacement *ast.Node) bool {
+	parent := previousNode.Parent
+	if parent == nil || parent.Kind == ast.KindParenthesizedExpression {
+		return false
+	}
+
+	precedence := ast.GetExpressionPrecedence(replacement)
+
+	switch parent.Kind {
+	case ast.KindCallExpression, ast.KindNewExpression:
+		return isBinaryWithOperator(replacement, ast.KindCommaToken)
+	case ast.KindIfStatement, ast.KindDoStatement, ast.KindWhileStatement, ast.KindForStatement:
+		return false
+	case ast.KindConditionalExpression:
+		return precedence <= ast.GetExpressionPrecedence(parent)
+	case ast.KindPrefixUnaryExpression:
+		return precedence < ast.GetExpressionPrecedence(parent)
+	case ast.KindBinaryExpression:
+		// `a ?? b` can't be mixed with `&&`/`||` without parentheses
+		if isBinaryWithOperator(replacement, ast.KindQuestionQuestionToken) != isBinaryWithOperator(parent, ast.KindQuestionQuestionToken) &&
+			(isLogicalContext(replacement) || isLogicalContext(parent)) {
+			return true
+		}
+		if parent.AsBinaryExpression().Left == previousNode {
+			return precedence < ast.GetExpressionPrecedence(parent)
+		}
+		return precedence <= ast.GetExpressionPrecedence(parent)
+	}
+	return false
+}
+
+func isIdentifierPartChar(ch byte) bool {
+	return ch == '_' || ch == '$' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9')
+}
+
+// NoExtraBooleanCastRule disallows unnecessary boolean casts
+var NoExtraBooleanCastRule = rule.CreateRule(rule.Rule{
+	Name: "no-extra-boolean-cast",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+
+		// isInFlaggedContext checks whether node is in a context where its value is only used as a boolean
+		var isInFlaggedContext func(node *ast.Node) bool
+		isInFlaggedContext = func(node *ast.Node) bool {
+			node = skipOuterParentheses(node)
+			parent := node.Parent
+			if parent == nil {
+				return false
+			}
+
+			if (opts.EnforceForLogicalOperands || opts.EnforceForInnerExpressions) && isLogicalContext(parent) {
+				return isInFlaggedContext(parent)
+			}
+
+			if opts.EnforceForInnerExpressions {
+				switch parent.Kind {
+				case ast.KindConditionalExpression:
+					conditional := parent.AsConditionalExpression()
+					if conditional.WhenTrue == node || conditional.WhenFalse == node {
+						return isInFlaggedContext(parent)
+					}
+				case ast.KindBinaryExpression:
+					// Only the right side of `??` and the last expression of a sequence are used as the result
+					if isBinaryWithOperator(parent, ast.KindQuestionQuestionToken, ast.KindCommaToken) && parent.AsBinaryExpression().Right == node {
+						return isInFlaggedContext(parent)
+					}
+				}
+			}
+
+			return isInBooleanContext(node)
+		}
+
+		isGlobalBoolean := func(callee *ast.Node) bool {
+			if ctx.TypeChecker == nil {
+				return true
+			}
+			symbol := ctx.TypeChecker.GetSymbolAtLocation(callee)
+			return symbol == nil || utils.IsSymbolFromDefaultLibrary(ctx.Program, symbol)
+		}
+
+		// replaceWithText builds a fix replacing node with text, separating it from a preceding identifier if needed
+		replaceWithText := func(node *ast.Node, text string) rule.RuleFix {
+			nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			sourceText := ctx.SourceFile.Text()
+			if nodeRange.Pos() > 0 && len(text) > 0 && isIdentifierPartChar(sourceText[nodeRange.Pos()-1]) && isIdentifierPartChar(text[0]) {
+				text = " " + text
+			}
+			return rule.RuleFixReplaceRange(nodeRange, text)
+		}
+
+		getNodeText := func(node *ast.Node) string {
+			nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			return ctx.SourceFile.Text()[nodeRange.Pos():nodeRange.End()]
+		}
+
+		replaceWithArgument := func(node *ast.Node, argument *ast.Node) rule.RuleFix {
+			text := getNodeText(argument)
+			if needsParens(node, argument) {
+				text = "(" + text + ")"
+			}
+			return replaceWithText(node, text)
+		}
+
+		return rule.RuleListeners{
+			ast.KindPrefixUnaryExpression: func(node *ast.Node) {
+				if !isLogicalNegation(node) {
+					return
+				}
+
+				inner := ast.SkipParentheses(node.AsPrefixUnaryExpression().Operand)
+				if !isLogicalNegation(inner) {
+					return
+				}
+
+				if !isInFlaggedContext(node) {
+					return
+				}
+
+				if utils.HasCommentsInRange(ctx.SourceFile, utils.TrimNodeTextRange(ctx.SourceFile, node)) {
+					ctx.ReportNode(node, buildUnexpectedNegationMessage())
+					return
+				}
+
+				ctx.ReportNodeWithFixes(node, buildUnexpectedNegationMessage(), replaceWithArgument(node, inner.AsPrefixUnaryExpression().Operand))
+			},
+
+			ast.KindCallExpression: func(node *ast.Node) {
+				callee := node.Expression()
+				if callee.Kind != ast.KindIdentifier || callee.Text() != "Boolean" || !isGlobalBoolean(callee) {
+					return
+				}
+
+				if !isInFlaggedContext(node) {
+					return
+				}
+
+				args := node.Arguments()
+				switch len(args) {
+				case 0:
+					outer := skipOuterParentheses(node)
+					if isLogicalNegation(outer.Parent) {
+						// !Boolean() -> true
+						negation := outer.Parent
+						if utils.HasCommentsInRange(ctx.SourceFile, utils.TrimNodeTextRange(ctx.SourceFile, negation)) {
+							break
+						}
+						ctx.ReportNodeWithFixes(node, buildUnexpectedCallMessage(), replaceWithText(negation, "true"))
+						return
+					}
+					if utils.HasCommentsInRange(ctx.SourceFile, utils.TrimNodeTextRange(ctx.SourceFile, node)) {
+						break
+					}
+					ctx.ReportNodeWithFixes(node, buildUnexpectedCallMessage(), rule.RuleFixReplace(ctx.SourceFile, node, "false"))
+					return
+				case 1:
+					if args[0].Kind == ast.KindSpreadElement || utils.HasCommentsInRange(ctx.SourceFile, utils.TrimNodeTextRange(ctx.SourceFile, node)) {
+						break
+					}
+					ctx.ReportNodeWithFixes(node, buildUnexpectedCallMessage(), replaceWithArgument(node, args[0]))
+					return
+				}
+
+				ctx.ReportNode(node, buildUnexpectedCallMessage())
+			},
+		}
+	},
+})
@@ -0,0 +1,202 @@
+package no_extra_boolean_cast
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoExtraBooleanCastRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoExtraBooleanCastRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `var foo = !!bar;`},
+			{Code: `function foo() { return !!bar; }`},
+			{Code: `var foo = bar ? !!baz : !!bat;`},
+			{Code: `for(!!foo;;) {}`},
+			{Code: `for(;; !!foo) {}`},
+			{Code: `var foo = Boolean(bar);`},
+			{Code: `function foo() { return Boolean(bar); }`},
+			{Code: `var foo = bar ? Boolean(baz) : Boolean(bat);`},
+			{Code: `for(Boolean(foo);;) {}`},
+			{Code: `for(;; Boolean(foo)) {}`},
+			{Code: `if (new Boolean(foo)) {}`},
+
+			// Logical operands are only checked with options
+			{Code: `var foo = bar || !!baz;`},
+			{Code: `if (!!foo || bar) {}`},
+			{Code: `if (x ?? !!y) {}`, Options: map[string]interface{}{"enforceForLogicalOperands": true}},
+			{Code: `if (x ? !!y : z) {}`, Options: map[string]interface{}{"enforceForLogicalOperands": true}},
+			{Code: `if (!!x ?? y) {}`, Options: map[string]interface{}{"enforceForInnerExpressions": true}},
+
+			// Shadowed Boolean
+			{Code: `function f(Boolean: any) { if (Boolean(foo)) {} }`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:   `if (!!foo) {}`,
+				Output: []string{`if (foo) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedNegation", Line: 1, Column: 5},
+				},
+			},
+			{
+				Code:   `do {} while (!!foo)`,
+				Output: []string{`do {} while (foo)`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedNegation", Line: 1, Column: 14},
+				},
+			},
+			{
+				Code:   `while (!!foo) {}`,
+				Output: []string{`while (foo) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedNegation", Line: 1, Column: 8},
+				},
+			},
+			{
+				Code:   `!!foo ? bar : baz`,
+				Output: []string{`foo ? bar : baz`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedNegation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `for (; !!foo;) {}`,
+				Output: []string{`for (; foo;) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedNegation", Line: 1, Column: 8},
+				},
+			},
+			{
+				Code:   `!!!foo`,
+				Output: []string{`!foo`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedNegation", Line: 1, Column: 2},
+				},
+			},
+			{
+				Code:   `Boolean(!!foo)`,
+				Output: []string{`Boolean(foo)`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedNegation", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:   `new Boolean(!!foo)`,
+				Output: []string{`new Boolean(foo)`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedNegation", Line: 1, Column: 13},
+				},
+			},
+			{
+				Code:   `if (Boolean(foo)) {}`,
+				Output: []string{`if (foo) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 5},
+				},
+			},
+			{
+				Code:   `while (Boolean(foo)) {}`,
+				Output: []string{`while (foo) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 8},
+				},
+			},
+			{
+				Code:   `!Boolean(foo)`,
+				Output: []string{`!foo`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 2},
+				},
+			},
+			{
+				Code:   `!Boolean(a + b)`,
+				Output: []string{`!(a + b)`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 2},
+				},
+			},
+			{
+				Code:   `!Boolean()`,
+				Output: []string{`true`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 2},
+				},
+			},
+			{
+				Code:   `if (Boolean()) { foo() }`,
+				Output: []string{`if (false) { foo() }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 5},
+				},
+			},
+
+			// Comments prevent the autofix
+			{
+				Code: `if (!!/* comment */foo) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedNegation", Line: 1, Column: 5},
+				},
+			},
+
+			// enforceForLogicalOperands
+			{
+				Code:    `if (!!foo || bar) {}`,
+				Output:  []string{`if (foo || bar) {}`},
+				Options: map[string]interface{}{"enforceForLogicalOperands": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedNegation", Line: 1, Column: 5},
+				},
+			},
+			{
+				Code:    `if (foo && Boolean(bar)) {}`,
+				Output:  []string{`if (foo && bar) {}`},
+				Options: map[string]interface{}{"enforceForLogicalOperands": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 12},
+				},
+			},
+
+			// enforceForInnerExpressions
+			{
+				Code:    `if (x ? !!y : z) {}`,
+				Output:  []string{`if (x ? y : z) {}`},
+				Options: map[string]interface{}{"enforceForInnerExpressions": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedNegation", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:    `if (x ?? !!y) {}`,
+				Output:  []string{`if (x ?? y) {}`},
+				Options: map[string]interface{}{"enforceForInnerExpressions": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedNegation", Line: 1, Column: 10},
+				},
+			},
+			{
+				Code:    `if ((a, !!b)) {}`,
+				Output:  []string{`if ((a, b)) {}`},
+				Options: map[string]interface{}{"enforceForInnerExpressions": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedNegation", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:    `if (!!(a ?? b) && c) {}`,
+				Output:  []string{`if ((a ?? b) && c) {}`},
+				Options: map[string]interface{}{"enforceForInnerExpressions": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedNegation", Line: 1, Column: 5},
+				},
+			},
+		},
+	)
+}
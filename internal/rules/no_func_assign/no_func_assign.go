@@ -0,0 +1,64 @@
+package no_func_assign
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// Message builder
+func buildIsAFunctionMessage(name string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "isAFunction",
+		Description: "'" + name + "' is a function.",
+	}
+}
+
+// NoFuncAssignRule disallows reassigning function declarations
+var NoFuncAssignRule = rule.CreateRule(rule.Rule{
+	Name: "no-func-assign",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		getReferencedSymbol := func(identifier *ast.Node) *ast.Symbol {
+			parent := identifier.Parent
+			if parent.Kind == ast.KindShorthandPropertyAssignment && parent.Name() == identifier {
+				return ctx.TypeChecker.GetShorthandAssignmentValueSymbol(parent)
+			}
+			return ctx.TypeChecker.GetSymbolAtLocation(identifier)
+		}
+
+		// checkReassignments reports writes to the function name within scope
+		checkReassignments := func(name *ast.Node, scope *ast.Node) {
+			var symbol *ast.Symbol
+			if ctx.TypeChecker != nil {
+				symbol = ctx.TypeChecker.GetSymbolAtLocation(name)
+			}
+
+			var visit func(n *ast.Node) bool
+			visit = func(n *ast.Node) bool {
+				if n.Kind == ast.KindIdentifier && n != name && n.Text() == name.Text() && ast.IsAssignmentTarget(n) &&
+					(symbol == nil || getReferencedSymbol(n) == symbol) {
+					ctx.ReportNode(n, buildIsAFunctionMessage(n.Text()))
+				}
+				n.ForEachChild(visit)
+				return false
+			}
+			scope.ForEachChild(visit)
+		}
+
+		return rule.RuleListeners{
+			ast.KindFunctionDeclaration: func(node *ast.Node) {
+				// Overload signatures and ambient declarations share the implementation's binding
+				name := node.Name()
+				if name == nil || node.Body() == nil {
+					return
+				}
+				checkReassignments(name, node.Parent)
+			},
+			ast.KindFunctionExpression: func(node *ast.Node) {
+				// The name of a function expression is only bound inside the function itself
+				if name := node.Name(); name != nil {
+					checkReassignments(name, node)
+				}
+			},
+		}
+	},
+})
@@ -0,0 +1,100 @@
+package no_func_assign
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoFuncAssignRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoFuncAssignRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `function foo() { var foo = bar; }`},
+			{Code: `function foo(foo) { foo = bar; }`},
+			{Code: `function foo() { var foo; foo = bar; }`},
+			{Code: `var foo = () => {}; foo = bar;`},
+			{Code: `var foo = function() {}; foo = bar;`},
+			{Code: `var foo = function() { foo = bar; };`},
+			{Code: `import bar from 'bar'; function foo() { var foo = bar; }`},
+			{Code: `function foo() {} foo.bar = 1;`},
+			{Code: `function foo() {} bar = foo;`},
+			{Code: `var a = function foo() { var foo = 1; foo = 2; };`},
+			{Code: `function foo(): void; function foo(x?: number) {} const f = (foo: number) => { foo = 1; };`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `function foo() {}; foo = bar;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "isAFunction", Line: 1, Column: 20},
+				},
+			},
+			{
+				Code: `function foo() { foo = bar; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "isAFunction", Line: 1, Column: 18},
+				},
+			},
+			{
+				Code: `foo = bar; function foo() { };`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "isAFunction", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `[foo] = bar; function foo() { };`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "isAFunction", Line: 1, Column: 2},
+				},
+			},
+			{
+				Code: `({x: foo = 0} = bar); function foo() { };`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "isAFunction", Line: 1, Column: 6},
+				},
+			},
+			{
+				Code: `function foo() { [foo] = bar; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "isAFunction", Line: 1, Column: 19},
+				},
+			},
+			{
+				Code: `(function() { ({x: foo = 0} = bar); function foo() { }; })();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "isAFunction", Line: 1, Column: 20},
+				},
+			},
+			{
+				Code: `({foo} = bar); function foo() {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "isAFunction", Line: 1, Column: 3},
+				},
+			},
+			{
+				Code: `function foo() {} for (foo of arr);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "isAFunction", Line: 1, Column: 24},
+				},
+			},
+			{
+				Code: `var a = function foo() { foo = 123; };`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "isAFunction", Line: 1, Column: 26},
+				},
+			},
+			{
+				Code: `var a = function foo() { foo++; };`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "isAFunction", Line: 1, Column: 26},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,366 @@
+package no_implicit_coercion
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Options for no-implicit-coercion rule
+type Options struct {
+	Boolean                   bool     `json:"boolean"`
+	Number                    bool     `json:"number"`
+	String                    bool     `json:"string"`
+	DisallowTemplateShorthand bool     `json:"disallowTemplateShorthand"`
+	Allow                     []string `json:"allow"`
+}
+
+func parseOptions(options any) Options {
+	opts := Options{
+		Boolean: true,
+		Number:  true,
+		String:  true,
+	}
+
+	if options == nil {
+		return opts
+	}
+
+	// Parse options with dual-format support (handles both array and object formats)
+	var optsMap map[string]interface{}
+	var ok bool
+
+	// Handle array format: [{ option: value }]
+	if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+		optsMap, ok = optArray[0].(map[string]interface{})
+	} else {
+		// Handle direct object format: { option: value }
+		optsMap, ok = options.(map[string]interface{})
+	}
+
+	if ok {
+		if v, ok := optsMap["boolean"].(bool); ok {
+			opts.Boolean = v
+		}
+		if v, ok := optsMap["number"].(bool); ok {
+			opts.Number = v
+		}
+		if v, ok := optsMap["string"].(bool); ok {
+			opts.String = v
+		}
+		if v, ok := optsMap["disallowTemplateShorthand"].(bool); ok {
+			opts.DisallowTemplateShorthand = v
+		}
+		if allow, ok := optsMap["allow"].([]interface{}); ok {
+			for _, operator := range allow {
+				if s, ok := operator.(string); ok {
+					opts.Allow = append(opts.Allow, s)
+				}
+			}
+		}
+	}
+	return opts
+}
+
+// Message builders
+func buildImplicitCoercionMessage(recommendation string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "implicitCoercion",
+		Description: "Unexpected implicit coercion encountered. Use `" + recommendation + "` instead.",
+	}
+}
+
+func buildUseRecommendationMessage(recommendation string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "useRecommendation",
+		Description: "Use `" + recommendation + "` instead.",
+	}
+}
+
+func isPrefixUnaryWithOperator(node *ast.Node, operator ast.Kind) bool {
+	return node.Kind == ast.KindPrefixUnaryExpression && node.AsPrefixUnaryExpression().Operator == operator
+}
+
+func getNumericValue(node *ast.Node) (float64, bool) {
+	if node.Kind != ast.KindNumericLiteral {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(node.Text(), 64)
+	return n, err == nil
+}
+
+// isNumeric checks for number literals and `Number()`, `parseInt()` and `parseFloat()` calls
+func isNumeric(node *ast.Node) bool {
+	node = ast.SkipParentheses(node)
+	if node.Kind == ast.KindNumericLiteral {
+		return true
+	}
+	if node.Kind == ast.KindCallExpression {
+		callee := node.AsCallExpression().Expression
+		if callee.Kind == ast.KindIdentifier {
+			switch callee.Text() {
+			case "Number", "parseInt", "parseFloat":
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isOne(node *ast.Node) bool {
+	value, ok := getNumericValue(ast.SkipParentheses(node))
+	return ok && value == 1
+}
+
+func isZero(node *ast.Node) bool {
+	value, ok := getNumericValue(ast.SkipParentheses(node))
+	return ok && value == 0
+}
+
+// isStringType checks for string and template literals
+func isStringType(node *ast.Node) bool {
+	switch ast.SkipParentheses(node).Kind {
+	case ast.KindStringLiteral, ast.KindNoSubstitutionTemplateLiteral, ast.KindTemplateExpression:
+		return true
+	}
+	return false
+}
+
+// isEmptyString checks for empty string and template literals
+func isEmptyString(node *ast.Node) bool {
+	node = ast.SkipParentheses(node)
+	return (node.Kind == ast.KindStringLiteral || node.Kind == ast.KindNoSubstitutionTemplateLiteral) && node.Text() == ""
+}
+
+// isArithmeticBinary checks for binary expressions other than assignments, logical and comma expressions
+func isArithmeticBinary(node *ast.Node) bool {
+	if node.Kind != ast.KindBinaryExpression {
+		return false
+	}
+	operator := node.AsBinaryExpression().OperatorToken.Kind
+	return !ast.IsAssignmentOperator(operator) && !ast.IsLogicalOrCoalescingBinaryOperator(operator) && operator != ast.KindCommaToken
+}
+
+// isIndexOfCall checks for `foo.indexOf(bar)` and `foo.lastIndexOf(bar)`
+func isIndexOfCall(node *ast.Node) bool {
+	if node.Kind != ast.KindCallExpression {
+		return false
+	}
+	callee := ast.SkipParentheses(node.AsCallExpression().Expression)
+	var name string
+	switch callee.Kind {
+	case ast.KindPropertyAccessExpression:
+		name = callee.AsPropertyAccessExpression().Name().Text()
+	case ast.KindElementAccessExpression:
+		argument := callee.AsElementAccessExpression().ArgumentExpression
+		if argument.Kind != ast.KindStringLiteral && argument.Kind != ast.KindNoSubstitutionTemplateLiteral {
+			return false
+		}
+		name = argument.Text()
+	default:
+		return false
+	}
+	return name == "indexOf" || name == "lastIndexOf"
+}
+
+// isMultiplyByFractionOfOne checks for `foo * 1 / bar`, where `* 1` isn't a coercion
+func isMultiplyByFractionOfOne(node *ast.Node) bool {
+	if !isOne(node.AsBinaryExpression().Right) {
+		return false
+	}
+	parent := node.Parent
+	return parent.Kind == ast.KindBinaryExpression &&
+		parent.AsBinaryExpression().OperatorToken.Kind == ast.KindSlashToken &&
+		parent.AsBinaryExpression().Left == node
+}
+
+// getNonNumericOperand returns the operand of `foo * 1` which is being coerced
+func getNonNumericOperand(node *ast.Node) *ast.Node {
+	binary := node.AsBinaryExpression()
+	if right := ast.SkipParentheses(binary.Right); !isArithmeticBinary(right) && !isNumeric(right) {
+		return right
+	}
+	if left := ast.SkipParentheses(binary.Left); !isArithmeticBinary(left) && !isNumeric(left) {
+		return left
+	}
+	return nil
+}
+
+func isIdentifierPartChar(ch byte) bool {
+	return ch == '_' || ch == '$' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9')
+}
+
+// NoImplicitCoercionRule disallows shorthand type conversions
+var NoImplicitCoercionRule = rule.CreateRule(rule.Rule{
+	Name: "no-implicit-coercion",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+		text := ctx.SourceFile.Text()
+
+		isAllowed := func(operator string) bool {
+			return slices.Contains(opts.Allow, operator)
+		}
+
+		// getText returns the source of an operand without redundant parentheses
+		getText := func(node *ast.Node) string {
+			inner := ast.SkipParentheses(node)
+			if inner.Kind == ast.KindBinaryExpression && inner.AsBinaryExpression().OperatorToken.Kind == ast.KindCommaToken {
+				inner = node
+			}
+			nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, inner)
+			return text[nodeRange.Pos():nodeRange.End()]
+		}
+
+		isGlobalBooleanInScope := func(node *ast.Node) bool {
+			if ctx.TypeChecker == nil {
+				return true
+			}
+			for _, symbol := range ctx.TypeChecker.GetSymbolsInScope(node, ast.SymbolFlagsValue) {
+				if symbol.Name == "Boolean" {
+					return utils.IsSymbolFromDefaultLibrary(ctx.Program, symbol)
+				}
+			}
+			return true
+		}
+
+		// report reports node with an autofix when shouldFix is set, otherwise with a suggestion when shouldSuggest is set
+		report := func(node *ast.Node, recommendation string, shouldSuggest bool, shouldFix bool) {
+			message := buildImplicitCoercionMessage(recommendation)
+			if !shouldFix && !shouldSuggest {
+				ctx.ReportNode(node, message)
+				return
+			}
+
+			replacement := recommendation
+			nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			// typeof!!foo -> typeof Boolean(foo)
+			if nodeRange.Pos() > 0 && isIdentifierPartChar(text[nodeRange.Pos()-1]) && isIdentifierPartChar(replacement[0]) {
+				replacement = " " + replacement
+			}
+			fix := rule.RuleFixReplace(ctx.SourceFile, node, replacement)
+
+			if shouldFix {
+				ctx.ReportNodeWithFixes(node, message, fix)
+				return
+			}
+			ctx.ReportNodeWithSuggestions(node, message, rule.RuleSuggestion{
+				Message:  buildUseRecommendationMessage(recommendation),
+				FixesArr: []rule.RuleFix{fix},
+			})
+		}
+
+		return rule.RuleListeners{
+			ast.KindPrefixUnaryExpression: func(node *ast.Node) {
+				unary := node.AsPrefixUnaryExpression()
+				operand := ast.SkipParentheses(unary.Operand)
+
+				switch unary.Operator {
+				case ast.KindExclamationToken:
+					// !!foo
+					if !isAllowed("!!") && opts.Boolean && isPrefixUnaryWithOperator(operand, ast.KindExclamationToken) {
+						recommendation := "Boolean(" + getText(operand.AsPrefixUnaryExpression().Operand) + ")"
+						report(node, recommendation, true, isGlobalBooleanInScope(node))
+					}
+
+				case ast.KindTildeToken:
+					// ~foo.indexOf(bar)
+					if !isAllowed("~") && opts.Boolean && isIndexOfCall(operand) {
+						// `foo?.indexOf(bar) !== -1` is true when `foo` is nullish, so `>= 0` is used for optional chains
+						comparison := " !== -1"
+						if ast.IsOptionalChain(operand) {
+							comparison = " >= 0"
+						}
+						report(node, getText(operand)+comparison, false, false)
+						return
+					}
+					// ~~foo
+					if !isAllowed("~") && opts.Number && isPrefixUnaryWithOperator(operand, ast.KindTildeToken) &&
+						!isNumeric(operand.AsPrefixUnaryExpression().Operand) {
+						recommendation := "Math.trunc(" + getText(operand.AsPrefixUnaryExpression().Operand) + ")"
+						report(node, recommendation, true, false)
+					}
+
+				case ast.KindPlusToken:
+					// +foo
+					if !isAllowed("+") && opts.Number && !isNumeric(operand) {
+						report(node, "Number("+getText(operand)+")", true, false)
+					}
+
+				case ast.KindMinusToken:
+					// -(-foo)
+					if !isAllowed("- -") && opts.Number && isPrefixUnaryWithOperator(operand, ast.KindMinusToken) &&
+						!isNumeric(operand.AsPrefixUnaryExpression().Operand) {
+						recommendation := "Number(" + getText(operand.AsPrefixUnaryExpression().Operand) + ")"
+						report(node, recommendation, true, false)
+					}
+				}
+			},
+
+			ast.KindBinaryExpression: func(node *ast.Node) {
+				binary := node.AsBinaryExpression()
+
+				switch binary.OperatorToken.Kind {
+				case ast.KindAsteriskToken:
+					// 1 * foo
+					if !isAllowed("*") && opts.Number && (isOne(binary.Left) || isOne(binary.Right)) && !isMultiplyByFractionOfOne(node) {
+						if operand := getNonNumericOperand(node); operand != nil {
+							report(node, "Number("+getText(operand)+")", true, false)
+						}
+					}
+
+				case ast.KindMinusToken:
+					// foo - 0
+					if !isAllowed("-") && opts.Number && isZero(binary.Right) && !isNumeric(binary.Left) {
+						report(node, "Number("+getText(binary.Left)+")", true, false)
+					}
+
+				case ast.KindPlusToken:
+					// "" + foo
+					if !isAllowed("+") && opts.String {
+						if isEmptyString(binary.Left) && !isStringType(binary.Right) {
+							report(node, "String("+getText(binary.Right)+")", true, false)
+						} else if isEmptyString(binary.Right) && !isStringType(binary.Left) {
+							report(node, "String("+getText(binary.Left)+")", true, false)
+						}
+					}
+
+				case ast.KindPlusEqualsToken:
+					// foo += ""
+					if !isAllowed("+") && opts.String && isEmptyString(binary.Right) {
+						code := getText(binary.Left)
+						report(node, code+" = String("+code+")", true, false)
+					}
+				}
+			},
+
+			ast.KindTemplateExpression: func(node *ast.Node) {
+				if !opts.DisallowTemplateShorthand {
+					return
+				}
+
+				// tag`${foo}`
+				if node.Parent.Kind == ast.KindTaggedTemplateExpression {
+					return
+				}
+
+				// `${foo}${bar}`, `prefix${foo}` and `${foo}postfix`
+				template := node.AsTemplateExpression()
+				spans := template.TemplateSpans.Nodes
+				if len(spans) != 1 || template.Head.Text() != "" || spans[0].AsTemplateSpan().Literal.Text() != "" {
+					return
+				}
+
+				// if the expression is already a string, then this isn't a coercion
+				expression := spans[0].AsTemplateSpan().Expression
+				if isStringType(expression) {
+					return
+				}
+
+				report(node, "String("+getText(expression)+")", true, false)
+			},
+		}
+	},
+})
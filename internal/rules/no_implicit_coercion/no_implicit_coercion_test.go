@@ -0,0 +1,361 @@
+package no_implicit_coercion
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoImplicitCoercionRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoImplicitCoercionRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `Boolean(foo)`},
+			{Code: `foo.indexOf(1) !== -1`},
+			{Code: `Number(foo)`},
+			{Code: `parseInt(foo)`},
+			{Code: `parseFloat(foo)`},
+			{Code: `String(foo)`},
+			{Code: `!foo`},
+			{Code: `~foo`},
+			{Code: `-foo`},
+			{Code: `+1234`},
+			{Code: `-1234`},
+			{Code: `- -1234`},
+			{Code: `+Number(lol)`},
+			{Code: `-parseFloat(lol)`},
+			{Code: `2 * foo`},
+			{Code: `1 * 1234`},
+			{Code: `123 - 0`},
+			{Code: `0 - foo`},
+			{Code: `1 * Number(foo)`},
+			{Code: `1 * parseInt(foo)`},
+			{Code: `Number(foo) * 1`},
+			{Code: `1 * 1234 * 678 * Number(foo)`},
+			{Code: `foo * 1 / 2`},
+			{Code: `foo + 'bar'`},
+			{Code: `'' + 'foo'`},
+			{Code: "'' + `${foo}`"},
+			{Code: `foo += 'bar'`},
+			{Code: "`${foo}`"},
+
+			// disallowTemplateShorthand
+			{Code: "`a${foo}`", Options: map[string]interface{}{"disallowTemplateShorthand": true}},
+			{Code: "`${foo}b`", Options: map[string]interface{}{"disallowTemplateShorthand": true}},
+			{Code: "`${foo}${bar}`", Options: map[string]interface{}{"disallowTemplateShorthand": true}},
+			{Code: "tag`${foo}`", Options: map[string]interface{}{"disallowTemplateShorthand": true}},
+			{Code: "`${'foo'}`", Options: map[string]interface{}{"disallowTemplateShorthand": true}},
+			{Code: "`${`foo`}`", Options: map[string]interface{}{"disallowTemplateShorthand": true}},
+
+			// Options
+			{Code: `!!foo`, Options: map[string]interface{}{"boolean": false}},
+			{Code: `~foo.indexOf(1)`, Options: map[string]interface{}{"boolean": false}},
+			{Code: `+foo`, Options: map[string]interface{}{"number": false}},
+			{Code: `1 * foo`, Options: map[string]interface{}{"number": false}},
+			{Code: `~~foo`, Options: map[string]interface{}{"number": false}},
+			{Code: `"" + foo`, Options: map[string]interface{}{"string": false}},
+			{Code: `foo += ""`, Options: map[string]interface{}{"string": false}},
+			{Code: `!!foo`, Options: map[string]interface{}{"allow": []interface{}{"!!"}}},
+			{Code: `~foo.indexOf(1)`, Options: map[string]interface{}{"allow": []interface{}{"~"}}},
+			{Code: `~~foo`, Options: []interface{}{map[string]interface{}{"allow": []interface{}{"~"}}}},
+			{Code: `+foo`, Options: map[string]interface{}{"allow": []interface{}{"+"}}},
+			{Code: `- -foo`, Options: map[string]interface{}{"allow": []interface{}{"- -"}}},
+			{Code: `foo - 0`, Options: map[string]interface{}{"allow": []interface{}{"-"}}},
+			{Code: `1 * foo`, Options: map[string]interface{}{"allow": []interface{}{"*"}}},
+			{Code: `"" + foo`, Options: map[string]interface{}{"allow": []interface{}{"+"}}},
+			{Code: `foo += ""`, Options: map[string]interface{}{"allow": []interface{}{"+"}}},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			// boolean
+			{
+				Code:   `!!foo`,
+				Output: []string{`Boolean(foo)`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "implicitCoercion", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `!!(foo + bar)`,
+				Output: []string{`Boolean(foo + bar)`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "implicitCoercion", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `!!(foo, bar)`,
+				Output: []string{`Boolean((foo, bar))`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "implicitCoercion", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `x = typeof!!foo`,
+				Output: []string{`x = typeof Boolean(foo)`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "implicitCoercion", Line: 1, Column: 11},
+				},
+			},
+			{
+				Code: `function f(Boolean: any) { return !!foo; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 35,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `function f(Boolean: any) { return Boolean(foo); }`},
+						},
+					},
+				},
+			},
+			{
+				Code: `~foo.indexOf(1)`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "implicitCoercion", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `~foo.bar.lastIndexOf(2)`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "implicitCoercion", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `~foo?.indexOf(1)`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "implicitCoercion", Line: 1, Column: 1},
+				},
+			},
+
+			// number
+			{
+				Code: `+foo`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `Number(foo)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `+foo.bar`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `Number(foo.bar)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `-(-foo)`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `Number(foo)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `- -foo`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `Number(foo)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `~~foo`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `Math.trunc(foo)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `1 * foo`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `Number(foo)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `foo * 1`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `Number(foo)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `1.0 * foo.bar`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `Number(foo.bar)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `(foo * 1) / 2`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 2,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `(Number(foo)) / 2`},
+						},
+					},
+				},
+			},
+			{
+				Code: `foo * 1 + 2`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `Number(foo) + 2`},
+						},
+					},
+				},
+			},
+			{
+				Code: `foo - 0`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `Number(foo)`},
+						},
+					},
+				},
+			},
+
+			// string
+			{
+				Code: `"" + foo`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `String(foo)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `foo + ''`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `String(foo)`},
+						},
+					},
+				},
+			},
+			{
+				Code: "``+foo",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `String(foo)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `"" + (foo + bar)`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `String(foo + bar)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `foo += ""`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `foo = String(foo)`},
+						},
+					},
+				},
+			},
+			{
+				Code:    "`${foo}`",
+				Options: map[string]interface{}{"disallowTemplateShorthand": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `String(foo)`},
+						},
+					},
+				},
+			},
+			{
+				Code:    "x = `${foo.bar}`",
+				Options: map[string]interface{}{"disallowTemplateShorthand": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 5,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `x = String(foo.bar)`},
+						},
+					},
+				},
+			},
+
+			// allow only exempts the listed operators
+			{
+				Code:    `+foo`,
+				Options: map[string]interface{}{"allow": []interface{}{"!!", "~"}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "implicitCoercion", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "useRecommendation", Output: `Number(foo)`},
+						},
+					},
+				},
+			},
+			{
+				Code:    `!!foo`,
+				Output:  []string{`Boolean(foo)`},
+				Options: map[string]interface{}{"allow": []interface{}{"+"}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "implicitCoercion", Line: 1, Column: 1},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,203 @@
+package no_import_assign
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// Message builders
+func buildReadonlyMessage(name string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "readonly",
+		Description: "'" + name + "' is read-only.",
+	}
+}
+
+func buildReadonlyMemberMessage(name string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "readonlyMember",
+		Description: "The members of '" + name + "' are read-only.",
+	}
+}
+
+// wellKnownMutationFunctions lists the functions that modify the object passed as their first argument
+var wellKnownMutationFunctions = map[string]map[string]bool{
+	"Object": {
+		"assign":           true,
+		"defineProperty":   true,
+		"defineProperties": true,
+		"freeze":           true,
+		"setPrototypeOf":   true,
+	},
+	"Reflect": {
+		"defineProperty": true,
+		"deleteProperty": true,
+		"set":            true,
+		"setPrototypeOf": true,
+	},
+}
+
+type importBinding struct {
+	symbol    *ast.Symbol
+	target    *ast.Symbol
+	namespace bool
+}
+
+// skipParenthesesUp returns the outermost parenthesized expression wrapping node
+func skipParenthesesUp(node *ast.Node) *ast.Node {
+	for node.Parent != nil && node.Parent.Kind == ast.KindParenthesizedExpression {
+		node = node.Parent
+	}
+	return node
+}
+
+// isMutationFunctionArgument checks for `Object.assign(ns, ...)` and the like
+func isMutationFunctionArgument(node *ast.Node) bool {
+	node = skipParenthesesUp(node)
+	call := node.Parent
+	if call == nil || call.Kind != ast.KindCallExpression {
+		return false
+	}
+	args := call.Arguments()
+	if len(args) == 0 || args[0] != node {
+		return false
+	}
+	callee := ast.SkipParentheses(call.Expression())
+	if callee.Kind != ast.KindPropertyAccessExpression {
+		return false
+	}
+	object := ast.SkipParentheses(callee.Expression())
+	if object.Kind != ast.KindIdentifier {
+		return false
+	}
+	functions, ok := wellKnownMutationFunctions[object.Text()]
+	return ok && functions[callee.AsPropertyAccessExpression().Name().Text()]
+}
+
+// isMemberWrite checks for a write to a member of node, e.g. `ns.prop = 1` or `delete ns.prop`
+func isMemberWrite(node *ast.Node) bool {
+	outer := skipParenthesesUp(node)
+	member := outer.Parent
+	if member != nil &&
+		(member.Kind == ast.KindPropertyAccessExpression || member.Kind == ast.KindElementAccessExpression) &&
+		member.Expression() == outer {
+		if ast.IsAssignmentTarget(member) {
+			return true
+		}
+		if parent := skipParenthesesUp(member).Parent; parent != nil && parent.Kind == ast.KindDeleteExpression {
+			return true
+		}
+	}
+	return isMutationFunctionArgument(node)
+}
+
+// getWriteNode returns the expression or statement that performs the write
+func getWriteNode(identifier *ast.Node) *ast.Node {
+	for node := identifier.Parent; node != nil; node = node.Parent {
+		switch node.Kind {
+		case ast.KindBinaryExpression:
+			if ast.IsAssignmentOperator(node.AsBinaryExpression().OperatorToken.Kind) {
+				return node
+			}
+		case ast.KindPrefixUnaryExpression,
+			ast.KindPostfixUnaryExpression,
+			ast.KindDeleteExpression,
+			ast.KindCallExpression,
+			ast.KindForInStatement,
+			ast.KindForOfStatement:
+			return node
+		}
+	}
+	return identifier
+}
+
+// NoImportAssignRule disallows assigning to imported bindings
+var NoImportAssignRule = rule.CreateRule(rule.Rule{
+	Name: "no-import-assign",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		bindings := make(map[string]importBinding)
+		addBinding := func(name *ast.Node, namespace bool) {
+			binding := importBinding{namespace: namespace}
+			if ctx.TypeChecker != nil {
+				binding.symbol = ctx.TypeChecker.GetSymbolAtLocation(name)
+				if binding.symbol != nil {
+					if target := checker.SkipAlias(binding.symbol, ctx.TypeChecker); target != binding.symbol {
+						binding.target = target
+					}
+				}
+			}
+			bindings[name.Text()] = binding
+		}
+
+		for _, statement := range ctx.SourceFile.Statements.Nodes {
+			if statement.Kind != ast.KindImportDeclaration {
+				continue
+			}
+			clauseNode := statement.AsImportDeclaration().ImportClause
+			if clauseNode == nil {
+				continue
+			}
+			clause := clauseNode.AsImportClause()
+			if clause.IsTypeOnly {
+				continue
+			}
+			if name := clauseNode.Name(); name != nil {
+				addBinding(name, false)
+			}
+			if clause.NamedBindings == nil {
+				continue
+			}
+			if clause.NamedBindings.Kind == ast.KindNamespaceImport {
+				addBinding(clause.NamedBindings.Name(), true)
+				continue
+			}
+			for _, specifier := range clause.NamedBindings.AsNamedImports().Elements.Nodes {
+				if !specifier.AsImportSpecifier().IsTypeOnly {
+					addBinding(specifier.Name(), false)
+				}
+			}
+		}
+		if len(bindings) == 0 {
+			return rule.RuleListeners{}
+		}
+
+		// refersTo checks that identifier resolves to the import rather than a shadowing variable
+		refersTo := func(identifier *ast.Node, binding importBinding) bool {
+			if binding.symbol == nil {
+				return true
+			}
+			var symbol *ast.Symbol
+			parent := identifier.Parent
+			if parent.Kind == ast.KindShorthandPropertyAssignment && parent.Name() == identifier {
+				symbol = ctx.TypeChecker.GetShorthandAssignmentValueSymbol(parent)
+			} else {
+				symbol = ctx.TypeChecker.GetSymbolAtLocation(identifier)
+			}
+			return symbol != nil && (symbol == binding.symbol || symbol == binding.target)
+		}
+
+		return rule.RuleListeners{
+			ast.KindIdentifier: func(node *ast.Node) {
+				binding, ok := bindings[node.Text()]
+				if !ok {
+					return
+				}
+				switch node.Parent.Kind {
+				case ast.KindImportClause, ast.KindImportSpecifier, ast.KindNamespaceImport:
+					return
+				}
+
+				if ast.IsAssignmentTarget(node) {
+					if refersTo(node, binding) {
+						ctx.ReportNode(getWriteNode(node), buildReadonlyMessage(node.Text()))
+					}
+				} else if binding.namespace && isMemberWrite(node) {
+					if refersTo(node, binding) {
+						ctx.ReportNode(getWriteNode(node), buildReadonlyMemberMessage(node.Text()))
+					}
+				}
+			},
+		}
+	},
+})
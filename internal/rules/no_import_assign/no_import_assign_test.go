@@ -0,0 +1,184 @@
+package no_import_assign
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoImportAssignRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoImportAssignRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `import mod from 'mod'; mod.prop = 0;`},
+			{Code: `import mod from 'mod'; mod.prop++;`},
+			{Code: `import mod from 'mod'; delete mod.prop;`},
+			{Code: `import mod from 'mod'; Object.assign(mod, obj);`},
+			{Code: `import {named} from 'mod'; named.prop = 0;`},
+			{Code: `import {named} from 'mod'; Object.assign(named, obj);`},
+			{Code: `import * as mod from 'mod'; mod.named.prop = 0;`},
+			{Code: `import * as mod from 'mod'; mod.named.prop++;`},
+			{Code: `import * as mod from 'mod'; delete mod.named.prop;`},
+			{Code: `import * as mod from 'mod'; obj[mod] = 0;`},
+			{Code: `import * as mod from 'mod'; obj.prop = mod;`},
+			{Code: `import * as mod from 'mod'; mod.named();`},
+			{Code: `import * as mod from 'mod'; for (const x in mod);`},
+			{Code: `import * as mod from 'mod'; Object.assign(obj, mod);`},
+			{Code: `import * as mod from 'mod'; Object.keys(mod);`},
+			{Code: `import * as mod from 'mod'; Object.assign(mod.named, obj);`},
+			{Code: `import * as mod from 'mod'; Reflect.get(mod, 'named');`},
+
+			// Shadowed bindings
+			{Code: `import mod from 'mod'; function f(mod: any) { mod = 0; }`},
+			{Code: `import mod from 'mod'; { let mod = 0; mod = 1; }`},
+			{Code: `import {named} from 'mod'; const f = (named: number) => named++;`},
+			{Code: `import * as mod from 'mod'; function f(mod: any) { mod.named = 0; }`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			// Default imports
+			{
+				Code: `import mod1 from 'mod'; mod1 = 0;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonly", Line: 1, Column: 25},
+				},
+			},
+			{
+				Code: `import mod2 from 'mod'; mod2 += 0;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonly", Line: 1, Column: 25},
+				},
+			},
+			{
+				Code: `import mod3 from 'mod'; mod3++;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonly", Line: 1, Column: 25},
+				},
+			},
+			{
+				Code: `import mod4 from 'mod'; [mod4] = arr;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonly", Line: 1, Column: 25},
+				},
+			},
+			{
+				Code: `import mod5 from 'mod'; ({ mod5 } = obj);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonly", Line: 1, Column: 26},
+				},
+			},
+			{
+				Code: `import mod6 from 'mod'; for (mod6 of arr);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonly", Line: 1, Column: 25},
+				},
+			},
+			{
+				Code: `import mod7 from 'mod'; function f() { mod7 = 0; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonly", Line: 1, Column: 40},
+				},
+			},
+
+			// Named imports
+			{
+				Code: `import {named1} from 'mod'; named1 = 0;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonly", Line: 1, Column: 29},
+				},
+			},
+			{
+				Code: `import {named2 as alias} from 'mod'; alias = 0;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonly", Line: 1, Column: 38},
+				},
+			},
+			{
+				Code: `import mod, {named3} from 'mod'; named3 = mod = 0;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonly", Line: 1, Column: 34},
+					{MessageId: "readonly", Line: 1, Column: 43},
+				},
+			},
+
+			// Namespace imports
+			{
+				Code: `import * as mod1 from 'mod'; mod1 = 0;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonly", Line: 1, Column: 30},
+				},
+			},
+			{
+				Code: `import * as mod2 from 'mod'; mod2.named = 0;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonlyMember", Line: 1, Column: 30},
+				},
+			},
+			{
+				Code: `import * as mod3 from 'mod'; mod3['named'] = 0;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonlyMember", Line: 1, Column: 30},
+				},
+			},
+			{
+				Code: `import * as mod4 from 'mod'; mod4.named++;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonlyMember", Line: 1, Column: 30},
+				},
+			},
+			{
+				Code: `import * as mod5 from 'mod'; delete mod5.named;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonlyMember", Line: 1, Column: 30},
+				},
+			},
+			{
+				Code: `import * as mod6 from 'mod'; [mod6.named] = arr;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonlyMember", Line: 1, Column: 30},
+				},
+			},
+			{
+				Code: `import * as mod7 from 'mod'; ({ x: mod7.named } = obj);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonlyMember", Line: 1, Column: 31},
+				},
+			},
+			{
+				Code: `import * as mod8 from 'mod'; for (mod8.named in obj);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonlyMember", Line: 1, Column: 30},
+				},
+			},
+			{
+				Code: `import * as mod9 from 'mod'; (mod9).named = 0;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonlyMember", Line: 1, Column: 30},
+				},
+			},
+			{
+				Code: `import * as mod10 from 'mod'; Object.assign(mod10, obj);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonlyMember", Line: 1, Column: 31},
+				},
+			},
+			{
+				Code: `import * as mod11 from 'mod'; Object.defineProperty(mod11, 'named', {});`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonlyMember", Line: 1, Column: 31},
+				},
+			},
+			{
+				Code: `import * as mod12 from 'mod'; Reflect.set(mod12, 'named', 0);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "readonlyMember", Line: 1, Column: 31},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,87 @@
+package no_lonely_if
+
+import (
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builder
+func buildUnexpectedLonelyIfMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpectedLonelyIf",
+		Description: "Unexpected if as the only statement in an else block.",
+	}
+}
+
+// NoLonelyIfRule disallows `if` statements as the only statement in `else` blocks
+var NoLonelyIfRule = rule.CreateRule(rule.Rule{
+	Name: "no-lonely-if",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		text := ctx.SourceFile.Text()
+
+		// canFix checks that merging the else block wouldn't drop comments or change semantics
+		canFix := func(node *ast.Node, block *ast.Node) bool {
+			blockRange := utils.TrimNodeTextRange(ctx.SourceFile, block)
+			nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+
+			// Don't fix if there are any non-whitespace characters interfering (e.g. comments)
+			if strings.TrimSpace(text[blockRange.Pos()+1:nodeRange.Pos()]) != "" || strings.TrimSpace(text[nodeRange.End():blockRange.End()-1]) != "" {
+				return false
+			}
+
+			// If the `if` statement has no block and is not followed by a semicolon, make sure
+			// that fixing the issue would not change semantics due to ASI
+			consequent := node.AsIfStatement().ThenStatement
+			if consequent.Kind == ast.KindBlock {
+				return true
+			}
+			consequentText := strings.TrimRight(text[:consequent.End()], " \t\r\n")
+			if strings.HasSuffix(consequentText, ";") {
+				return true
+			}
+			tokenAfter := scanner.GetRangeOfTokenAtPosition(ctx.SourceFile, block.End())
+			if tokenAfter.Pos() >= len(text) {
+				return true
+			}
+			consequentEndLine, _ := scanner.GetLineAndCharacterOfPosition(ctx.SourceFile, consequent.End())
+			tokenAfterLine, _ := scanner.GetLineAndCharacterOfPosition(ctx.SourceFile, tokenAfter.Pos())
+			if consequentEndLine == tokenAfterLine ||
+				strings.ContainsRune("([/+`-", rune(text[tokenAfter.Pos()])) ||
+				strings.HasSuffix(consequentText, "++") || strings.HasSuffix(consequentText, "--") {
+				return false
+			}
+			return true
+		}
+
+		return rule.RuleListeners{
+			ast.KindIfStatement: func(node *ast.Node) {
+				block := node.Parent
+				if block == nil || block.Kind != ast.KindBlock || len(block.AsBlock().Statements.Nodes) != 1 {
+					return
+				}
+				grandparent := block.Parent
+				if grandparent == nil || grandparent.Kind != ast.KindIfStatement || grandparent.AsIfStatement().ElseStatement != block {
+					return
+				}
+
+				if !canFix(node, block) {
+					ctx.ReportNode(node, buildUnexpectedLonelyIfMessage())
+					return
+				}
+
+				blockRange := utils.TrimNodeTextRange(ctx.SourceFile, block)
+				nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+				replacement := text[nodeRange.Pos():nodeRange.End()]
+				// `else{` needs a space once the brace is gone
+				if blockRange.Pos() > 0 && text[blockRange.Pos()-1] == 'e' {
+					replacement = " " + replacement
+				}
+				ctx.ReportNodeWithFixes(node, buildUnexpectedLonelyIfMessage(), rule.RuleFixReplaceRange(blockRange, replacement))
+			},
+		}
+	},
+})
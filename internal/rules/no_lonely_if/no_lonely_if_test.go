@@ -0,0 +1,103 @@
+package no_lonely_if
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoLonelyIfRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoLonelyIfRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `if (a) {;} else if (b) {;}`},
+			{Code: `if (a) {;} else { if (b) {;} ; }`},
+			{Code: `if (a) if (a) {} else { if (b) {} } else {}`},
+			{Code: `if (a) {;} else { if (b) {;} else {;} foo(); }`},
+			{Code: `if (a) {;} else { foo(); if (b) {;} }`},
+			{Code: `if (a) {;} else { { if (b) {;} } }`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:   "if (a) {\n;\n} else {\nif (b) {\n;\n}\n}",
+				Output: []string{"if (a) {\n;\n} else if (b) {\n;\n}"},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedLonelyIf", Line: 4, Column: 1}},
+			},
+			{
+				Code:   "if (a) {\n  foo();\n} else {\n  if (b) {\n    bar();\n  }\n}",
+				Output: []string{"if (a) {\n  foo();\n} else if (b) {\n    bar();\n  }"},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedLonelyIf", Line: 4, Column: 3}},
+			},
+			{
+				Code:   "if (a) {\n  foo();\n} else {\n  if (b) {\n    bar();\n  } else if (c) {\n    baz();\n  } else {\n    qux();\n  }\n}",
+				Output: []string{"if (a) {\n  foo();\n} else if (b) {\n    bar();\n  } else if (c) {\n    baz();\n  } else {\n    qux();\n  }"},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedLonelyIf", Line: 4, Column: 3}},
+			},
+			{
+				Code:   "if (a) {} else{if (b) {}}",
+				Output: []string{"if (a) {} else if (b) {}"},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedLonelyIf", Line: 1, Column: 16}},
+			},
+			{
+				Code:   "if (a) {} else { if (b) foo(); }",
+				Output: []string{"if (a) {} else if (b) foo();"},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedLonelyIf", Line: 1, Column: 18}},
+			},
+			{
+				Code:   "if (a) {} else { if (b) foo() }\nbar();",
+				Output: []string{"if (a) {} else if (b) foo()\nbar();"},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedLonelyIf", Line: 1, Column: 18}},
+			},
+			{
+				Code:   "if (a) {} else { if (b) {} }\n[1, 2].forEach(foo);",
+				Output: []string{"if (a) {} else if (b) {}\n[1, 2].forEach(foo);"},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedLonelyIf", Line: 1, Column: 18}},
+			},
+
+			// Nested lonely ifs are fixed one at a time
+			{
+				Code:   "if (a) {} else { if (b) {} else { if (c) {} } }",
+				Output: []string{"if (a) {} else if (b) {} else { if (c) {} }", "if (a) {} else if (b) {} else if (c) {}"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedLonelyIf", Line: 1, Column: 18},
+					{MessageId: "unexpectedLonelyIf", Line: 1, Column: 35},
+				},
+			},
+
+			// Comments would be lost
+			{
+				Code:   "if (a) {} else {\n  // comment\n  if (b) {}\n}",
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedLonelyIf", Line: 3, Column: 3}},
+			},
+			{
+				Code:   "if (a) {} else {\n  if (b) {}\n  // comment\n}",
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedLonelyIf", Line: 2, Column: 3}},
+			},
+			{
+				Code:   "if (a) {} else {\n  if (b) {\n    // comment\n  }\n}",
+				Output: []string{"if (a) {} else if (b) {\n    // comment\n  }"},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedLonelyIf", Line: 2, Column: 3}},
+			},
+
+			// Fixing would change semantics due to ASI
+			{
+				Code:   "if (a) {} else { if (b) foo() }\n(bar)",
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedLonelyIf", Line: 1, Column: 18}},
+			},
+			{
+				Code:   "if (a) {} else { if (b) foo() } bar()",
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedLonelyIf", Line: 1, Column: 18}},
+			},
+			{
+				Code:   "if (a) {} else { if (b) foo++ }\nbar()",
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedLonelyIf", Line: 1, Column: 18}},
+			},
+		},
+	)
+}
@@ -0,0 +1,160 @@
+package no_mixed_operators
+
+import (
+	"slices"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+type NoMixedOperatorsOptions struct {
+	Groups              [][]string `json:"groups"`
+	AllowSamePrecedence bool       `json:"allowSamePrecedence"`
+}
+
+// Message builders
+func buildUnexpectedMixedOperatorMessage(leftOperator string, rightOperator string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpectedMixedOperator",
+		Description: "Unexpected mix of '" + leftOperator + "' and '" + rightOperator + "'. Use parentheses to clarify the intended order of operations.",
+	}
+}
+
+func buildAddParenthesesMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "addParentheses",
+		Description: "Add parentheses around the nested expression.",
+	}
+}
+
+const ternaryOperator = "?:"
+
+var (
+	arithmeticOperators = []string{"+", "-", "*", "/", "%", "**"}
+	bitwiseOperators    = []string{"&", "|", "^", "~", "<<", ">>", ">>>"}
+	comparisonOperators = []string{"==", "!=", "===", "!==", ">", ">=", "<", "<="}
+	logicalOperators    = []string{"&&", "||"}
+	relationalOperators = []string{"in", "instanceof"}
+	coalesceOperators   = []string{"??"}
+
+	allOperators = slices.Concat(arithmeticOperators, bitwiseOperators, comparisonOperators,
+		logicalOperators, relationalOperators, []string{ternaryOperator}, coalesceOperators)
+	defaultGroups = [][]string{arithmeticOperators, bitwiseOperators, comparisonOperators, logicalOperators, relationalOperators}
+)
+
+// getOperator returns the operator of a binary or conditional expression, or "" for any other node
+func getOperator(node *ast.Node) string {
+	switch node.Kind {
+	case ast.KindBinaryExpression:
+		operator := scanner.TokenToString(node.AsBinaryExpression().OperatorToken.Kind)
+		// Assignments and the comma operator are not mixed operators
+		if slices.Contains(allOperators, operator) {
+			return operator
+		}
+	case ast.KindConditionalExpression:
+		return ternaryOperator
+	}
+	return ""
+}
+
+// getOperatorToken returns the operator token of a binary expression, or `?` of a conditional expression
+func getOperatorToken(node *ast.Node) *ast.Node {
+	if node.Kind == ast.KindConditionalExpression {
+		return node.AsConditionalExpression().QuestionToken
+	}
+	return node.AsBinaryExpression().OperatorToken
+}
+
+// isLeftOperand checks whether node is evaluated before the operator of parent
+func isLeftOperand(node *ast.Node, parent *ast.Node) bool {
+	if parent.Kind == ast.KindConditionalExpression {
+		return parent.AsConditionalExpression().Condition == node
+	}
+	return parent.AsBinaryExpression().Left == node
+}
+
+// NoMixedOperatorsRule disallows mixed binary operators without parentheses
+var NoMixedOperatorsRule = rule.CreateRule(rule.Rule{
+	Name: "no-mixed-operators",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := NoMixedOperatorsOptions{
+			Groups:              defaultGroups,
+			AllowSamePrecedence: true,
+		}
+
+		// Parse options with dual-format support (handles both array and object formats)
+		var optsMap map[string]interface{}
+		if options != nil {
+			switch v := options.(type) {
+			case []interface{}:
+				if len(v) > 0 {
+					optsMap, _ = v[0].(map[string]interface{})
+				}
+			case map[string]interface{}:
+				optsMap = v
+			}
+		}
+		if optsMap != nil {
+			if groups, ok := optsMap["groups"].([]interface{}); ok && len(groups) > 0 {
+				opts.Groups = nil
+				for _, group := range groups {
+					items, ok := group.([]interface{})
+					if !ok {
+						continue
+					}
+					var operators []string
+					for _, item := range items {
+						if operator, ok := item.(string); ok {
+							operators = append(operators, operator)
+						}
+					}
+					opts.Groups = append(opts.Groups, operators)
+				}
+			}
+			if allowSamePrecedence, ok := optsMap["allowSamePrecedence"].(bool); ok {
+				opts.AllowSamePrecedence = allowSamePrecedence
+			}
+		}
+
+		includesBothInAGroup := func(left string, right string) bool {
+			return utils.Some(opts.Groups, func(group []string) bool {
+				return slices.Contains(group, left) && slices.Contains(group, right)
+			})
+		}
+
+		return rule.RuleListeners{
+			ast.KindBinaryExpression: func(node *ast.Node) {
+				// Parenthesized operands have a ParenthesizedExpression parent, so they are never mixed
+				parent := node.Parent
+				operator := getOperator(node)
+				parentOperator := getOperator(parent)
+				if operator == "" || parentOperator == "" || operator == parentOperator {
+					return
+				}
+				if !includesBothInAGroup(operator, parentOperator) {
+					return
+				}
+				if opts.AllowSamePrecedence && ast.GetExpressionPrecedence(node) == ast.GetExpressionPrecedence(parent) {
+					return
+				}
+
+				left, right := parent, node
+				if isLeftOperand(node, parent) {
+					left, right = node, parent
+				}
+				msg := buildUnexpectedMixedOperatorMessage(getOperator(left), getOperator(right))
+				suggestion := rule.RuleSuggestion{
+					Message: buildAddParenthesesMessage(),
+					FixesArr: []rule.RuleFix{
+						rule.RuleFixInsertBefore(ctx.SourceFile, node, "("),
+						rule.RuleFixInsertAfter(node, ")"),
+					},
+				}
+				ctx.ReportRangeWithSuggestions(utils.TrimNodeTextRange(ctx.SourceFile, getOperatorToken(left)), msg, suggestion)
+				ctx.ReportRangeWithSuggestions(utils.TrimNodeTextRange(ctx.SourceFile, getOperatorToken(right)), msg, suggestion)
+			},
+		}
+	},
+})
@@ -0,0 +1,233 @@
+package no_mixed_operators
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoMixedOperatorsRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoMixedOperatorsRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `a && b && c && d`},
+			{Code: `a || b || c || d`},
+			{Code: `(a || b) && c && d`},
+			{Code: `a || (b && c && d)`},
+			{Code: `(a || b || c) && d`},
+			{Code: `a || b || (c && d)`},
+			{Code: `a + b + c + d`},
+			{Code: `a * b * c * d`},
+			{Code: `a == 0 && b == 1`},
+			{Code: `a == 0 || b == 1`},
+			{Code: `a + (b * c)`},
+			{Code: `a, b && c || d`, Options: map[string]interface{}{"groups": []interface{}{[]interface{}{"&&", "=="}}}},
+			{
+				Code:    `(a == 0) && (b == 1)`,
+				Options: map[string]interface{}{"groups": []interface{}{[]interface{}{"&&", "=="}}},
+			},
+			{
+				Code:    `a + b - c * d / e`,
+				Options: map[string]interface{}{"groups": []interface{}{[]interface{}{"&&", "||"}}},
+			},
+			{Code: `a + b - c`},
+			{Code: `a * b / c`},
+			{Code: `a + b - c`, Options: map[string]interface{}{"allowSamePrecedence": true}},
+			{Code: `a * b / c`, Options: []interface{}{map[string]interface{}{"allowSamePrecedence": true}}},
+			{Code: `a || b ? c : d`},
+			{Code: `a ? b || c : d`},
+			{Code: `a ? b : c || d`},
+			{
+				Code:    `(a || b) ? c : d`,
+				Options: map[string]interface{}{"groups": []interface{}{[]interface{}{"||", "?:"}}},
+			},
+			{Code: `a ?? b ?? c`},
+			{Code: `(a || b) ?? c`},
+			{Code: `a in b && c`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `a && b || c`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 3,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `(a && b) || c`},
+						},
+					},
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 8,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `(a && b) || c`},
+						},
+					},
+				},
+			},
+			{
+				Code: `a || b && c`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 3,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `a || (b && c)`},
+						},
+					},
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 8,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `a || (b && c)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `a + b * c`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 3,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `a + (b * c)`},
+						},
+					},
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 7,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `a + (b * c)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `a & b | c`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 3,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `(a & b) | c`},
+						},
+					},
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 7,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `(a & b) | c`},
+						},
+					},
+				},
+			},
+			{
+				Code:    `a + b - c`,
+				Options: map[string]interface{}{"allowSamePrecedence": false},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 3,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `(a + b) - c`},
+						},
+					},
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 7,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `(a + b) - c`},
+						},
+					},
+				},
+			},
+			{
+				Code:    `a * b / c`,
+				Options: []interface{}{map[string]interface{}{"allowSamePrecedence": false}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 3,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `(a * b) / c`},
+						},
+					},
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 7,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `(a * b) / c`},
+						},
+					},
+				},
+			},
+			{
+				Code:    `a + b - c`,
+				Options: map[string]interface{}{"groups": []interface{}{[]interface{}{"+", "-"}}, "allowSamePrecedence": false},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 3,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `(a + b) - c`},
+						},
+					},
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 7,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `(a + b) - c`},
+						},
+					},
+				},
+			},
+			{
+				Code:    `a || b ? c : d`,
+				Options: map[string]interface{}{"groups": []interface{}{[]interface{}{"&&", "||", "?:"}}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 3,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `(a || b) ? c : d`},
+						},
+					},
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 8,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `(a || b) ? c : d`},
+						},
+					},
+				},
+			},
+			{
+				Code:    `a ? b : c || d`,
+				Options: map[string]interface{}{"groups": []interface{}{[]interface{}{"||", "?:"}}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 3,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `a ? b : (c || d)`},
+						},
+					},
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 11,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `a ? b : (c || d)`},
+						},
+					},
+				},
+			},
+			{
+				Code:    `a in b && c`,
+				Options: map[string]interface{}{"groups": []interface{}{[]interface{}{"in", "&&"}}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 3,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `(a in b) && c`},
+						},
+					},
+					{
+						MessageId: "unexpectedMixedOperator", Line: 1, Column: 8,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addParentheses", Output: `(a in b) && c`},
+						},
+					},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,32 @@
+package no_nested_ternary
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// Message builder
+func buildNoNestedTernaryMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "noNestedTernary",
+		Description: "Do not nest ternary expressions.",
+	}
+}
+
+// NoNestedTernaryRule disallows nested ternary expressions
+var NoNestedTernaryRule = rule.CreateRule(rule.Rule{
+	Name: "no-nested-ternary",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		return rule.RuleListeners{
+			ast.KindConditionalExpression: func(node *ast.Node) {
+				conditional := node.AsConditionalExpression()
+				// Parentheses don't make a nested ternary any easier to read
+				for _, branch := range []*ast.Node{conditional.WhenTrue, conditional.WhenFalse} {
+					if nested := ast.SkipParentheses(branch); nested.Kind == ast.KindConditionalExpression {
+						ctx.ReportNode(nested, buildNoNestedTernaryMessage())
+					}
+				}
+			},
+		}
+	},
+})
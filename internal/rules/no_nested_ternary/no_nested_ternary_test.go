@@ -0,0 +1,61 @@
+package no_nested_ternary
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoNestedTernaryRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoNestedTernaryRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `foo ? doBar() : doBaz();`},
+			{Code: `var foo = bar === baz ? qux : quxx;`},
+			{Code: `var foo = (bar ? baz : qux) ? a : b;`},
+			{Code: `var foo = bar ? () => (baz ? qux : quxx) : a;`},
+			{Code: `var foo = bar ? [baz ? qux : quxx] : a;`},
+			{Code: `var foo = bar ? fn(baz ? qux : quxx) : a;`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `foo ? bar : baz === qux ? quxx : foobar;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "noNestedTernary", Line: 1, Column: 13},
+				},
+			},
+			{
+				Code: `foo ? baz === qux ? quxx : foobar : bar;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "noNestedTernary", Line: 1, Column: 7},
+				},
+			},
+			{
+				Code: `var foo = bar ? (baz ? qux : quxx) : a;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "noNestedTernary", Line: 1, Column: 18},
+				},
+			},
+			{
+				Code: `var foo = a ? b ? c : d : e ? f : g;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "noNestedTernary", Line: 1, Column: 15},
+					{MessageId: "noNestedTernary", Line: 1, Column: 27},
+				},
+			},
+			{
+				Code: `var foo = a ? b : c ? d : e ? f : g;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "noNestedTernary", Line: 1, Column: 19},
+					{MessageId: "noNestedTernary", Line: 1, Column: 27},
+				},
+			},
+		},
+	)
+}
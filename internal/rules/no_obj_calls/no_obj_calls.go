@@ -0,0 +1,134 @@
+package no_obj_calls
+
+import (
+	"slices"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builders
+func buildUnexpectedCallMessage(name string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpectedCall",
+		Description: "'" + name + "' is not a function.",
+	}
+}
+
+func buildUnexpectedRefCallMessage(name string, ref string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpectedRefCall",
+		Description: "'" + name + "' is reference to '" + ref + "', which is not a function.",
+	}
+}
+
+var nonCallableGlobals = []string{"Atomics", "JSON", "Math", "Reflect", "Intl"}
+
+var globalObjectNames = []string{"globalThis", "self", "window", "global"}
+
+// getReportName returns the name the callee is written as, `Math` for both `Math()` and `globalThis.Math()`
+func getReportName(callee *ast.Node) string {
+	switch callee.Kind {
+	case ast.KindIdentifier:
+		return callee.Text()
+	case ast.KindPropertyAccessExpression:
+		return callee.AsPropertyAccessExpression().Name().Text()
+	case ast.KindElementAccessExpression:
+		return callee.AsElementAccessExpression().ArgumentExpression.Text()
+	}
+	return ""
+}
+
+// getStaticPropertyName returns the name of `obj.name` and `obj['name']`
+func getStaticPropertyName(node *ast.Node) (string, bool) {
+	switch node.Kind {
+	case ast.KindPropertyAccessExpression:
+		name := node.AsPropertyAccessExpression().Name()
+		return name.Text(), name.Kind == ast.KindIdentifier
+	case ast.KindElementAccessExpression:
+		argument := ast.SkipParentheses(node.AsElementAccessExpression().ArgumentExpression)
+		if argument.Kind == ast.KindStringLiteral || argument.Kind == ast.KindNoSubstitutionTemplateLiteral {
+			return argument.Text(), true
+		}
+	}
+	return "", false
+}
+
+// NoObjCallsRule disallows calling global object properties as functions
+var NoObjCallsRule = rule.CreateRule(rule.Rule{
+	Name: "no-obj-calls",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		isGlobal := func(identifier *ast.Node) bool {
+			if ctx.TypeChecker == nil {
+				return true
+			}
+			symbol := ctx.TypeChecker.GetSymbolAtLocation(identifier)
+			// `globalThis` resolves to a synthesized symbol without declarations
+			return symbol == nil || len(symbol.Declarations) == 0 || utils.IsSymbolFromDefaultLibrary(ctx.Program, symbol)
+		}
+
+		// getNonCallableGlobal returns the name of the non-callable global node refers to,
+		// following `globalThis.Math` and variables initialized with such references
+		var getNonCallableGlobal func(node *ast.Node, seen []*ast.Node) string
+		getNonCallableGlobal = func(node *ast.Node, seen []*ast.Node) string {
+			node = ast.SkipParentheses(node)
+
+			switch node.Kind {
+			case ast.KindIdentifier:
+				name := node.Text()
+				if isGlobal(node) {
+					if slices.Contains(nonCallableGlobals, name) {
+						return name
+					}
+					return ""
+				}
+				symbol := ctx.TypeChecker.GetSymbolAtLocation(node)
+
+				// const foo = Math; foo();
+				declaration := symbol.ValueDeclaration
+				if declaration == nil || declaration.Kind != ast.KindVariableDeclaration || slices.Contains(seen, declaration) {
+					return ""
+				}
+				variable := declaration.AsVariableDeclaration()
+				if variable.Initializer == nil || variable.Name().Kind != ast.KindIdentifier {
+					return ""
+				}
+				return getNonCallableGlobal(variable.Initializer, append(seen, declaration))
+
+			case ast.KindPropertyAccessExpression, ast.KindElementAccessExpression:
+				// globalThis.Math()
+				name, ok := getStaticPropertyName(node)
+				if !ok || !slices.Contains(nonCallableGlobals, name) {
+					return ""
+				}
+				object := ast.SkipParentheses(node.Expression())
+				if object.Kind != ast.KindIdentifier || !slices.Contains(globalObjectNames, object.Text()) || !isGlobal(object) {
+					return ""
+				}
+				return name
+			}
+			return ""
+		}
+
+		check := func(node *ast.Node) {
+			callee := ast.SkipParentheses(node.Expression())
+			ref := getNonCallableGlobal(callee, nil)
+			if ref == "" {
+				return
+			}
+
+			name := getReportName(callee)
+			if name == ref {
+				ctx.ReportNode(node, buildUnexpectedCallMessage(name))
+			} else {
+				ctx.ReportNode(node, buildUnexpectedRefCallMessage(name, ref))
+			}
+		}
+
+		return rule.RuleListeners{
+			ast.KindCallExpression: check,
+			ast.KindNewExpression:  check,
+		}
+	},
+})
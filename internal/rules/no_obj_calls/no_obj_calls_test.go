@@ -0,0 +1,176 @@
+package no_obj_calls
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoObjCallsRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoObjCallsRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `var x = Math;`},
+			{Code: `var x = Math.random();`},
+			{Code: `var x = Math.PI;`},
+			{Code: `var x = foo.Math();`},
+			{Code: `var x = new foo.Math();`},
+			{Code: `var x = new Math.foo;`},
+			{Code: `var x = new Math.foo.bar;`},
+			{Code: `var x = JSON.parse(foo);`},
+			{Code: `new JSON.parse;`},
+			{Code: `Reflect.get(foo, 'x');`},
+			{Code: `new Reflect.foo(a, b);`},
+			{Code: `Atomics.load(foo, 0);`},
+			{Code: `new Atomics.foo();`},
+			{Code: `new Intl.Segmenter();`},
+			{Code: `Intl.DateTimeFormat();`},
+			{Code: `globalThis.Math.max(1, 2);`},
+			{Code: `globalThis.foo();`},
+			{Code: `var foo = bar ? baz : JSON; foo();`},
+
+			// Shadowed globals
+			{Code: `function f(Math: any) { Math(); new Math(); }`},
+			{Code: `function f() { const JSON = () => 1; JSON(); }`},
+			{Code: `function f(globalThis: any) { globalThis.Reflect(); }`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `Math();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `var x = Math();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `f(Math());`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 3},
+				},
+			},
+			{
+				Code: `Math().foo;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `new Math;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `new Math();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `new Math(foo);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `(new Math).foo();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 2},
+				},
+			},
+			{
+				Code: `var x = JSON();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `x = JSON(str);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 5},
+				},
+			},
+			{
+				Code: `var x = Reflect();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `new Reflect();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `var x = Atomics();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `new Intl();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `(Math)();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 1},
+				},
+			},
+
+			// Global object references
+			{
+				Code: `globalThis.Math();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `new globalThis.JSON;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `globalThis['Reflect']();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedCall", Line: 1, Column: 1},
+				},
+			},
+
+			// References through variables
+			{
+				Code: `const foo = Math; foo();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedRefCall", Line: 1, Column: 19},
+				},
+			},
+			{
+				Code: `const foo = globalThis.Atomics; new foo();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedRefCall", Line: 1, Column: 33},
+				},
+			},
+			{
+				Code: `const a = JSON; const b = a; b();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedRefCall", Line: 1, Column: 30},
+				},
+			},
+		},
+	)
+}
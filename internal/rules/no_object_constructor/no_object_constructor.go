@@ -0,0 +1,89 @@
+package no_object_constructor
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builder
+func buildPreferLiteralMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "preferLiteral",
+		Description: "The object literal notation {} is preferable.",
+	}
+}
+
+// needsParens checks whether an object literal replacing node would be parsed as
+// a block, i.e. it would start an expression statement or an arrow function body
+func needsParens(node *ast.Node) bool {
+	for {
+		parent := node.Parent
+		switch parent.Kind {
+		case ast.KindExpressionStatement:
+			return true
+		case ast.KindArrowFunction:
+			return parent.Body() == node
+		case ast.KindPropertyAccessExpression, ast.KindElementAccessExpression, ast.KindCallExpression,
+			ast.KindNonNullExpression, ast.KindAsExpression, ast.KindSatisfiesExpression:
+			if parent.Expression() != node {
+				return false
+			}
+		case ast.KindTaggedTemplateExpression:
+			if parent.AsTaggedTemplateExpression().Tag != node {
+				return false
+			}
+		case ast.KindBinaryExpression:
+			if parent.AsBinaryExpression().Left != node {
+				return false
+			}
+		case ast.KindConditionalExpression:
+			if parent.AsConditionalExpression().Condition != node {
+				return false
+			}
+		default:
+			return false
+		}
+		node = parent
+	}
+}
+
+// NoObjectConstructorRule disallows calling the `Object` constructor without an argument
+var NoObjectConstructorRule = rule.CreateRule(rule.Rule{
+	Name: "no-object-constructor",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		isGlobalObject := func(callee *ast.Node) bool {
+			if callee.Kind != ast.KindIdentifier || callee.Text() != "Object" {
+				return false
+			}
+			if ctx.TypeChecker == nil {
+				return true
+			}
+			symbol := ctx.TypeChecker.GetSymbolAtLocation(callee)
+			return symbol == nil || utils.IsSymbolFromDefaultLibrary(ctx.Program, symbol)
+		}
+
+		check := func(node *ast.Node) {
+			// `Object(value)` converts value to an object, which a literal can't express
+			if len(node.Arguments()) != 0 || ast.IsOptionalChain(node) || !isGlobalObject(ast.SkipParentheses(node.Expression())) {
+				return
+			}
+
+			if utils.HasCommentsInRange(ctx.SourceFile, utils.TrimNodeTextRange(ctx.SourceFile, node)) {
+				ctx.ReportNode(node, buildPreferLiteralMessage())
+				return
+			}
+
+			text := "{}"
+			if needsParens(node) {
+				text = "({})"
+			}
+			ctx.ReportNodeWithFixes(node, buildPreferLiteralMessage(), rule.RuleFixReplace(ctx.SourceFile, node, text))
+		}
+
+		return rule.RuleListeners{
+			ast.KindCallExpression: check,
+			ast.KindNewExpression:  check,
+		}
+	},
+})
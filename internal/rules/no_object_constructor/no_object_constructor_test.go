@@ -0,0 +1,95 @@
+package no_object_constructor
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoObjectConstructorRule(t *testing.T) {
+	rule_tester.RunRuleTester(fixtures.GetRootDir(), "tsconfig.json", t, &NoObjectConstructorRule, []rule_tester.ValidTestCase{
+		{Code: "const foo = Object('foo');"},
+		{Code: "const foo = new Object(bar);"},
+		{Code: "const foo = new foo.Object();"},
+		{Code: "const foo = foo.Object();"},
+		{Code: "const foo = Object?.();"},
+		{Code: "function f(Object: any) { return new Object(); }"},
+		{Code: "const foo = { Object() {} }.Object();"},
+	}, []rule_tester.InvalidTestCase{
+		{
+			Code:   "const foo = new Object();",
+			Output: []string{"const foo = {};"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferLiteral", Line: 1, Column: 13, EndColumn: 25},
+			},
+		},
+		{
+			Code:   "const foo = new Object;",
+			Output: []string{"const foo = {};"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferLiteral", Line: 1, Column: 13, EndColumn: 23},
+			},
+		},
+		{
+			Code:   "const foo = Object();",
+			Output: []string{"const foo = {};"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferLiteral", Line: 1, Column: 13, EndColumn: 21},
+			},
+		},
+		{
+			Code:   "const foo = (Object)();",
+			Output: []string{"const foo = {};"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferLiteral", Line: 1, Column: 13},
+			},
+		},
+		{
+			Code:   "foo(new Object(), Object());",
+			Output: []string{"foo({}, {});"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferLiteral", Line: 1, Column: 5},
+				{MessageId: "preferLiteral", Line: 1, Column: 19},
+			},
+		},
+
+		// An object literal can't start a statement or an arrow function body
+		{
+			Code:   "Object();",
+			Output: []string{"({});"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferLiteral", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:   "new Object().toString();",
+			Output: []string{"({}).toString();"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferLiteral", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code:   "const foo = () => new Object();",
+			Output: []string{"const foo = () => ({});"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferLiteral", Line: 1, Column: 19},
+			},
+		},
+		{
+			Code:   "const foo = () => bar(Object());",
+			Output: []string{"const foo = () => bar({});"},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferLiteral", Line: 1, Column: 23},
+			},
+		},
+
+		// Comments would be lost by the fix
+		{
+			Code: "const foo = new Object(/* empty */);",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "preferLiteral", Line: 1, Column: 13},
+			},
+		},
+	})
+}
@@ -0,0 +1,122 @@
+package no_prototype_builtins
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+var disallowedProps = map[string]bool{
+	"hasOwnProperty":       true,
+	"isPrototypeOf":        true,
+	"propertyIsEnumerable": true,
+}
+
+// Message builders
+func buildPrototypeBuildInMessage(prop string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "prototypeBuildIn",
+		Description: "Do not access Object.prototype method '" + prop + "' from target object.",
+	}
+}
+
+func buildCallObjectPrototypeMessage(prop string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "callObjectPrototype",
+		Description: "Call Object.prototype." + prop + " explicitly.",
+	}
+}
+
+func buildUseObjectHasOwnMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "useObjectHasOwn",
+		Description: "Use 'Object.hasOwn' instead.",
+	}
+}
+
+// getStaticPropertyName returns the name of `obj.name` and `obj['name']` along with the node to report
+func getStaticPropertyName(node *ast.Node) (string, *ast.Node, bool) {
+	switch node.Kind {
+	case ast.KindPropertyAccessExpression:
+		name := node.AsPropertyAccessExpression().Name()
+		return name.Text(), name, name.Kind == ast.KindIdentifier
+	case ast.KindElementAccessExpression:
+		argument := node.AsElementAccessExpression().ArgumentExpression
+		unwrapped := ast.SkipParentheses(argument)
+		if unwrapped.Kind == ast.KindStringLiteral || unwrapped.Kind == ast.KindNoSubstitutionTemplateLiteral {
+			return unwrapped.Text(), argument, true
+		}
+	}
+	return "", nil, false
+}
+
+// isObjectShadowed checks whether `Object` is declared in any scope enclosing node
+func isObjectShadowed(node *ast.Node) bool {
+	for scope := ast.GetEnclosingBlockScopeContainer(node); scope != nil; scope = ast.GetEnclosingBlockScopeContainer(scope) {
+		if _, ok := scope.Locals()["Object"]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// NoPrototypeBuiltinsRule disallows calling some Object.prototype methods directly on objects
+var NoPrototypeBuiltinsRule = rule.CreateRule(rule.Rule{
+	Name: "no-prototype-builtins",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		return rule.RuleListeners{
+			ast.KindCallExpression: func(node *ast.Node) {
+				callee := ast.SkipParentheses(node.Expression())
+				if !ast.IsAccessExpression(callee) {
+					return
+				}
+				prop, propNode, ok := getStaticPropertyName(callee)
+				if !ok || !disallowedProps[prop] {
+					return
+				}
+
+				message := buildPrototypeBuildInMessage(prop)
+
+				// The rewritten call can't keep the short-circuiting of an optional chain, e.g. `a?.b.hasOwnProperty(c)`,
+				// and `super` can't be passed as an argument
+				object := callee.Expression()
+				if ast.IsOptionalChain(callee) || object.Kind == ast.KindSuperKeyword || isObjectShadowed(node) {
+					ctx.ReportNode(propNode, message)
+					return
+				}
+
+				objectRange := utils.TrimNodeTextRange(ctx.SourceFile, object)
+				objectText := ctx.SourceFile.Text()[objectRange.Pos():objectRange.End()]
+				args := node.Arguments()
+
+				// The target object becomes the first argument
+				insertObject := rule.RuleFixReplaceRange(core.NewTextRange(node.End()-1, node.End()-1), objectText)
+				if len(args) > 0 {
+					insertObject = rule.RuleFixInsertBefore(ctx.SourceFile, args[0], objectText+", ")
+				}
+
+				suggestions := []rule.RuleSuggestion{{
+					Message: buildCallObjectPrototypeMessage(prop),
+					FixesArr: []rule.RuleFix{
+						rule.RuleFixReplace(ctx.SourceFile, node.Expression(), "Object.prototype."+prop+".call"),
+						insertObject,
+					},
+				}}
+
+				// `Object.hasOwn(obj, key)` is the modern replacement for `obj.hasOwnProperty(key)`
+				if prop == "hasOwnProperty" && len(args) == 1 && args[0].Kind != ast.KindSpreadElement && !ast.IsOptionalChain(node) {
+					suggestions = append(suggestions, rule.RuleSuggestion{
+						Message: buildUseObjectHasOwnMessage(),
+						FixesArr: []rule.RuleFix{
+							rule.RuleFixReplace(ctx.SourceFile, node.Expression(), "Object.hasOwn"),
+							insertObject,
+						},
+					})
+				}
+
+				ctx.ReportNodeWithSuggestions(propNode, message, suggestions...)
+			},
+		}
+	},
+})
@@ -0,0 +1,166 @@
+package no_prototype_builtins
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoPrototypeBuiltinsRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoPrototypeBuiltinsRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `Object.prototype.hasOwnProperty.call(foo, 'bar')`},
+			{Code: `Object.prototype.isPrototypeOf.call(foo, 'bar')`},
+			{Code: `Object.prototype.propertyIsEnumerable.call(foo, 'bar')`},
+			{Code: `Object.prototype.hasOwnProperty.apply(foo, ['bar'])`},
+			{Code: `Object.hasOwn(foo, 'bar')`},
+			{Code: `hasOwnProperty(foo, 'bar')`},
+			{Code: `foo.hasOwnProperty`},
+			{Code: `foo.hasOwnProperty.bar()`},
+			{Code: `foo(hasOwnProperty)`},
+			{Code: `foo[hasOwnProperty]('bar')`},
+			{Code: `foo['HasOwnProperty']('bar')`},
+			{Code: "foo[`isPrototypeOff`]('bar')"},
+			{Code: `foo.bar()`},
+			{Code: `foo?.['propertyIsEnumerabl']('bar')`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `foo.hasOwnProperty('bar')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "prototypeBuildIn", Line: 1, Column: 5,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "callObjectPrototype", Output: `Object.prototype.hasOwnProperty.call(foo, 'bar')`},
+							{MessageId: "useObjectHasOwn", Output: `Object.hasOwn(foo, 'bar')`},
+						},
+					},
+				},
+			},
+			{
+				Code: `foo.isPrototypeOf('bar')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "prototypeBuildIn", Line: 1, Column: 5,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "callObjectPrototype", Output: `Object.prototype.isPrototypeOf.call(foo, 'bar')`},
+						},
+					},
+				},
+			},
+			{
+				Code: `foo.propertyIsEnumerable('bar')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "prototypeBuildIn", Line: 1, Column: 5,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "callObjectPrototype", Output: `Object.prototype.propertyIsEnumerable.call(foo, 'bar')`},
+						},
+					},
+				},
+			},
+			{
+				Code: `foo.bar.hasOwnProperty('bar')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "prototypeBuildIn", Line: 1, Column: 9,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "callObjectPrototype", Output: `Object.prototype.hasOwnProperty.call(foo.bar, 'bar')`},
+							{MessageId: "useObjectHasOwn", Output: `Object.hasOwn(foo.bar, 'bar')`},
+						},
+					},
+				},
+			},
+			{
+				Code: `foo.bar.baz.isPrototypeOf()`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "prototypeBuildIn", Line: 1, Column: 13,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "callObjectPrototype", Output: `Object.prototype.isPrototypeOf.call(foo.bar.baz)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `foo['hasOwnProperty']('bar')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "prototypeBuildIn", Line: 1, Column: 5,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "callObjectPrototype", Output: `Object.prototype.hasOwnProperty.call(foo, 'bar')`},
+							{MessageId: "useObjectHasOwn", Output: `Object.hasOwn(foo, 'bar')`},
+						},
+					},
+				},
+			},
+			{
+				Code: "foo[`isPrototypeOf`]('bar').baz",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "prototypeBuildIn", Line: 1, Column: 5,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "callObjectPrototype", Output: `Object.prototype.isPrototypeOf.call(foo, 'bar').baz`},
+						},
+					},
+				},
+			},
+			{
+				Code: `(a, b).hasOwnProperty('bar')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "prototypeBuildIn", Line: 1, Column: 8,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "callObjectPrototype", Output: `Object.prototype.hasOwnProperty.call((a, b), 'bar')`},
+							{MessageId: "useObjectHasOwn", Output: `Object.hasOwn((a, b), 'bar')`},
+						},
+					},
+				},
+			},
+			{
+				Code: `foo.hasOwnProperty?.('bar')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "prototypeBuildIn", Line: 1, Column: 5,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "callObjectPrototype", Output: `Object.prototype.hasOwnProperty.call?.(foo, 'bar')`},
+						},
+					},
+				},
+			},
+
+			// No suggestions when the rewrite would change behavior
+			{
+				Code: `foo?.hasOwnProperty('bar')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "prototypeBuildIn", Line: 1, Column: 6},
+				},
+			},
+			{
+				Code: `a?.b.hasOwnProperty('bar')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "prototypeBuildIn", Line: 1, Column: 6},
+				},
+			},
+			{
+				Code: `function f(Object: unknown) { foo.hasOwnProperty('bar'); }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "prototypeBuildIn", Line: 1, Column: 35},
+				},
+			},
+			{
+				Code: `class A extends B { m() { return super.isPrototypeOf(x); } }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "prototypeBuildIn", Line: 1, Column: 40},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,268 @@
+package no_restricted_properties
+
+import (
+	"slices"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/checker"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Restriction is a single entry of the no-restricted-properties options
+type Restriction struct {
+	Object          string   `json:"object"`
+	Property        string   `json:"property"`
+	Message         string   `json:"message"`
+	AllowObjects    []string `json:"allowObjects"`
+	AllowProperties []string `json:"allowProperties"`
+	// IncludeInstances also matches objects whose type is, or extends, the type named by Object
+	IncludeInstances bool `json:"includeInstances"`
+}
+
+func toStringSlice(value interface{}) []string {
+	var result []string
+	if items, ok := value.([]interface{}); ok {
+		for _, item := range items {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+	}
+	return result
+}
+
+func parseOptions(options any) []Restriction {
+	var entries []interface{}
+	switch v := options.(type) {
+	case []interface{}:
+		entries = v
+	case map[string]interface{}:
+		entries = []interface{}{v}
+	}
+
+	var restrictions []Restriction
+	for _, entry := range entries {
+		entryMap, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		restriction := Restriction{}
+		if v, ok := entryMap["object"].(string); ok {
+			restriction.Object = v
+		}
+		if v, ok := entryMap["property"].(string); ok {
+			restriction.Property = v
+		}
+		if v, ok := entryMap["message"].(string); ok {
+			restriction.Message = v
+		}
+		if v, ok := entryMap["includeInstances"].(bool); ok {
+			restriction.IncludeInstances = v
+		}
+		restriction.AllowObjects = toStringSlice(entryMap["allowObjects"])
+		restriction.AllowProperties = toStringSlice(entryMap["allowProperties"])
+
+		// Entries need at least one of object and property
+		if restriction.Object == "" && restriction.Property == "" {
+			continue
+		}
+		restrictions = append(restrictions, restriction)
+	}
+	return restrictions
+}
+
+// Message builders
+func formatCustomMessage(message string) string {
+	if message == "" {
+		return ""
+	}
+	return " " + message
+}
+
+func buildRestrictedObjectPropertyMessage(objectName string, propertyName string, message string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "restrictedObjectProperty",
+		Description: "'" + objectName + "." + propertyName + "' is restricted from being used." + formatCustomMessage(message),
+	}
+}
+
+func buildRestrictedPropertyMessage(propertyName string, message string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "restrictedProperty",
+		Description: "'" + propertyName + "' is restricted from being used." + formatCustomMessage(message),
+	}
+}
+
+// getStaticPropertyName returns the name of `obj.name` and `obj['name']`
+func getStaticPropertyName(node *ast.Node) (string, bool) {
+	switch node.Kind {
+	case ast.KindPropertyAccessExpression:
+		name := node.AsPropertyAccessExpression().Name()
+		return name.Text(), name.Kind == ast.KindIdentifier
+	case ast.KindElementAccessExpression:
+		argument := ast.SkipParentheses(node.AsElementAccessExpression().ArgumentExpression)
+		switch argument.Kind {
+		case ast.KindStringLiteral, ast.KindNumericLiteral, ast.KindNoSubstitutionTemplateLiteral:
+			return argument.Text(), true
+		}
+	}
+	return "", false
+}
+
+// getStaticKeyName returns the key of a destructured property with a statically known name
+func getStaticKeyName(name *ast.Node) (string, bool) {
+	if name == nil {
+		return "", false
+	}
+	if name.Kind == ast.KindComputedPropertyName {
+		name = ast.SkipParentheses(name.AsComputedPropertyName().Expression)
+	}
+	switch name.Kind {
+	case ast.KindIdentifier, ast.KindStringLiteral, ast.KindNumericLiteral, ast.KindNoSubstitutionTemplateLiteral:
+		return name.Text(), true
+	}
+	return "", false
+}
+
+// getPatternSource returns the expression an object binding pattern destructures, if any
+func getPatternSource(pattern *ast.Node) *ast.Node {
+	parent := pattern.Parent
+	switch parent.Kind {
+	case ast.KindVariableDeclaration, ast.KindParameter, ast.KindBindingElement:
+		if parent.Name() == pattern {
+			return parent.Initializer()
+		}
+	}
+	return nil
+}
+
+// NoRestrictedPropertiesRule disallows certain properties on certain objects
+var NoRestrictedPropertiesRule = rule.CreateRule(rule.Rule{
+	Name: "no-restricted-properties",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		restrictions := parseOptions(options)
+		if len(restrictions) == 0 {
+			return rule.RuleListeners{}
+		}
+
+		// isInstanceOfType checks whether t is, or extends, a class or interface named typeName
+		var isInstanceOfType func(t *checker.Type, typeName string) bool
+		isInstanceOfType = func(t *checker.Type, typeName string) bool {
+			if utils.IsUnionType(t) {
+				return utils.Some(utils.UnionTypeParts(t), func(t *checker.Type) bool {
+					return isInstanceOfType(t, typeName)
+				})
+			}
+			if utils.IsIntersectionType(t) {
+				return utils.Some(utils.IntersectionTypeParts(t), func(t *checker.Type) bool {
+					return isInstanceOfType(t, typeName)
+				})
+			}
+
+			symbol := checker.Type_symbol(t)
+			if symbol == nil {
+				return false
+			}
+			if symbol.Name == typeName {
+				return true
+			}
+			if symbol.Flags&(ast.SymbolFlagsClass|ast.SymbolFlagsInterface) != 0 {
+				declaredType := checker.Checker_getDeclaredTypeOfSymbol(ctx.TypeChecker, symbol)
+				for _, baseType := range checker.Checker_getBaseTypes(ctx.TypeChecker, declaredType) {
+					if isInstanceOfType(baseType, typeName) {
+						return true
+					}
+				}
+			}
+			return false
+		}
+
+		matchesObject := func(restriction Restriction, objectNode *ast.Node, objectName string) bool {
+			if objectName != "" && objectName == restriction.Object {
+				return true
+			}
+			if !restriction.IncludeInstances || objectNode == nil || ctx.TypeChecker == nil {
+				return false
+			}
+			return isInstanceOfType(utils.GetConstrainedTypeAtLocation(ctx.TypeChecker, objectNode), restriction.Object)
+		}
+
+		checkPropertyAccess := func(node *ast.Node, objectNode *ast.Node, propertyName string) {
+			objectName := ""
+			if objectNode != nil {
+				objectNode = ast.SkipParentheses(objectNode)
+				if objectNode.Kind == ast.KindIdentifier {
+					objectName = objectNode.Text()
+				}
+			}
+
+			// Restrictions on an object take precedence over restrictions on a property everywhere
+			for _, restriction := range restrictions {
+				if restriction.Object == "" || (restriction.Property != "" && restriction.Property != propertyName) {
+					continue
+				}
+				if slices.Contains(restriction.AllowProperties, propertyName) || !matchesObject(restriction, objectNode, objectName) {
+					continue
+				}
+				ctx.ReportNode(node, buildRestrictedObjectPropertyMessage(restriction.Object, propertyName, restriction.Message))
+				return
+			}
+			for _, restriction := range restrictions {
+				if restriction.Object != "" || restriction.Property != propertyName {
+					continue
+				}
+				if objectName != "" && slices.Contains(restriction.AllowObjects, objectName) {
+					continue
+				}
+				ctx.ReportNode(node, buildRestrictedPropertyMessage(propertyName, restriction.Message))
+				return
+			}
+		}
+
+		checkMemberAccess := func(node *ast.Node) {
+			if propertyName, ok := getStaticPropertyName(node); ok {
+				checkPropertyAccess(node, node.Expression(), propertyName)
+			}
+		}
+
+		return rule.RuleListeners{
+			ast.KindPropertyAccessExpression: checkMemberAccess,
+			ast.KindElementAccessExpression:  checkMemberAccess,
+
+			// const {foo} = obj
+			ast.KindObjectBindingPattern: func(node *ast.Node) {
+				source := getPatternSource(node)
+				for _, element := range node.AsBindingPattern().Elements.Nodes {
+					binding := element.AsBindingElement()
+					if binding.DotDotDotToken != nil {
+						continue
+					}
+					key := binding.PropertyName
+					if key == nil {
+						key = binding.Name()
+					}
+					if propertyName, ok := getStaticKeyName(key); ok {
+						checkPropertyAccess(node, source, propertyName)
+					}
+				}
+			},
+
+			// ({foo} = obj)
+			rule.ListenerOnAllowPattern(ast.KindObjectLiteralExpression): func(node *ast.Node) {
+				var source *ast.Node
+				if parent := node.Parent; parent.Kind == ast.KindBinaryExpression && parent.AsBinaryExpression().Left == node {
+					source = parent.AsBinaryExpression().Right
+				}
+				for _, property := range node.AsObjectLiteralExpression().Properties.Nodes {
+					if property.Kind != ast.KindPropertyAssignment && property.Kind != ast.KindShorthandPropertyAssignment {
+						continue
+					}
+					if propertyName, ok := getStaticKeyName(property.Name()); ok {
+						checkPropertyAccess(node, source, propertyName)
+					}
+				}
+			},
+		}
+	},
+})
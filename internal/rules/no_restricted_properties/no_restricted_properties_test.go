@@ -0,0 +1,191 @@
+package no_restricted_properties
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoRestrictedPropertiesRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoRestrictedPropertiesRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{
+				Code:    `someObject.someProperty`,
+				Options: []interface{}{map[string]interface{}{"object": "someObject", "property": "disallowedProperty"}},
+			},
+			{
+				Code:    `anotherObject.disallowedProperty`,
+				Options: []interface{}{map[string]interface{}{"object": "someObject", "property": "disallowedProperty"}},
+			},
+			{
+				Code:    `someObject.someProperty()`,
+				Options: []interface{}{map[string]interface{}{"object": "someObject", "property": "disallowedProperty"}},
+			},
+			{
+				Code:    `someObject['someProperty']`,
+				Options: []interface{}{map[string]interface{}{"object": "someObject", "property": "disallowedProperty"}},
+			},
+			{
+				Code:    `someObject[disallowedProperty]`,
+				Options: []interface{}{map[string]interface{}{"object": "someObject", "property": "disallowedProperty"}},
+			},
+			{
+				Code:    `anyObject.foo`,
+				Options: []interface{}{map[string]interface{}{"property": "bar"}},
+			},
+			{
+				Code:    `foo.bar`,
+				Options: []interface{}{map[string]interface{}{"property": "bar", "allowObjects": []interface{}{"foo"}}},
+			},
+			{
+				Code:    `foo.bar`,
+				Options: []interface{}{map[string]interface{}{"object": "foo", "allowProperties": []interface{}{"bar"}}},
+			},
+			{
+				Code:    `let {bar} = foo;`,
+				Options: []interface{}{map[string]interface{}{"object": "foo", "property": "baz"}},
+			},
+			{
+				Code:    `let {bar} = notFoo;`,
+				Options: []interface{}{map[string]interface{}{"object": "foo", "property": "bar"}},
+			},
+			{
+				Code:    `let {...bar} = foo;`,
+				Options: []interface{}{map[string]interface{}{"object": "foo", "property": "bar"}},
+			},
+			{
+				Code:    `({baz} = foo);`,
+				Options: []interface{}{map[string]interface{}{"object": "foo", "property": "bar"}},
+			},
+
+			// Instances only match with includeInstances
+			{
+				Code:    `class Foo { bar() {} } new Foo().bar();`,
+				Options: []interface{}{map[string]interface{}{"object": "Foo", "property": "bar"}},
+			},
+			{
+				Code:    `class Foo { bar() {} baz() {} } new Foo().baz();`,
+				Options: []interface{}{map[string]interface{}{"object": "Foo", "property": "bar", "includeInstances": true}},
+			},
+			{
+				Code:    `class Bar { bar() {} } new Bar().bar();`,
+				Options: []interface{}{map[string]interface{}{"object": "Foo", "property": "bar", "includeInstances": true}},
+			},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:    `someObject.disallowedProperty`,
+				Options: []interface{}{map[string]interface{}{"object": "someObject", "property": "disallowedProperty"}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "restrictedObjectProperty", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `someObject['disallowedProperty']()`,
+				Options: []interface{}{map[string]interface{}{"object": "someObject", "property": "disallowedProperty", "message": "Please use someObject.allowedProperty instead."}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "restrictedObjectProperty", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `foo.__proto__ = {}; bar.__defineGetter__('x', () => 1);`,
+				Options: []interface{}{
+					map[string]interface{}{"property": "__proto__"},
+					map[string]interface{}{"property": "__defineGetter__"},
+				},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "restrictedProperty", Line: 1, Column: 1},
+					{MessageId: "restrictedProperty", Line: 1, Column: 21},
+				},
+			},
+			{
+				Code:    `require.call({}, 'foo')`,
+				Options: []interface{}{map[string]interface{}{"object": "require"}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "restrictedObjectProperty", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `foo.bar`,
+				Options: []interface{}{map[string]interface{}{"property": "bar", "allowObjects": []interface{}{"baz"}}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "restrictedProperty", Line: 1, Column: 1},
+				},
+			},
+
+			// Destructuring
+			{
+				Code:    `let {bar} = foo;`,
+				Options: []interface{}{map[string]interface{}{"object": "foo", "property": "bar"}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "restrictedObjectProperty", Line: 1, Column: 5},
+				},
+			},
+			{
+				Code:    `let {bar: baz} = foo;`,
+				Options: []interface{}{map[string]interface{}{"object": "foo", "property": "bar"}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "restrictedObjectProperty", Line: 1, Column: 5},
+				},
+			},
+			{
+				Code:    `let {'bar': baz} = foo;`,
+				Options: []interface{}{map[string]interface{}{"object": "foo", "property": "bar"}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "restrictedObjectProperty", Line: 1, Column: 5},
+				},
+			},
+			{
+				Code:    `({bar: baz} = foo);`,
+				Options: []interface{}{map[string]interface{}{"object": "foo", "property": "bar"}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "restrictedObjectProperty", Line: 1, Column: 2},
+				},
+			},
+			{
+				Code:    `function qux({bar} = foo) {}`,
+				Options: []interface{}{map[string]interface{}{"object": "foo", "property": "bar"}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "restrictedObjectProperty", Line: 1, Column: 14},
+				},
+			},
+			{
+				Code:    `function qux({bar}) {}`,
+				Options: []interface{}{map[string]interface{}{"property": "bar"}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "restrictedProperty", Line: 1, Column: 14},
+				},
+			},
+
+			// Type-based matching
+			{
+				Code:    `class Foo { bar() {} } new Foo().bar();`,
+				Options: []interface{}{map[string]interface{}{"object": "Foo", "property": "bar", "includeInstances": true}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "restrictedObjectProperty", Line: 1, Column: 24},
+				},
+			},
+			{
+				Code:    `class Foo { bar() {} } class Baz extends Foo {} const baz = new Baz(); baz.bar();`,
+				Options: []interface{}{map[string]interface{}{"object": "Foo", "property": "bar", "includeInstances": true}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "restrictedObjectProperty", Line: 1, Column: 72},
+				},
+			},
+			{
+				Code:    `declare const date: Date; const {getYear} = date;`,
+				Options: []interface{}{map[string]interface{}{"object": "Date", "property": "getYear", "includeInstances": true}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "restrictedObjectProperty", Line: 1, Column: 33},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,84 @@
+package no_return_assign
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// parseMode returns the configured mode, "except-parens" by default
+func parseMode(options any) string {
+	mode := "except-parens"
+	switch opts := options.(type) {
+	case string:
+		mode = opts
+	case []interface{}:
+		if len(opts) > 0 {
+			if s, ok := opts[0].(string); ok {
+				mode = s
+			}
+		}
+	}
+	return mode
+}
+
+// Message builders
+func buildReturnAssignmentMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "returnAssignment",
+		Description: "Return statement should not contain assignment.",
+	}
+}
+
+func buildArrowAssignmentMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "arrowAssignment",
+		Description: "Arrow function should not return assignment.",
+	}
+}
+
+// isSentinel checks whether the upward search for a return context should stop at node
+func isSentinel(node *ast.Node) bool {
+	switch node.Kind {
+	case ast.KindArrowFunction, ast.KindClassExpression:
+		return true
+	}
+	return ast.IsStatement(node) || ast.IsFunctionLike(node)
+}
+
+// NoReturnAssignRule disallows assignment operators in return statements
+var NoReturnAssignRule = rule.CreateRule(rule.Rule{
+	Name: "no-return-assign",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		always := parseMode(options) != "except-parens"
+
+		return rule.RuleListeners{
+			ast.KindBinaryExpression: func(node *ast.Node) {
+				if !ast.IsAssignmentOperator(node.AsBinaryExpression().OperatorToken.Kind) {
+					return
+				}
+				if !always && node.Parent != nil && node.Parent.Kind == ast.KindParenthesizedExpression {
+					return
+				}
+
+				child := node
+				parent := node.Parent
+				for parent != nil && !isSentinel(parent) {
+					child = parent
+					parent = parent.Parent
+				}
+				if parent == nil {
+					return
+				}
+
+				switch parent.Kind {
+				case ast.KindReturnStatement:
+					ctx.ReportNode(parent, buildReturnAssignmentMessage())
+				case ast.KindArrowFunction:
+					if parent.Body() == child {
+						ctx.ReportNode(parent, buildArrowAssignmentMessage())
+					}
+				}
+			},
+		}
+	},
+})
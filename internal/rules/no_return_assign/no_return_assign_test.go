@@ -0,0 +1,111 @@
+package no_return_assign
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoReturnAssignRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoReturnAssignRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `module.exports = {'a': 1};`},
+			{Code: `var result = a * b;`},
+			{Code: `function x() { var result = a * b; return result; }`},
+			{Code: `function x() { return (result = a * b); }`},
+			{Code: `function x() { var result = a * b; return result; }`, Options: "except-parens"},
+			{Code: `function x() { return (result = a * b); }`, Options: "except-parens"},
+			{Code: `function x() { var result = a * b; return result; }`, Options: "always"},
+			{Code: `function x() { return function y() { result = a * b }; }`, Options: "always"},
+			{Code: `() => { return (result = a * b); }`, Options: "except-parens"},
+			{Code: `() => (result = a * b)`, Options: "except-parens"},
+			{Code: `const foo = (a,b,c) => ((a = b), c)`},
+			{Code: `function x() { return function y() { result = a * b }; }`},
+			{Code: `function x() { return class { y = () => { a = b } }; }`, Options: "always"},
+			{Code: `() => { result = a * b; }`, Options: "always"},
+			{Code: `function x() { return a === b; }`},
+			{Code: `function x() { return ((result = a * b)); }`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `function x() { return result = a * b; };`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnAssignment", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code: `function x() { return (result) = (a * b); };`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnAssignment", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code:    `function x() { return result = a * b; };`,
+				Options: "except-parens",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnAssignment", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code:    `function x() { return (result = a * b); };`,
+				Options: "always",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnAssignment", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code:    `() => { return result = a * b; }`,
+				Options: "except-parens",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnAssignment", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `() => result = a * b`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "arrowAssignment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `() => (result = a * b)`,
+				Options: "always",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "arrowAssignment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `function x() { return result || (result = a * b); };`,
+				Options: "always",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnAssignment", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code: `function x() { return (result = a * b, result); };`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnAssignment", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code: `const foo = (a) => a.b = 1`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "arrowAssignment", Line: 1, Column: 13},
+				},
+			},
+			{
+				Code:    `function x() { return a += b; }`,
+				Options: []interface{}{"always"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnAssignment", Line: 1, Column: 16},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,16 @@
+package no_return_await
+
+import (
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/return_await"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// NoReturnAwaitRule is a compatibility rule for the deprecated core `no-return-await` rule.
+// It runs `return-await` in "never" mode so existing configs keep working.
+var NoReturnAwaitRule = rule.CreateRule(rule.Rule{
+	Name: "no-return-await",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		return return_await.ReturnAwaitRule.Run(ctx, return_await.ReturnAwaitOptions{Option: utils.Ref(return_await.ReturnAwaitOptionNever)})
+	},
+})
@@ -0,0 +1,64 @@
+package no_return_await
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoReturnAwaitRule(t *testing.T) {
+	rule_tester.RunRuleTester(fixtures.GetRootDir(), "tsconfig.json", t, &NoReturnAwaitRule, []rule_tester.ValidTestCase{
+		{Code: `
+      async function test() {
+        return Promise.resolve(1);
+      }
+    `},
+		{Code: `
+      async function test() {
+        const value = await Promise.resolve(1);
+        return value;
+      }
+    `},
+		{Code: "const test = async () => Promise.resolve(1);"},
+	}, []rule_tester.InvalidTestCase{
+		{
+			Code: `
+        async function test() {
+          return await Promise.resolve(1);
+        }
+      `,
+			Output: []string{`
+        async function test() {
+          return  Promise.resolve(1);
+        }
+      `,
+			},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "disallowedPromiseAwait",
+					Line:      3,
+				},
+			},
+		},
+		{
+			Code: `
+        async function test() {
+          return await 1;
+        }
+      `,
+			Output: []string{`
+        async function test() {
+          return  1;
+        }
+      `,
+			},
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "nonPromiseAwait",
+					Line:      3,
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,185 @@
+package no_self_assign
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Options for no-self-assign rule
+type Options struct {
+	Props bool `json:"props"`
+}
+
+func parseOptions(options any) Options {
+	opts := Options{
+		Props: true,
+	}
+
+	if options == nil {
+		return opts
+	}
+
+	// Parse options with dual-format support (handles both array and object formats)
+	var optsMap map[string]interface{}
+	var ok bool
+
+	// Handle array format: [{ option: value }]
+	if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+		optsMap, ok = optArray[0].(map[string]interface{})
+	} else {
+		// Handle direct object format: { option: value }
+		optsMap, ok = options.(map[string]interface{})
+	}
+
+	if ok {
+		if v, ok := optsMap["props"].(bool); ok {
+			opts.Props = v
+		}
+	}
+	return opts
+}
+
+// Message builders
+func buildSelfAssignmentMessage(name string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "selfAssignment",
+		Description: "'" + name + "' is assigned to itself.",
+	}
+}
+
+// getStaticPropertyName returns the name of an object literal property with a statically known key
+func getStaticPropertyName(node *ast.Node) (string, bool) {
+	name := node.Name()
+	if name == nil {
+		return "", false
+	}
+	if name.Kind == ast.KindIdentifier {
+		return name.Text(), true
+	}
+	if name.Kind == ast.KindComputedPropertyName {
+		name = ast.SkipParentheses(name.AsComputedPropertyName().Expression)
+	}
+	switch name.Kind {
+	case ast.KindStringLiteral, ast.KindNumericLiteral, ast.KindNoSubstitutionTemplateLiteral:
+		return name.Text(), true
+	}
+	return "", false
+}
+
+// getPropertyValue returns the value side of an object literal property
+func getPropertyValue(node *ast.Node) *ast.Node {
+	switch node.Kind {
+	case ast.KindPropertyAssignment:
+		return node.AsPropertyAssignment().Initializer
+	case ast.KindShorthandPropertyAssignment:
+		// `{a = 1}` assigns a default value and is not a plain self assignment
+		if node.AsShorthandPropertyAssignment().ObjectAssignmentInitializer != nil {
+			return nil
+		}
+		return node.Name()
+	}
+	return nil
+}
+
+// eachSelfAssignment calls report for every part of right that is assigned to itself through left
+func eachSelfAssignment(left *ast.Node, right *ast.Node, props bool, report func(node *ast.Node)) {
+	if left == nil || right == nil {
+		return
+	}
+	left = ast.SkipParentheses(left)
+	right = ast.SkipParentheses(right)
+
+	switch {
+	case left.Kind == ast.KindIdentifier && right.Kind == ast.KindIdentifier:
+		if left.Text() == right.Text() {
+			report(right)
+		}
+
+	case left.Kind == ast.KindArrayLiteralExpression && right.Kind == ast.KindArrayLiteralExpression:
+		leftElements := left.AsArrayLiteralExpression().Elements.Nodes
+		rightElements := right.AsArrayLiteralExpression().Elements.Nodes
+		end := min(len(leftElements), len(rightElements))
+		for i := range end {
+			leftElement := leftElements[i]
+			rightElement := rightElements[i]
+
+			// Avoid cases such as `[...a] = [...a, 1]`
+			if leftElement.Kind == ast.KindSpreadElement && i < len(rightElements)-1 {
+				break
+			}
+
+			eachSelfAssignment(leftElement, rightElement, props, report)
+
+			// Indices after a spread element are unknown
+			if rightElement.Kind == ast.KindSpreadElement {
+				break
+			}
+		}
+
+	case left.Kind == ast.KindSpreadElement && right.Kind == ast.KindSpreadElement:
+		eachSelfAssignment(left.Expression(), right.Expression(), props, report)
+
+	case left.Kind == ast.KindObjectLiteralExpression && right.Kind == ast.KindObjectLiteralExpression:
+		leftProperties := left.AsObjectLiteralExpression().Properties.Nodes
+		rightProperties := right.AsObjectLiteralExpression().Properties.Nodes
+
+		// Properties before the last spread may be overwritten by it
+		start := 0
+		for i := len(rightProperties) - 1; i >= 0; i-- {
+			if rightProperties[i].Kind == ast.KindSpreadAssignment {
+				start = i + 1
+				break
+			}
+		}
+
+		for _, leftProperty := range leftProperties {
+			for _, rightProperty := range rightProperties[start:] {
+				leftName, leftStatic := getStaticPropertyName(leftProperty)
+				rightName, rightStatic := getStaticPropertyName(rightProperty)
+				if !leftStatic || !rightStatic || leftName != rightName {
+					continue
+				}
+				eachSelfAssignment(getPropertyValue(leftProperty), getPropertyValue(rightProperty), props, report)
+			}
+		}
+
+	case props && ast.IsAccessExpression(left) && ast.IsAccessExpression(right):
+		if utils.IsSameReference(left, right) {
+			report(right)
+		}
+	}
+}
+
+// NoSelfAssignRule disallows assignments where both sides are exactly the same
+var NoSelfAssignRule = rule.CreateRule(rule.Rule{
+	Name: "no-self-assign",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+
+		report := func(node *ast.Node) {
+			nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			text := ctx.SourceFile.Text()[nodeRange.Pos():nodeRange.End()]
+			name := strings.Map(func(r rune) rune {
+				if unicode.IsSpace(r) {
+					return -1
+				}
+				return r
+			}, text)
+			ctx.ReportNode(node, buildSelfAssignmentMessage(name))
+		}
+
+		return rule.RuleListeners{
+			ast.KindBinaryExpression: func(node *ast.Node) {
+				binary := node.AsBinaryExpression()
+				switch binary.OperatorToken.Kind {
+				case ast.KindEqualsToken, ast.KindAmpersandAmpersandEqualsToken, ast.KindBarBarEqualsToken, ast.KindQuestionQuestionEqualsToken:
+					eachSelfAssignment(binary.Left, binary.Right, opts.Props, report)
+				}
+			},
+		}
+	},
+})
@@ -0,0 +1,113 @@
+package no_sequences
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Options for no-sequences rule
+type Options struct {
+	AllowInParentheses bool `json:"allowInParentheses"`
+}
+
+func parseOptions(options any) Options {
+	opts := Options{
+		AllowInParentheses: true,
+	}
+
+	if options == nil {
+		return opts
+	}
+
+	// Parse options with dual-format support (handles both array and object formats)
+	var optsMap map[string]interface{}
+	var ok bool
+
+	// Handle array format: [{ option: value }]
+	if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+		optsMap, ok = optArray[0].(map[string]interface{})
+	} else {
+		// Handle direct object format: { option: value }
+		optsMap, ok = options.(map[string]interface{})
+	}
+
+	if ok {
+		if v, ok := optsMap["allowInParentheses"].(bool); ok {
+			opts.AllowInParentheses = v
+		}
+	}
+	return opts
+}
+
+// Message builder
+func buildUnexpectedCommaExpressionMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpectedCommaExpression",
+		Description: "Unexpected use of comma operator.",
+	}
+}
+
+func isCommaExpression(node *ast.Node) bool {
+	return node != nil &&
+		node.Kind == ast.KindBinaryExpression &&
+		node.AsBinaryExpression().OperatorToken.Kind == ast.KindCommaToken
+}
+
+// NoSequencesRule disallows comma operators
+var NoSequencesRule = rule.CreateRule(rule.Rule{
+	Name: "no-sequences",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+
+		return rule.RuleListeners{
+			ast.KindBinaryExpression: func(node *ast.Node) {
+				if !isCommaExpression(node) {
+					return
+				}
+
+				// `a, b, c` is parsed as `(a, b), c`; only check the outermost sequence
+				if isCommaExpression(node.Parent) && node.Parent.AsBinaryExpression().Left == node {
+					return
+				}
+
+				// Count the parentheses wrapping the sequence
+				outer := node
+				parenCount := 0
+				for outer.Parent != nil && outer.Parent.Kind == ast.KindParenthesizedExpression {
+					outer = outer.Parent
+					parenCount++
+				}
+				parent := outer.Parent
+
+				// Always allow sequences in for statement initializers and updates
+				if parent.Kind == ast.KindForStatement {
+					forStmt := parent.AsForStatement()
+					if forStmt.Initializer == outer || forStmt.Incrementor == outer {
+						return
+					}
+				}
+
+				// Wrapping a sequence in extra parentheses indicates intent
+				if opts.AllowInParentheses {
+					// Statement heads like `if (...)` aren't parenthesized expressions in
+					// the AST, but a sequence arrow body must be parenthesized once anyway
+					requiredParens := 1
+					if parent.Kind == ast.KindArrowFunction && parent.Body() == outer {
+						requiredParens = 2
+					}
+					if parenCount >= requiredParens {
+						return
+					}
+				}
+
+				// Report on the first comma of the sequence
+				first := node
+				for isCommaExpression(first.AsBinaryExpression().Left) {
+					first = first.AsBinaryExpression().Left
+				}
+				ctx.ReportRange(utils.TrimNodeTextRange(ctx.SourceFile, first.AsBinaryExpression().OperatorToken), buildUnexpectedCommaExpressionMessage())
+			},
+		}
+	},
+})
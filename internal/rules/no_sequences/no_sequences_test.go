@@ -0,0 +1,117 @@
+package no_sequences
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoSequencesRule(t *testing.T) {
+	noParens := map[string]interface{}{"allowInParentheses": false}
+
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoSequencesRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `var arr = [1, 2];`},
+			{Code: `var obj = {a: 1, b: 2};`},
+			{Code: `var a = 1, b = 2;`},
+			{Code: `var foo = (1, 2);`},
+			{Code: `(0,eval)("foo()");`},
+			{Code: `for (i = 1, j = 2;; i++, j++);`},
+			{Code: `foo(a, (b, c), d);`},
+			{Code: `do {} while ((doSomething(), !!test));`},
+			{Code: `for ((doSomething(), somethingElse()); (doSomething(), !!test); );`},
+			{Code: `if ((doSomething(), !!test));`},
+			{Code: `switch ((doSomething(), val)) {}`},
+			{Code: `while ((doSomething(), !!test));`},
+			{Code: `a => ((doSomething(), a))`},
+
+			// allowInParentheses: false
+			{Code: `for (i = 0, j = 0; test; );`, Options: noParens},
+			{Code: `for (; test; i++, j++);`, Options: noParens},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:   `1, 2;`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 2}},
+			},
+			{
+				Code:   `a = 1, 2`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 6}},
+			},
+			{
+				Code:   `a, b, c;`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 2}},
+			},
+			{
+				Code:   `do {} while (doSomething(), !!test);`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 27}},
+			},
+			{
+				Code:   `for (; doSomething(), !!test; );`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 21}},
+			},
+			{
+				Code:   `if (doSomething(), !!test);`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 18}},
+			},
+			{
+				Code:   `switch (doSomething(), val) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 22}},
+			},
+			{
+				Code:   `while (doSomething(), !!test);`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 21}},
+			},
+			{
+				Code:   `a => (doSomething(), a)`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 20}},
+			},
+			{
+				Code:   `(1), 2`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 4}},
+			},
+			{
+				Code:   `((1)) , (2)`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 7}},
+			},
+			{
+				Code:   `while((1) , 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 11}},
+			},
+
+			// allowInParentheses: false
+			{
+				Code:    `var foo = (1, 2);`,
+				Options: noParens,
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 13}},
+			},
+			{
+				Code:    `(0,eval)("foo()");`,
+				Options: noParens,
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 3}},
+			},
+			{
+				Code:    `foo(a, (b, c), d);`,
+				Options: noParens,
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 10}},
+			},
+			{
+				Code:    `if ((doSomething(), !!test));`,
+				Options: noParens,
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 19}},
+			},
+			{
+				Code:    `a => ((doSomething(), a))`,
+				Options: noParens,
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpectedCommaExpression", Line: 1, Column: 21}},
+			},
+		},
+	)
+}
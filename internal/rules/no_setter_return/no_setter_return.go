@@ -0,0 +1,132 @@
+package no_setter_return
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builder
+func buildReturnsValueMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "returnsValue",
+		Description: "Setter cannot return a value.",
+	}
+}
+
+// getStaticPropertyName returns the name of an object literal member, including `['name']`
+func getStaticPropertyName(member *ast.Node) (string, bool) {
+	name := member.Name()
+	if name == nil {
+		return "", false
+	}
+	if name.Kind == ast.KindComputedPropertyName {
+		name = ast.SkipParentheses(name.Expression())
+	}
+	switch name.Kind {
+	case ast.KindIdentifier, ast.KindStringLiteral, ast.KindNoSubstitutionTemplateLiteral:
+		return name.Text(), true
+	}
+	return "", false
+}
+
+// NoSetterReturnRule disallows returning values from setters
+var NoSetterReturnRule = rule.CreateRule(rule.Rule{
+	Name: "no-setter-return",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		isGlobal := func(identifier *ast.Node) bool {
+			if ctx.TypeChecker == nil {
+				return true
+			}
+			symbol := ctx.TypeChecker.GetSymbolAtLocation(identifier)
+			return symbol == nil || utils.IsSymbolFromDefaultLibrary(ctx.Program, symbol)
+		}
+
+		// getGlobalMethodName returns e.g. `Object.defineProperty` for a call of a global object's method
+		getGlobalMethodName := func(call *ast.Node) string {
+			callee := ast.SkipParentheses(call.Expression())
+			if callee.Kind != ast.KindPropertyAccessExpression {
+				return ""
+			}
+			object := ast.SkipParentheses(callee.Expression())
+			if object.Kind != ast.KindIdentifier || !isGlobal(object) {
+				return ""
+			}
+			return object.Text() + "." + callee.AsPropertyAccessExpression().Name().Text()
+		}
+
+		// isPropertyDescriptor checks whether an object literal is passed as a property descriptor, e.g.
+		// `Object.defineProperty(obj, 'foo', descriptor)` or `Object.defineProperties(obj, { foo: descriptor })`
+		isPropertyDescriptor := func(object *ast.Node) bool {
+			parent := ast.WalkUpParenthesizedExpressions(object.Parent)
+			if parent == nil {
+				return false
+			}
+
+			if parent.Kind == ast.KindCallExpression {
+				args := parent.Arguments()
+				switch getGlobalMethodName(parent) {
+				case "Object.defineProperty", "Reflect.defineProperty":
+					return len(args) > 2 && ast.SkipParentheses(args[2]) == object
+				}
+				return false
+			}
+
+			// A descriptor nested in the properties map of Object.defineProperties() or Object.create()
+			if parent.Kind != ast.KindPropertyAssignment || ast.SkipParentheses(parent.Initializer()) != object {
+				return false
+			}
+			descriptors := parent.Parent
+			call := ast.WalkUpParenthesizedExpressions(descriptors.Parent)
+			if call == nil || call.Kind != ast.KindCallExpression {
+				return false
+			}
+			args := call.Arguments()
+			switch getGlobalMethodName(call) {
+			case "Object.defineProperties", "Object.create":
+				return len(args) > 1 && ast.SkipParentheses(args[1]) == descriptors
+			}
+			return false
+		}
+
+		// isSetter checks for set accessors and `set` functions of property descriptors
+		isSetter := func(fn *ast.Node) bool {
+			if fn.Kind == ast.KindSetAccessor {
+				return true
+			}
+
+			member := fn
+			if fn.Kind != ast.KindMethodDeclaration {
+				parent := ast.WalkUpParenthesizedExpressions(fn.Parent)
+				if parent == nil || parent.Kind != ast.KindPropertyAssignment || ast.SkipParentheses(parent.Initializer()) != fn {
+					return false
+				}
+				member = parent
+			}
+			if member.Parent.Kind != ast.KindObjectLiteralExpression {
+				return false
+			}
+			name, ok := getStaticPropertyName(member)
+			return ok && name == "set" && isPropertyDescriptor(member.Parent)
+		}
+
+		return rule.RuleListeners{
+			ast.KindReturnStatement: func(node *ast.Node) {
+				if node.Expression() == nil {
+					return
+				}
+				fn := ast.FindAncestor(node.Parent, ast.IsFunctionLike)
+				if fn != nil && isSetter(fn) {
+					ctx.ReportNode(node, buildReturnsValueMessage())
+				}
+			},
+			// `set: value => value` implicitly returns its body
+			ast.KindArrowFunction: func(node *ast.Node) {
+				body := node.Body()
+				if body != nil && body.Kind != ast.KindBlock && isSetter(node) {
+					ctx.ReportNode(body, buildReturnsValueMessage())
+				}
+			},
+		}
+	},
+})
@@ -0,0 +1,130 @@
+package no_setter_return
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoSetterReturnRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoSetterReturnRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `function foo() { return 1; }`},
+			{Code: `const foo = { set a(val) { } };`},
+			{Code: `const foo = { set a(val) { return; } };`},
+			{Code: `const foo = { set a(val) { if (val) { return; } bar(); } };`},
+			{Code: `const foo = { get a() { return 1; } };`},
+			{Code: `const foo = { set a(val) { function f() { return 1; } } };`},
+			{Code: `const foo = { set a(val) { const f = () => 1; } };`},
+			{Code: `const foo = { set a(val) { const f = () => { return 1; }; } };`},
+			{Code: `class A { set a(val) { this._a = val; } }`},
+			{Code: `class A { static set a(val) { return; } }`},
+			{Code: `class A { set(val) { return 1; } }`},
+			{Code: `const foo = { set: function(val) { return 1; } };`},
+			{Code: `const foo = { set(val) { return 1; } };`},
+
+			// Property descriptors
+			{Code: `Object.defineProperty(foo, 'bar', { set(val) { return; } });`},
+			{Code: `Object.defineProperty(foo, 'bar', { get() { return 1; } });`},
+			{Code: `Reflect.defineProperty(foo, 'bar', { set: function(val) { } });`},
+			{Code: `Object.defineProperties(foo, { bar: { set(val) { return; } } });`},
+			{Code: `Object.create(null, { bar: { set: val => { } } });`},
+			{Code: `Object.defineProperty(foo, 'bar', { set: val => { foo._bar = val; } });`},
+			{Code: `foo.defineProperty(foo, 'bar', { set(val) { return 1; } });`},
+			{Code: `Object.defineProperty(foo, 'bar', { get set() { return 1; } });`},
+			{Code: `Object.defineProperty(foo, { set(val) { return 1; } });`},
+			{Code: `Object.defineProperties(foo, { set(val) { return 1; } });`},
+			{Code: `function f(Object: any) { Object.defineProperty(foo, 'bar', { set(val) { return 1; } }); }`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `const foo = { set a(val) { return val; } };`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnsValue", Line: 1, Column: 28},
+				},
+			},
+			{
+				Code: `const foo = { set a(val) { if (val) { return 1; } } };`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnsValue", Line: 1, Column: 39},
+				},
+			},
+			{
+				Code: `class A { set a(val) { return undefined; } }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnsValue", Line: 1, Column: 24},
+				},
+			},
+			{
+				Code: `class A { static set a(val) { if (val) { return 1; } else { return 2; } } }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnsValue", Line: 1, Column: 42},
+					{MessageId: "returnsValue", Line: 1, Column: 61},
+				},
+			},
+			{
+				Code: `const A = class { set a(val) { try { return 1; } catch (e) {} } };`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnsValue", Line: 1, Column: 38},
+				},
+			},
+
+			// Property descriptors
+			{
+				Code: `Object.defineProperty(foo, 'bar', { set(val) { return 1; } });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnsValue", Line: 1, Column: 48},
+				},
+			},
+			{
+				Code: `Reflect.defineProperty(foo, 'bar', { set: function(val) { return 1; } });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnsValue", Line: 1, Column: 59},
+				},
+			},
+			{
+				Code: `Object.defineProperty(foo, 'bar', { 'set': (val) => { return 1; } });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnsValue", Line: 1, Column: 55},
+				},
+			},
+			{
+				Code: `Object.defineProperty(foo, 'bar', { set: val => val });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnsValue", Line: 1, Column: 49},
+				},
+			},
+			{
+				Code: `Object.defineProperty(foo, 'bar', { ['set'](val) { return 1; } });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnsValue", Line: 1, Column: 52},
+				},
+			},
+			{
+				Code: `Object.defineProperties(foo, { bar: { set(val) { return 1; } } });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnsValue", Line: 1, Column: 50},
+				},
+			},
+			{
+				Code: `Object.create(null, { bar: { set: (function(val) { return 1; }) } });`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnsValue", Line: 1, Column: 52},
+				},
+			},
+			{
+				Code: `Object.defineProperty(foo, 'bar', ({ set(val) { return 1; } }));`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "returnsValue", Line: 1, Column: 49},
+				},
+			},
+		},
+	)
+}
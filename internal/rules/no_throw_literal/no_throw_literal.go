@@ -0,0 +1,82 @@
+package no_throw_literal
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// Message builders
+func buildObjectMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "object",
+		Description: "Expected an error object to be thrown.",
+	}
+}
+
+func buildUndefMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "undef",
+		Description: "Do not throw undefined.",
+	}
+}
+
+// skipOuterExpressions skips parentheses and type-only wrappers, which don't change the thrown value
+func skipOuterExpressions(node *ast.Node) *ast.Node {
+	for {
+		switch node.Kind {
+		case ast.KindParenthesizedExpression, ast.KindAsExpression, ast.KindSatisfiesExpression,
+			ast.KindNonNullExpression, ast.KindTypeAssertionExpression:
+			node = node.Expression()
+		default:
+			return node
+		}
+	}
+}
+
+// couldBeError checks whether node may evaluate to an error object, judging by its syntax alone
+func couldBeError(node *ast.Node) bool {
+	node = skipOuterExpressions(node)
+	switch node.Kind {
+	case ast.KindIdentifier, ast.KindCallExpression, ast.KindNewExpression, ast.KindPropertyAccessExpression,
+		ast.KindElementAccessExpression, ast.KindTaggedTemplateExpression, ast.KindYieldExpression,
+		ast.KindAwaitExpression:
+		return true
+	case ast.KindConditionalExpression:
+		conditional := node.AsConditionalExpression()
+		return couldBeError(conditional.WhenTrue) || couldBeError(conditional.WhenFalse)
+	case ast.KindBinaryExpression:
+		binary := node.AsBinaryExpression()
+		switch binary.OperatorToken.Kind {
+		case ast.KindEqualsToken, ast.KindAmpersandAmpersandToken, ast.KindAmpersandAmpersandEqualsToken,
+			ast.KindCommaToken:
+			return couldBeError(binary.Right)
+		case ast.KindBarBarToken, ast.KindQuestionQuestionToken, ast.KindBarBarEqualsToken,
+			ast.KindQuestionQuestionEqualsToken:
+			return couldBeError(binary.Left) || couldBeError(binary.Right)
+		}
+	}
+	return false
+}
+
+// NoThrowLiteralRule disallows throwing literals and other expressions that can't be errors. Unlike
+// only-throw-error it doesn't need type information, so the allowThrowingAny and allowThrowingUnknown
+// options of that rule have no effect here.
+var NoThrowLiteralRule = rule.CreateRule(rule.Rule{
+	Name: "no-throw-literal",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		return rule.RuleListeners{
+			ast.KindThrowStatement: func(node *ast.Node) {
+				expr := node.Expression()
+				if !couldBeError(expr) {
+					ctx.ReportNode(node, buildObjectMessage())
+					return
+				}
+
+				expr = skipOuterExpressions(expr)
+				if expr.Kind == ast.KindIdentifier && expr.Text() == "undefined" {
+					ctx.ReportNode(node, buildUndefMessage())
+				}
+			},
+		}
+	},
+})
@@ -0,0 +1,126 @@
+package no_throw_literal
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoThrowLiteralRule(t *testing.T) {
+	rule_tester.RunRuleTester(fixtures.GetRootDir(), "tsconfig.json", t, &NoThrowLiteralRule, []rule_tester.ValidTestCase{
+		{Code: `throw new Error();`},
+		{Code: `throw new Error('error');`},
+		{Code: `throw Error('error');`},
+		{Code: `const e = new Error(); throw e;`},
+		{Code: `declare const foo: { bar: Error }; throw foo.bar;`},
+		{Code: `declare const foo: { bar: Error }; throw foo['bar'];`},
+		{Code: `declare const foo: any; throw foo.bar();`},
+		{Code: `declare const foo: any; throw foo` + "`bar`" + `;`},
+		{Code: `declare let e: Error; throw e = new Error();`},
+		{Code: `declare const a: any, b: Error; throw a || b;`},
+		{Code: `declare const a: any, b: Error; throw a && b;`},
+		{Code: `declare const a: any, b: Error; throw a ?? b;`},
+		{Code: `declare const a: any, b: Error; throw a ? new Error() : b;`},
+		{Code: `declare const a: any, b: Error; throw (a, b);`},
+		{Code: `async function f() { throw await Promise.resolve(new Error()); }`},
+		{Code: `function* f() { throw yield; }`},
+		{Code: `declare const e: unknown; throw e as Error;`},
+		{Code: `declare const e: Error | undefined; throw e!;`},
+		{Code: `throw new Error() satisfies Error;`},
+		// Without type information, any and unknown values could be errors
+		{Code: `declare const e: any; throw e;`, Options: map[string]interface{}{"allowThrowingAny": false}},
+		{Code: `declare const e: unknown; throw e;`, Options: map[string]interface{}{"allowThrowingUnknown": false}},
+	}, []rule_tester.InvalidTestCase{
+		{
+			Code: `throw 'err';`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "object", Line: 1, Column: 1, EndColumn: 13},
+			},
+		},
+		{
+			Code: `throw 0;`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "object", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code: `throw false;`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "object", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code: `throw null;`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "object", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code: `throw {};`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "object", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code: `throw { message: 'err' } as Error;`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "object", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code: `throw undefined;`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "undef", Line: 1, Column: 1, EndColumn: 17},
+			},
+		},
+		{
+			Code: "declare const y: string; throw `x${y}`;",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "object", Line: 1, Column: 26},
+			},
+		},
+		{
+			Code: "throw `err`;",
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "object", Line: 1, Column: 1},
+			},
+		},
+		{
+			Code: `declare const a: any; throw 'a' + a;`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "object", Line: 1, Column: 23},
+			},
+		},
+		{
+			Code: `declare let e: any; throw e = 'err';`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "object", Line: 1, Column: 21},
+			},
+		},
+		{
+			Code: `declare let e: any; throw e += 'err';`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "object", Line: 1, Column: 21},
+			},
+		},
+		{
+			Code: `declare const a: any; throw a && 'err';`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "object", Line: 1, Column: 23},
+			},
+		},
+		{
+			Code: `declare const a: any; throw a ? 'a' : 'b';`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "object", Line: 1, Column: 23},
+			},
+		},
+		{
+			Code: `declare const a: any; throw (a, 'err');`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{MessageId: "object", Line: 1, Column: 23},
+			},
+		},
+	})
+}
@@ -0,0 +1,105 @@
+package no_unexpected_multiline
+
+import (
+	"regexp"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builders
+func buildFunctionMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "function",
+		Description: "Unexpected newline between function and ( of function call.",
+	}
+}
+
+func buildPropertyMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "property",
+		Description: "Unexpected newline between object and [ of property access.",
+	}
+}
+
+func buildTaggedTemplateMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "taggedTemplate",
+		Description: "Unexpected newline between template tag and template literal.",
+	}
+}
+
+func buildDivisionMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "division",
+		Description: "Unexpected newline between numerator and division operator.",
+	}
+}
+
+var regexFlagMatcher = regexp.MustCompile(`^[gimsuyvd]+$`)
+
+func isDivision(node *ast.Node) bool {
+	return node.Kind == ast.KindBinaryExpression && node.AsBinaryExpression().OperatorToken.Kind == ast.KindSlashToken
+}
+
+// NoUnexpectedMultilineRule disallows confusing multiline expressions
+var NoUnexpectedMultilineRule = rule.CreateRule(rule.Rule{
+	Name: "no-unexpected-multiline",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		getLine := func(pos int) int {
+			line, _ := scanner.GetLineAndCharacterOfPosition(ctx.SourceFile, pos)
+			return line
+		}
+
+		// checkForBreakAfter reports the token following node when a line break separates them
+		checkForBreakAfter := func(node *ast.Node, msg rule.RuleMessage) {
+			token := ctx.GetTokenAfter(node)
+			if token != nil && getLine(token.Range.Pos()) != getLine(node.End()) {
+				ctx.ReportRange(token.Range, msg)
+			}
+		}
+
+		return rule.RuleListeners{
+			ast.KindCallExpression: func(node *ast.Node) {
+				call := node.AsCallExpression()
+				if len(call.Arguments.Nodes) == 0 || call.QuestionDotToken != nil {
+					return
+				}
+				checkForBreakAfter(call.Expression, buildFunctionMessage())
+			},
+			ast.KindElementAccessExpression: func(node *ast.Node) {
+				access := node.AsElementAccessExpression()
+				if access.QuestionDotToken != nil {
+					return
+				}
+				checkForBreakAfter(access.Expression, buildPropertyMessage())
+			},
+			ast.KindTaggedTemplateExpression: func(node *ast.Node) {
+				// The token before the template is the end of the tag or of its type arguments
+				template := node.AsTaggedTemplateExpression().Template
+				templateStart := utils.TrimNodeTextRange(ctx.SourceFile, template).Pos()
+				token := ctx.GetTokenBefore(template)
+				if token != nil && getLine(token.Range.End()) != getLine(templateStart) {
+					ctx.ReportRange(core.NewTextRange(templateStart, templateStart+1), buildTaggedTemplateMessage())
+				}
+			},
+			ast.KindBinaryExpression: func(node *ast.Node) {
+				// `a\n/foo/g` continues as `a / foo / g` rather than starting a regular expression
+				if !isDivision(node) || !isDivision(node.AsBinaryExpression().Left) {
+					return
+				}
+				secondSlash := node.AsBinaryExpression().OperatorToken
+				tokenAfterOperator := ctx.GetFirstToken(node.AsBinaryExpression().Right)
+				if tokenAfterOperator == nil || tokenAfterOperator.Kind != ast.KindIdentifier ||
+					!regexFlagMatcher.MatchString(tokenAfterOperator.Text) ||
+					tokenAfterOperator.Range.Pos() != secondSlash.End() {
+					return
+				}
+				checkForBreakAfter(node.AsBinaryExpression().Left.AsBinaryExpression().Left, buildDivisionMessage())
+			},
+		}
+	},
+})
@@ -0,0 +1,125 @@
+package no_unexpected_multiline
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoUnexpectedMultilineRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoUnexpectedMultilineRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: "(x || y).aFunction()"},
+			{Code: "[a, b, c].forEach(doSomething)"},
+			{Code: "var a = b;\n(x || y).doSomething()"},
+			{Code: "var a = b\n;(x || y).doSomething()"},
+			{Code: "var a = b\nvoid (x || y).doSomething()"},
+			{Code: "var a = b;\n[1, 2, 3].forEach(console.log)"},
+			{Code: "var a = b\nvoid [1, 2, 3].forEach(console.log)"},
+			{Code: "var a = (\n(123)\n)"},
+			{Code: "f(\n(x)\n)"},
+			{Code: "(\nfunction () {}\n)[1]"},
+			{Code: "let x = function() {};\n   `hello`"},
+			{Code: "let x = function() {}\nx `hello`"},
+			{Code: "String.raw `Hi\n${2+3}!`;"},
+			{Code: "x\n.y\nz `Valid Test Case`"},
+			{Code: "f(x\n)`Valid Test Case`"},
+			{Code: "x.\ny `Valid Test Case`"},
+			{Code: "(x\n)`Valid Test Case`"},
+			{Code: "foo\n/ bar/2"},
+			{Code: "foo\n/ bar/ g"},
+			{Code: "foo\n/bar/ g"},
+			{Code: "foo /\n bar/g"},
+			{Code: "var a = b\n?.(x || y).doSomething()"},
+			{Code: "var a = b\n?.[a, b, c].forEach(doSomething)"},
+			{Code: "var a = b\n()"},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: "var a = b\n(x || y).doSomething()",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "function", Line: 2, Column: 1},
+				},
+			},
+			{
+				Code: "var a = (a || b)\n(x || y).doSomething()",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "function", Line: 2, Column: 1},
+				},
+			},
+			{
+				Code: "var a = (a || b)\n(x).doSomething()",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "function", Line: 2, Column: 1},
+				},
+			},
+			{
+				Code: "var a = b\n    (x || y).doSomething()",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "function", Line: 2, Column: 5},
+				},
+			},
+			{
+				Code: "var a = b\n[a, b, c].forEach(doSomething)",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "property", Line: 2, Column: 1},
+				},
+			},
+			{
+				Code: "var a = b\n  [a, b, c].forEach(doSomething)",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "property", Line: 2, Column: 3},
+				},
+			},
+			{
+				Code: "let x = function() {}\n `hello`",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "taggedTemplate", Line: 2, Column: 2},
+				},
+			},
+			{
+				Code: "let x = function() {}\nx\n`hello`",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "taggedTemplate", Line: 3, Column: 1},
+				},
+			},
+			{
+				Code: "x\n.y\nz\n`Invalid Test Case`",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "taggedTemplate", Line: 4, Column: 1},
+				},
+			},
+			{
+				Code: "const x = foo<string>\n`hello`",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "taggedTemplate", Line: 2, Column: 1},
+				},
+			},
+			{
+				Code: "foo\n/bar/g.test(baz)",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "division", Line: 2, Column: 1},
+				},
+			},
+			{
+				Code: "hello\n/bar/gimsuy.test(baz)",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "division", Line: 2, Column: 1},
+				},
+			},
+			{
+				Code: "let x = a\n  /foo/g",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "division", Line: 2, Column: 3},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,315 @@
+package no_unmodified_loop_condition
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// Message builders
+func buildLoopConditionNotModifiedMessage(name string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "loopConditionNotModified",
+		Description: "'" + name + "' is not modified in this loop.",
+	}
+}
+
+// loopCondition is a variable reference in the condition of a loop
+type loopCondition struct {
+	reference *ast.Node
+	symbol    *ast.Symbol
+	// group is the outermost binary or conditional expression containing the reference,
+	// whose value may change when any of its operands is modified
+	group    *ast.Node
+	modified bool
+}
+
+// getLoopTest returns the condition of a while, do-while or for loop
+func getLoopTest(node *ast.Node) *ast.Node {
+	switch node.Kind {
+	case ast.KindWhileStatement:
+		return node.AsWhileStatement().Expression
+	case ast.KindDoStatement:
+		return node.AsDoStatement().Expression
+	case ast.KindForStatement:
+		return node.AsForStatement().Condition
+	}
+	return nil
+}
+
+// isInLoop checks whether node is evaluated on each iteration of loop,
+// i.e. it is inside the loop but not in the initializer of a for statement
+func isInLoop(loop *ast.Node, node *ast.Node) bool {
+	if node.Pos() < loop.Pos() || node.End() > loop.End() {
+		return false
+	}
+	if loop.Kind == ast.KindForStatement {
+		initializer := loop.AsForStatement().Initializer
+		if initializer != nil && node.Pos() >= initializer.Pos() && node.End() <= initializer.End() {
+			return false
+		}
+	}
+	return true
+}
+
+// isSentinel checks whether a reference below node cannot be a plain operand of a loop condition
+func isSentinel(node *ast.Node) bool {
+	switch node.Kind {
+	case ast.KindCallExpression,
+		ast.KindNewExpression,
+		ast.KindPropertyAccessExpression,
+		ast.KindElementAccessExpression,
+		ast.KindYieldExpression,
+		ast.KindClassExpression:
+		return true
+	}
+	return ast.IsFunctionLike(node) || ast.IsStatement(node)
+}
+
+// isGroup checks for comparisons and other binary or conditional expressions,
+// which change when either operand changes
+func isGroup(node *ast.Node) bool {
+	switch node.Kind {
+	case ast.KindConditionalExpression:
+		return true
+	case ast.KindBinaryExpression:
+		operator := node.AsBinaryExpression().OperatorToken.Kind
+		return !ast.IsLogicalOrCoalescingBinaryOperator(operator) &&
+			!ast.IsAssignmentOperator(operator) &&
+			operator != ast.KindCommaToken
+	}
+	return false
+}
+
+// hasDynamicExpressions checks whether node contains an expression whose value may change
+// without any variable being modified, such as a call or a member access
+func hasDynamicExpressions(node *ast.Node) bool {
+	found := false
+	var visit func(n *ast.Node) bool
+	visit = func(n *ast.Node) bool {
+		switch n.Kind {
+		case ast.KindCallExpression,
+			ast.KindNewExpression,
+			ast.KindPropertyAccessExpression,
+			ast.KindElementAccessExpression,
+			ast.KindTaggedTemplateExpression,
+			ast.KindYieldExpression:
+			found = true
+			return true
+		case ast.KindFunctionExpression, ast.KindArrowFunction, ast.KindClassExpression:
+			return false
+		}
+		return n.ForEachChild(visit)
+	}
+	visit(node)
+	return found
+}
+
+// isVarDeclaration checks whether a variable declaration is function scoped
+func isVarDeclaration(node *ast.Node) bool {
+	return ast.GetCombinedNodeFlags(node)&ast.NodeFlagsBlockScoped == 0
+}
+
+// isWriteReference checks whether identifier is assigned a value. Like ESLint's scope analysis,
+// declarations only count as writes for `var` with an initializer or in a for-in/for-of head
+func isWriteReference(node *ast.Node) bool {
+	if ast.IsAssignmentTarget(node) {
+		return true
+	}
+
+	declaration := node.Parent
+	if declaration.Kind != ast.KindVariableDeclaration && declaration.Kind != ast.KindBindingElement {
+		return false
+	}
+	if declaration.Name() != node {
+		return false
+	}
+	for declaration.Kind == ast.KindBindingElement {
+		declaration = declaration.Parent.Parent
+	}
+	if declaration.Kind != ast.KindVariableDeclaration || !isVarDeclaration(declaration) {
+		return false
+	}
+	if declaration.Initializer() != nil {
+		return true
+	}
+	list := declaration.Parent
+	return list != nil && list.Parent != nil && ast.IsForInOrOfStatement(list.Parent)
+}
+
+// getEnclosingFunctionDeclaration returns the named function declaration containing node
+func getEnclosingFunctionDeclaration(node *ast.Node) *ast.Node {
+	for current := node.Parent; current != nil; current = current.Parent {
+		if current.Kind == ast.KindFunctionDeclaration {
+			if current.Name() == nil {
+				return nil
+			}
+			return current
+		}
+	}
+	return nil
+}
+
+// NoUnmodifiedLoopConditionRule disallows unmodified loop conditions
+var NoUnmodifiedLoopConditionRule = rule.CreateRule(rule.Rule{
+	Name: "no-unmodified-loop-condition",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		if ctx.TypeChecker == nil {
+			return rule.RuleListeners{}
+		}
+
+		getReferencedSymbol := func(identifier *ast.Node) *ast.Symbol {
+			parent := identifier.Parent
+			if parent.Kind == ast.KindShorthandPropertyAssignment && parent.Name() == identifier {
+				return ctx.TypeChecker.GetShorthandAssignmentValueSymbol(parent)
+			}
+			return ctx.TypeChecker.GetSymbolAtLocation(identifier)
+		}
+
+		// isLocalVariable checks whether symbol is a variable, function, class or import declared in this file
+		isLocalVariable := func(symbol *ast.Symbol) bool {
+			if symbol == nil || symbol.Flags&(ast.SymbolFlagsVariable|ast.SymbolFlagsFunction|ast.SymbolFlagsClass|ast.SymbolFlagsAlias) == 0 {
+				return false
+			}
+			for _, declaration := range symbol.Declarations {
+				if ast.GetSourceFileOfNode(declaration) == ctx.SourceFile {
+					return true
+				}
+			}
+			return false
+		}
+
+		// Write references of the whole file, collected on first use
+		var writes map[*ast.Symbol][]*ast.Node
+		getWrites := func(symbol *ast.Symbol) []*ast.Node {
+			if writes == nil {
+				writes = make(map[*ast.Symbol][]*ast.Node)
+				var visit func(node *ast.Node) bool
+				visit = func(node *ast.Node) bool {
+					if node.Kind == ast.KindIdentifier && isWriteReference(node) {
+						if symbol := getReferencedSymbol(node); symbol != nil {
+							writes[symbol] = append(writes[symbol], node)
+						}
+					}
+					node.ForEachChild(visit)
+					return false
+				}
+				ctx.SourceFile.AsNode().ForEachChild(visit)
+			}
+			return writes[symbol]
+		}
+
+		// isReferencedInLoop checks whether symbol is referenced on each iteration of loop
+		isReferencedInLoop := func(loop *ast.Node, symbol *ast.Symbol) bool {
+			found := false
+			var visit func(node *ast.Node) bool
+			visit = func(node *ast.Node) bool {
+				if node.Kind == ast.KindIdentifier && !ast.IsDeclarationName(node) &&
+					isInLoop(loop, node) && getReferencedSymbol(node) == symbol {
+					found = true
+					return true
+				}
+				return node.ForEachChild(visit)
+			}
+			loop.ForEachChild(visit)
+			return found
+		}
+
+		// isModifiedInLoop checks whether symbol is written inside loop,
+		// or inside a function declaration that is called in loop
+		isModifiedInLoop := func(loop *ast.Node, symbol *ast.Symbol) bool {
+			for _, write := range getWrites(symbol) {
+				if isInLoop(loop, write) {
+					return true
+				}
+				function := getEnclosingFunctionDeclaration(write)
+				if function == nil {
+					continue
+				}
+				functionSymbol := ctx.TypeChecker.GetSymbolAtLocation(function.Name())
+				if functionSymbol != nil && isReferencedInLoop(loop, functionSymbol) {
+					return true
+				}
+			}
+			return false
+		}
+
+		// toLoopCondition returns the condition info for a reference in test,
+		// or nil if the reference is not a plain operand of the condition
+		toLoopCondition := func(test *ast.Node, reference *ast.Node) *loopCondition {
+			var group *ast.Node
+			for node := reference; node != test; {
+				node = node.Parent
+				if isSentinel(node) {
+					return nil
+				}
+				if isGroup(node) {
+					// A dynamic expression may change on its own, no need to check
+					if hasDynamicExpressions(node) {
+						return nil
+					}
+					group = node
+				}
+			}
+
+			symbol := getReferencedSymbol(reference)
+			if !isLocalVariable(symbol) {
+				return nil
+			}
+			return &loopCondition{
+				reference: reference,
+				symbol:    symbol,
+				group:     group,
+			}
+		}
+
+		checkLoop := func(node *ast.Node) {
+			test := getLoopTest(node)
+			if test == nil {
+				return
+			}
+
+			var conditions []*loopCondition
+			var collect func(n *ast.Node) bool
+			collect = func(n *ast.Node) bool {
+				if n.Kind == ast.KindIdentifier {
+					if condition := toLoopCondition(test, n); condition != nil {
+						conditions = append(conditions, condition)
+					}
+					return false
+				}
+				if ast.IsFunctionLike(n) || ast.IsClassLike(n) || ast.IsPartOfTypeNode(n) {
+					return false
+				}
+				n.ForEachChild(collect)
+				return false
+			}
+			collect(test)
+
+			if len(conditions) == 0 {
+				return
+			}
+
+			modifiedGroups := make(map[*ast.Node]bool)
+			for _, condition := range conditions {
+				condition.modified = isModifiedInLoop(node, condition.symbol)
+				if condition.modified && condition.group != nil {
+					modifiedGroups[condition.group] = true
+				}
+			}
+
+			// A group is fine as long as any of its operands is modified
+			for _, condition := range conditions {
+				if condition.modified || (condition.group != nil && modifiedGroups[condition.group]) {
+					continue
+				}
+				ctx.ReportNode(condition.reference, buildLoopConditionNotModifiedMessage(condition.reference.Text()))
+			}
+		}
+
+		return rule.RuleListeners{
+			ast.KindWhileStatement: checkLoop,
+			ast.KindDoStatement:    checkLoop,
+			ast.KindForStatement:   checkLoop,
+		}
+	},
+})
@@ -0,0 +1,118 @@
+package no_unmodified_loop_condition
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoUnmodifiedLoopConditionRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoUnmodifiedLoopConditionRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `var foo = 0; while (foo) { ++foo; }`},
+			{Code: `var foo = 0; while (foo) { foo += 1; }`},
+			{Code: `var foo = 0; while (foo++) { }`},
+			{Code: `var foo = 0; while (foo = next()) { }`},
+			{Code: `var foo = 0; while (ok(foo)) { }`},
+			{Code: `var foo = 0, bar = 0; while (++foo < bar) { }`},
+			{Code: `var foo = 0, obj = {}; while (foo === obj.bar) { }`},
+			{Code: `var foo = 0, f = {}, bar = {}; while (foo === f(bar)) { }`},
+			{Code: `var foo = 0, f = {}; while (foo === f()) { }`},
+			{Code: `var foo = 0, tag = 0; while (foo === tag` + "`abc`" + `) { }`},
+			{Code: `function* foo() { var foo = 0; while (yield foo) { } }`},
+			{Code: `function* foo() { var foo = 0; while (foo === (yield)) { } }`},
+			{Code: `var foo = 0; while (foo.ok) { }`},
+			{Code: `var foo = 0; while (foo) { update(); } function update() { ++foo; }`},
+			{Code: `var foo = 0, bar = 9; while (foo < bar) { foo += 1; }`},
+			{Code: `var foo = 0, bar = 1, baz = 2; while (foo ? 1 : 0) { foo += 1; }`},
+			{Code: `var foo = 0; while (foo) { (function () { foo = 1; }); }`},
+			{Code: `var foo = 0; while (foo) { [foo] = [1]; }`},
+			{Code: `var foo = 0; while (foo) { ({ foo } = { foo: 1 }); }`},
+			{Code: `var foo = 0; while (foo) { for (foo of []); }`},
+			{Code: `var foo = 0; do { foo--; } while (foo);`},
+			{Code: `for (var foo = 0; foo < 10; ++foo) { }`},
+			{Code: `for (let foo = 0; foo < 10; foo++) { }`},
+			{Code: `for (var foo = 0; foo < 10; ) { foo = foo + 1; }`},
+			{Code: `for (;;) { }`},
+			{Code: `while (undefinedGlobal) { }`},
+			{Code: `var foo = 0; while (foo && bar()) { foo++; }`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `var foo = 0; while (foo) { } foo = 1;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "loopConditionNotModified", Line: 1, Column: 21},
+				},
+			},
+			{
+				Code: `var foo = 0; while (!foo) { } foo = 1;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "loopConditionNotModified", Line: 1, Column: 22},
+				},
+			},
+			{
+				Code: `var foo = 0; while (foo != null) { } foo = 1;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "loopConditionNotModified", Line: 1, Column: 21},
+				},
+			},
+			{
+				Code: `var foo = 0, bar = 9; while (foo < bar) { } foo = 1;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "loopConditionNotModified", Line: 1, Column: 30},
+					{MessageId: "loopConditionNotModified", Line: 1, Column: 36},
+				},
+			},
+			{
+				Code: `var foo = 0, bar = 0, baz = 0; while (foo & bar) { baz = 1; } foo = 1;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "loopConditionNotModified", Line: 1, Column: 39},
+					{MessageId: "loopConditionNotModified", Line: 1, Column: 45},
+				},
+			},
+			{
+				Code: `var foo = 0; while (foo) { update(); } function update(foo) { ++foo; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "loopConditionNotModified", Line: 1, Column: 21},
+				},
+			},
+			{
+				Code: `var foo = 0; while (foo) { } function update() { ++foo; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "loopConditionNotModified", Line: 1, Column: 21},
+				},
+			},
+			{
+				Code: `var foo = 0; do { } while (foo); foo = 1;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "loopConditionNotModified", Line: 1, Column: 28},
+				},
+			},
+			{
+				Code: `for (var foo = 0; foo < 10; ) { }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "loopConditionNotModified", Line: 1, Column: 19},
+				},
+			},
+			{
+				Code: `let i = 0; while (i < 10) { console.log(i); }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "loopConditionNotModified", Line: 1, Column: 19},
+				},
+			},
+			{
+				Code: `var foo = 0, bar = 0; while (foo && bar) { foo = 1; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "loopConditionNotModified", Line: 1, Column: 37},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,164 @@
+package no_unneeded_ternary
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+type NoUnneededTernaryOptions struct {
+	DefaultAssignment bool `json:"defaultAssignment"`
+}
+
+// Message builders
+func buildUnnecessaryConditionalExpressionMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unnecessaryConditionalExpression",
+		Description: "Unnecessary use of boolean literals in conditional expression.",
+	}
+}
+
+func buildUnnecessaryConditionalAssignmentMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unnecessaryConditionalAssignment",
+		Description: "Unnecessary use of conditional expression for default assignment.",
+	}
+}
+
+// operatorInverses maps equality operators to their negations
+var operatorInverses = map[ast.Kind]string{
+	ast.KindEqualsEqualsToken:            "!=",
+	ast.KindExclamationEqualsToken:       "==",
+	ast.KindEqualsEqualsEqualsToken:      "!==",
+	ast.KindExclamationEqualsEqualsToken: "===",
+}
+
+// isBooleanLiteral checks whether node is `true` or `false`
+func isBooleanLiteral(node *ast.Node) bool {
+	node = ast.SkipParentheses(node)
+	return node.Kind == ast.KindTrueKeyword || node.Kind == ast.KindFalseKeyword
+}
+
+// isBooleanExpression checks whether node always evaluates to a boolean
+func isBooleanExpression(node *ast.Node) bool {
+	switch node.Kind {
+	case ast.KindBinaryExpression:
+		switch node.AsBinaryExpression().OperatorToken.Kind {
+		case ast.KindEqualsEqualsToken, ast.KindEqualsEqualsEqualsToken, ast.KindExclamationEqualsToken,
+			ast.KindExclamationEqualsEqualsToken, ast.KindGreaterThanToken, ast.KindGreaterThanEqualsToken,
+			ast.KindLessThanToken, ast.KindLessThanEqualsToken, ast.KindInKeyword, ast.KindInstanceOfKeyword:
+			return true
+		}
+	case ast.KindPrefixUnaryExpression:
+		return node.AsPrefixUnaryExpression().Operator == ast.KindExclamationToken
+	}
+	return false
+}
+
+// matchesDefaultAssignment checks whether node has the form `x ? x : y`
+func matchesDefaultAssignment(node *ast.Node) bool {
+	conditional := node.AsConditionalExpression()
+	test := ast.SkipParentheses(conditional.Condition)
+	consequent := ast.SkipParentheses(conditional.WhenTrue)
+	return test.Kind == ast.KindIdentifier && consequent.Kind == ast.KindIdentifier && test.Text() == consequent.Text()
+}
+
+// NoUnneededTernaryRule disallows ternary operators when simpler alternatives exist
+var NoUnneededTernaryRule = rule.CreateRule(rule.Rule{
+	Name: "no-unneeded-ternary",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := NoUnneededTernaryOptions{
+			DefaultAssignment: true,
+		}
+
+		// Parse options with dual-format support (handles both array and object formats)
+		var optsMap map[string]interface{}
+		if options != nil {
+			switch v := options.(type) {
+			case []interface{}:
+				if len(v) > 0 {
+					optsMap, _ = v[0].(map[string]interface{})
+				}
+			case map[string]interface{}:
+				optsMap = v
+			}
+		}
+		if optsMap != nil {
+			if defaultAssignment, ok := optsMap["defaultAssignment"].(bool); ok {
+				opts.DefaultAssignment = defaultAssignment
+			}
+		}
+
+		getText := func(node *ast.Node) string {
+			textRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			return ctx.SourceFile.Text()[textRange.Pos():textRange.End()]
+		}
+
+		// invertExpression returns the text of the logical negation of node
+		invertExpression := func(node *ast.Node) string {
+			if node.Kind == ast.KindBinaryExpression {
+				binary := node.AsBinaryExpression()
+				if inverse, ok := operatorInverses[binary.OperatorToken.Kind]; ok {
+					operatorRange := utils.TrimNodeTextRange(ctx.SourceFile, binary.OperatorToken)
+					nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+					text := ctx.SourceFile.Text()
+					return text[nodeRange.Pos():operatorRange.Pos()] + inverse + text[operatorRange.End():nodeRange.End()]
+				}
+			}
+			if ast.GetExpressionPrecedence(node) < ast.OperatorPrecedenceUnary {
+				return "!(" + getText(node) + ")"
+			}
+			return "!" + getText(node)
+		}
+
+		return rule.RuleListeners{
+			ast.KindConditionalExpression: func(node *ast.Node) {
+				conditional := node.AsConditionalExpression()
+				nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+
+				if isBooleanLiteral(conditional.WhenTrue) && isBooleanLiteral(conditional.WhenFalse) {
+					msg := buildUnnecessaryConditionalExpressionMessage()
+					consequentIsTrue := ast.SkipParentheses(conditional.WhenTrue).Kind == ast.KindTrueKeyword
+					alternateIsTrue := ast.SkipParentheses(conditional.WhenFalse).Kind == ast.KindTrueKeyword
+					test := ast.SkipParentheses(conditional.Condition)
+
+					var replacement string
+					switch {
+					case consequentIsTrue == alternateIsTrue:
+						// `foo ? true : true` is just `true`, but `foo() ? true : true` has to keep the call
+						if test.Kind != ast.KindIdentifier {
+							ctx.ReportNode(node, msg)
+							return
+						}
+						replacement = "false"
+						if consequentIsTrue {
+							replacement = "true"
+						}
+					case alternateIsTrue:
+						// `foo ? false : true` is `!foo`
+						replacement = invertExpression(conditional.Condition)
+					case isBooleanExpression(test):
+						// `a === b ? true : false` is `a === b`
+						replacement = getText(conditional.Condition)
+					default:
+						// `foo ? true : false` is `!!foo`
+						replacement = "!" + invertExpression(conditional.Condition)
+					}
+					ctx.ReportNodeWithFixes(node, msg, rule.RuleFixReplaceRange(nodeRange, replacement))
+					return
+				}
+
+				if !opts.DefaultAssignment && matchesDefaultAssignment(node) {
+					alternate := conditional.WhenFalse
+					alternateText := getText(alternate)
+					// `x ? x : a ?? b` becomes `x || (a ?? b)`, mixing `||` and `??` is a syntax error
+					if ast.GetExpressionPrecedence(alternate) < ast.OperatorPrecedenceLogicalOR {
+						alternateText = "(" + alternateText + ")"
+					}
+					ctx.ReportNodeWithFixes(node, buildUnnecessaryConditionalAssignmentMessage(),
+						rule.RuleFixReplaceRange(nodeRange, getText(conditional.Condition)+" || "+alternateText))
+				}
+			},
+		}
+	},
+})
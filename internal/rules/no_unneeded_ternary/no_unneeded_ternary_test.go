@@ -0,0 +1,202 @@
+package no_unneeded_ternary
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoUnneededTernaryRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoUnneededTernaryRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `config.newIsCap = config.newIsCap !== false`},
+			{Code: `var a = x === 2 ? 'Yes' : 'No';`},
+			{Code: `var a = x === 2 ? true : 'No';`},
+			{Code: `var a = x === 2 ? 'Yes' : false;`},
+			{Code: `var a = x === 2 ? 'true' : 'false';`},
+			{Code: `var a = foo ? foo : bar;`},
+			{Code: `var value = 'a';var canSet = true;var result = value || (canSet ? 'unset' : 'can not set')`},
+			{Code: `var a = foo ? bar : foo;`},
+			{Code: `foo ? bar : foo;`},
+			{Code: `var a = f(x ? x : 1)`},
+			{Code: `f(x ? x : 1);`},
+			{Code: `foo ? foo : bar;`},
+			{Code: `var a = foo ? 'Yes' : foo;`},
+			{Code: `var a = foo ? bar : foo;`, Options: map[string]interface{}{"defaultAssignment": false}},
+			{Code: `foo ? bar : foo;`, Options: map[string]interface{}{"defaultAssignment": false}},
+			{Code: `var a = foo.bar ? foo.bar : 1;`, Options: map[string]interface{}{"defaultAssignment": false}},
+			{Code: `var a = x ? y : 1;`, Options: []interface{}{map[string]interface{}{"defaultAssignment": false}}},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:   `var a = x === 2 ? true : false;`,
+				Output: []string{`var a = x === 2;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalExpression", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:   `var a = x >= 2 ? true : false;`,
+				Output: []string{`var a = x >= 2;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalExpression", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:   `var a = x ? true : false;`,
+				Output: []string{`var a = !!x;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalExpression", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:   `var a = x === 1 ? false : true;`,
+				Output: []string{`var a = x !== 1;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalExpression", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:   `var a = x != 1 ? false : true;`,
+				Output: []string{`var a = x == 1;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalExpression", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:   `var a = foo() ? false : true;`,
+				Output: []string{`var a = !foo();`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalExpression", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:   `var a = !foo() ? false : true;`,
+				Output: []string{`var a = !!foo();`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalExpression", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:   `var a = foo + bar ? false : true;`,
+				Output: []string{`var a = !(foo + bar);`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalExpression", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:   `var a = x instanceof foo ? false : true;`,
+				Output: []string{`var a = !(x instanceof foo);`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalExpression", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:   `var a = foo ? false : false;`,
+				Output: []string{`var a = false;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalExpression", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `var a = foo() ? false : false;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalExpression", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:   `var a = x instanceof foo ? true : false;`,
+				Output: []string{`var a = x instanceof foo;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalExpression", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:   `var a = !foo ? true : false;`,
+				Output: []string{`var a = !foo;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalExpression", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:    `var a = foo ? foo : 'No';`,
+				Options: map[string]interface{}{"defaultAssignment": false},
+				Output:  []string{`var a = foo || 'No';`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalAssignment", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:    `var a = x ? x : 1;`,
+				Options: []interface{}{map[string]interface{}{"defaultAssignment": false}},
+				Output:  []string{`var a = x || 1;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalAssignment", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:    `var a = ((foo)) ? foo : {};`,
+				Options: map[string]interface{}{"defaultAssignment": false},
+				Output:  []string{`var a = ((foo)) || {};`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalAssignment", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:    `var a = foo ? foo : boo && bar;`,
+				Options: map[string]interface{}{"defaultAssignment": false},
+				Output:  []string{`var a = foo || boo && bar;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalAssignment", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:    `var a = foo ? foo : boo || bar;`,
+				Options: map[string]interface{}{"defaultAssignment": false},
+				Output:  []string{`var a = foo || boo || bar;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalAssignment", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:    `var a = foo ? foo : (boo ?? bar);`,
+				Options: map[string]interface{}{"defaultAssignment": false},
+				Output:  []string{`var a = foo || (boo ?? bar);`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalAssignment", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:    `var a = foo ? foo : boo ?? bar;`,
+				Options: map[string]interface{}{"defaultAssignment": false},
+				Output:  []string{`var a = foo || (boo ?? bar);`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalAssignment", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:    `var a = foo ? foo : (a, b);`,
+				Options: map[string]interface{}{"defaultAssignment": false},
+				Output:  []string{`var a = foo || (a, b);`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalAssignment", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code:    `f(x ? x : 1);`,
+				Options: map[string]interface{}{"defaultAssignment": false},
+				Output:  []string{`f(x || 1);`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryConditionalAssignment", Line: 1, Column: 3},
+				},
+			},
+		},
+	)
+}
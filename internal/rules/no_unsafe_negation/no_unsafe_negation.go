@@ -0,0 +1,125 @@
+package no_unsafe_negation
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Options for no-unsafe-negation rule
+type Options struct {
+	EnforceForOrderingRelations bool `json:"enforceForOrderingRelations"`
+}
+
+func parseOptions(options any) Options {
+	opts := Options{}
+
+	if options == nil {
+		return opts
+	}
+
+	// Parse options with dual-format support (handles both array and object formats)
+	var optsMap map[string]interface{}
+	var ok bool
+
+	// Handle array format: [{ option: value }]
+	if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+		optsMap, ok = optArray[0].(map[string]interface{})
+	} else {
+		// Handle direct object format: { option: value }
+		optsMap, ok = options.(map[string]interface{})
+	}
+
+	if ok {
+		if v, ok := optsMap["enforceForOrderingRelations"].(bool); ok {
+			opts.EnforceForOrderingRelations = v
+		}
+	}
+	return opts
+}
+
+// Message builders
+func buildUnexpectedMessage(operator string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpected",
+		Description: "Unexpected negating the left operand of '" + operator + "' operator.",
+	}
+}
+
+func buildSuggestNegatedExpressionMessage(operator string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "suggestNegatedExpression",
+		Description: "Negate '" + operator + "' expression instead of its left operand. This changes the current behavior.",
+	}
+}
+
+func buildSuggestParenthesisedNegationMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "suggestParenthesisedNegation",
+		Description: "Wrap negation in '()' to make the intention explicit. This preserves the current behavior.",
+	}
+}
+
+func isRelationalOperator(kind ast.Kind) bool {
+	return kind == ast.KindInKeyword || kind == ast.KindInstanceOfKeyword
+}
+
+func isOrderingRelationalOperator(kind ast.Kind) bool {
+	switch kind {
+	case ast.KindLessThanToken,
+		ast.KindGreaterThanToken,
+		ast.KindLessThanEqualsToken,
+		ast.KindGreaterThanEqualsToken:
+		return true
+	}
+	return false
+}
+
+// isNegation checks for a logical negation; a parenthesized one is a ParenthesizedExpression and never matches
+func isNegation(node *ast.Node) bool {
+	return node.Kind == ast.KindPrefixUnaryExpression && node.AsPrefixUnaryExpression().Operator == ast.KindExclamationToken
+}
+
+// NoUnsafeNegationRule disallows negating the left operand of relational operators
+var NoUnsafeNegationRule = rule.CreateRule(rule.Rule{
+	Name: "no-unsafe-negation",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+
+		return rule.RuleListeners{
+			ast.KindBinaryExpression: func(node *ast.Node) {
+				binary := node.AsBinaryExpression()
+				operatorKind := binary.OperatorToken.Kind
+				if !isRelationalOperator(operatorKind) &&
+					!(opts.EnforceForOrderingRelations && isOrderingRelationalOperator(operatorKind)) {
+					return
+				}
+				if !isNegation(binary.Left) {
+					return
+				}
+
+				operatorRange := utils.TrimNodeTextRange(ctx.SourceFile, binary.OperatorToken)
+				operator := ctx.SourceFile.Text()[operatorRange.Pos():operatorRange.End()]
+				leftRange := utils.TrimNodeTextRange(ctx.SourceFile, binary.Left)
+
+				ctx.ReportNodeWithSuggestions(node, buildUnexpectedMessage(operator),
+					rule.RuleSuggestion{
+						Message: buildSuggestNegatedExpressionMessage(operator),
+						FixesArr: []rule.RuleFix{
+							rule.RuleFixReplaceRange(core.NewTextRange(leftRange.Pos(), leftRange.Pos()+1), "!("),
+							rule.RuleFixInsertAfter(node, ")"),
+						},
+					},
+					rule.RuleSuggestion{
+						Message: buildSuggestParenthesisedNegationMessage(),
+						FixesArr: []rule.RuleFix{
+							rule.RuleFixInsertBefore(ctx.SourceFile, binary.Left, "("),
+							rule.RuleFixInsertAfter(binary.Left, ")"),
+						},
+					},
+				)
+			},
+		}
+	},
+})
@@ -0,0 +1,129 @@
+package no_unsafe_negation
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoUnsafeNegationRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoUnsafeNegationRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `a in b`},
+			{Code: `a in b === false`},
+			{Code: `!(a in b)`},
+			{Code: `(!a) in b`},
+			{Code: `a instanceof b`},
+			{Code: `a instanceof b === false`},
+			{Code: `!(a instanceof b)`},
+			{Code: `(!a) instanceof b`},
+
+			// Ordering relations aren't checked by default
+			{Code: `if (! a < b) {}`},
+			{Code: `while (! a > b) {}`},
+			{Code: `foo = ! this.bar <= baz`},
+			{Code: `foo = ! this.bar >= baz`},
+			{Code: `! a < b`, Options: map[string]interface{}{"enforceForOrderingRelations": false}},
+			{Code: `foo = (!a) >= b`, Options: []interface{}{map[string]interface{}{"enforceForOrderingRelations": true}}},
+			{Code: `a <= b`, Options: []interface{}{map[string]interface{}{"enforceForOrderingRelations": true}}},
+			{Code: `foo = !(a > b)`, Options: []interface{}{map[string]interface{}{"enforceForOrderingRelations": true}}},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `!a in b`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpected", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNegatedExpression", Output: `!(a in b)`},
+							{MessageId: "suggestParenthesisedNegation", Output: `(!a) in b`},
+						},
+					},
+				},
+			},
+			{
+				Code: `(!a in b)`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpected", Line: 1, Column: 2,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNegatedExpression", Output: `(!(a in b))`},
+							{MessageId: "suggestParenthesisedNegation", Output: `((!a) in b)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `!(a) in b`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpected", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNegatedExpression", Output: `!((a) in b)`},
+							{MessageId: "suggestParenthesisedNegation", Output: `(!(a)) in b`},
+						},
+					},
+				},
+			},
+			{
+				Code: `!a instanceof b`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpected", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNegatedExpression", Output: `!(a instanceof b)`},
+							{MessageId: "suggestParenthesisedNegation", Output: `(!a) instanceof b`},
+						},
+					},
+				},
+			},
+			{
+				Code: `if (!foo instanceof Bar) {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpected", Line: 1, Column: 5,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNegatedExpression", Output: `if (!(foo instanceof Bar)) {}`},
+							{MessageId: "suggestParenthesisedNegation", Output: `if ((!foo) instanceof Bar) {}`},
+						},
+					},
+				},
+			},
+
+			// enforceForOrderingRelations
+			{
+				Code:    `if (! a < b) {}`,
+				Options: []interface{}{map[string]interface{}{"enforceForOrderingRelations": true}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpected", Line: 1, Column: 5,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNegatedExpression", Output: `if (!( a < b)) {}`},
+							{MessageId: "suggestParenthesisedNegation", Output: `if ((! a) < b) {}`},
+						},
+					},
+				},
+			},
+			{
+				Code:    `foo = ! this.bar >= baz`,
+				Options: map[string]interface{}{"enforceForOrderingRelations": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "unexpected", Line: 1, Column: 7,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestNegatedExpression", Output: `foo = !( this.bar >= baz)`},
+							{MessageId: "suggestParenthesisedNegation", Output: `foo = (! this.bar) >= baz`},
+						},
+					},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,564 @@
+package no_useless_assignment
+
+import (
+	"slices"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// Message builder
+func buildUnnecessaryAssignmentMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unnecessaryAssignment",
+		Description: "This assigned value is not used in subsequent statements.",
+	}
+}
+
+type referenceKind int
+
+const (
+	referenceRead referenceKind = iota
+	// referenceWrite is a plain `v = x` assignment
+	referenceWrite
+	// referenceOtherWrite is a write that is not a plain assignment, e.g. destructuring or `for (v of xs)`
+	referenceOtherWrite
+	// referenceReadWrite is a compound assignment or an update expression, e.g. `v += 1` or `v++`
+	referenceReadWrite
+	referenceDeclaration
+)
+
+// jumpTarget is a statement that `break` or `continue` can jump to
+type jumpTarget struct {
+	labels       []string
+	isLoop       bool
+	isSwitch     bool
+	breakLive    bool
+	continueLive bool
+}
+
+// liveness computes, for a single variable, whether each of its assignments is read afterwards.
+// Statements and expressions are visited backwards, starting from the variable being dead at
+// the end of its scope.
+type liveness struct {
+	references    map[*ast.Node]referenceKind
+	targets       []*jumpTarget
+	pendingLabels []string
+	// tryLive is set when an exception thrown at the current position may reach a read
+	tryLive bool
+	dead    map[*ast.Node]bool
+	writes  []*ast.Node
+}
+
+func (l *liveness) recordWrite(node *ast.Node, live bool) {
+	dead := !live && !l.tryLive
+	if previous, ok := l.dead[node]; ok {
+		// Statements may be visited more than once, e.g. for loops and finally blocks
+		dead = previous && dead
+	} else {
+		l.writes = append(l.writes, node)
+	}
+	l.dead[node] = dead
+}
+
+func (l *liveness) takeLabels() []string {
+	labels := l.pendingLabels
+	l.pendingLabels = nil
+	return labels
+}
+
+func (l *liveness) withTarget(target *jumpTarget, visit func() bool) bool {
+	l.targets = append(l.targets, target)
+	live := visit()
+	l.targets = l.targets[:len(l.targets)-1]
+	return live
+}
+
+func (l *liveness) findTarget(label *ast.Node, isContinue bool) *jumpTarget {
+	for i := len(l.targets) - 1; i >= 0; i-- {
+		target := l.targets[i]
+		if label != nil {
+			if slices.Contains(target.labels, label.Text()) {
+				return target
+			}
+			continue
+		}
+		if target.isLoop || (!isContinue && target.isSwitch) {
+			return target
+		}
+	}
+	return nil
+}
+
+// loop computes the liveness at the head of a loop, revisiting the loop once the back edge turns out to be live
+func (l *liveness) loop(visit func(head bool) bool) bool {
+	head := false
+	for range 2 {
+		next := visit(head)
+		if next == head {
+			break
+		}
+		head = next
+	}
+	return head
+}
+
+func (l *liveness) statements(statements []*ast.Node, live bool) bool {
+	for i := len(statements) - 1; i >= 0; i-- {
+		live = l.statement(statements[i], live)
+	}
+	return live
+}
+
+func (l *liveness) statement(node *ast.Node, live bool) bool {
+	if node == nil {
+		return live
+	}
+
+	switch node.Kind {
+	case ast.KindBlock:
+		return l.statements(node.Statements(), live)
+
+	case ast.KindExpressionStatement:
+		return l.expression(node.Expression(), live)
+
+	case ast.KindVariableStatement:
+		return l.declarations(node.AsVariableStatement().DeclarationList, live)
+
+	case ast.KindIfStatement:
+		ifStatement := node.AsIfStatement()
+		thenLive := l.statement(ifStatement.ThenStatement, live)
+		elseLive := live
+		if ifStatement.ElseStatement != nil {
+			elseLive = l.statement(ifStatement.ElseStatement, live)
+		}
+		return l.expression(ifStatement.Expression, thenLive || elseLive)
+
+	case ast.KindReturnStatement, ast.KindThrowStatement:
+		if node.Expression() == nil {
+			return false
+		}
+		return l.expression(node.Expression(), false)
+
+	case ast.KindBreakStatement:
+		if target := l.findTarget(node.Label(), false); target != nil {
+			return target.breakLive
+		}
+		return true
+
+	case ast.KindContinueStatement:
+		if target := l.findTarget(node.Label(), true); target != nil {
+			return target.continueLive
+		}
+		return true
+
+	case ast.KindLabeledStatement:
+		labeled := node.AsLabeledStatement()
+		l.pendingLabels = append(l.pendingLabels, labeled.Label.Text())
+		switch labeled.Statement.Kind {
+		case ast.KindLabeledStatement, ast.KindWhileStatement, ast.KindDoStatement, ast.KindForStatement,
+			ast.KindForInStatement, ast.KindForOfStatement, ast.KindSwitchStatement:
+			// The labels are taken by the inner statement
+			return l.statement(labeled.Statement, live)
+		}
+		target := &jumpTarget{labels: l.takeLabels(), breakLive: live}
+		return l.withTarget(target, func() bool {
+			return l.statement(labeled.Statement, live)
+		})
+
+	case ast.KindWhileStatement:
+		whileStatement := node.AsWhileStatement()
+		labels := l.takeLabels()
+		return l.loop(func(head bool) bool {
+			target := &jumpTarget{labels: labels, isLoop: true, breakLive: live, continueLive: head}
+			bodyLive := l.withTarget(target, func() bool {
+				return l.statement(whileStatement.Statement, head)
+			})
+			return l.expression(whileStatement.Expression, live || bodyLive)
+		})
+
+	case ast.KindDoStatement:
+		doStatement := node.AsDoStatement()
+		labels := l.takeLabels()
+		return l.loop(func(head bool) bool {
+			conditionLive := l.expression(doStatement.Expression, live || head)
+			target := &jumpTarget{labels: labels, isLoop: true, breakLive: live, continueLive: conditionLive}
+			return l.withTarget(target, func() bool {
+				return l.statement(doStatement.Statement, conditionLive)
+			})
+		})
+
+	case ast.KindForStatement:
+		forStatement := node.AsForStatement()
+		labels := l.takeLabels()
+		head := l.loop(func(head bool) bool {
+			incrementorLive := head
+			if forStatement.Incrementor != nil {
+				incrementorLive = l.expression(forStatement.Incrementor, head)
+			}
+			target := &jumpTarget{labels: labels, isLoop: true, breakLive: live, continueLive: incrementorLive}
+			bodyLive := l.withTarget(target, func() bool {
+				return l.statement(forStatement.Statement, incrementorLive)
+			})
+			if forStatement.Condition == nil {
+				return bodyLive
+			}
+			return l.expression(forStatement.Condition, live || bodyLive)
+		})
+		if forStatement.Initializer == nil {
+			return head
+		}
+		if forStatement.Initializer.Kind == ast.KindVariableDeclarationList {
+			return l.declarations(forStatement.Initializer, head)
+		}
+		return l.expression(forStatement.Initializer, head)
+
+	case ast.KindForInStatement, ast.KindForOfStatement:
+		forInOrOf := node.AsForInOrOfStatement()
+		labels := l.takeLabels()
+		head := l.loop(func(head bool) bool {
+			target := &jumpTarget{labels: labels, isLoop: true, breakLive: live, continueLive: head}
+			bodyLive := l.withTarget(target, func() bool {
+				return l.statement(forInOrOf.Statement, head)
+			})
+			// The loop variable is assigned on each iteration, which never counts as a dead store
+			initializerLive := l.expression(forInOrOf.Initializer, bodyLive)
+			return live || initializerLive
+		})
+		return l.expression(forInOrOf.Expression, head)
+
+	case ast.KindSwitchStatement:
+		switchStatement := node.AsSwitchStatement()
+		clauses := switchStatement.CaseBlock.AsCaseBlock().Clauses.Nodes
+		target := &jumpTarget{labels: l.takeLabels(), isSwitch: true, breakLive: live}
+		clauseLive := make([]bool, len(clauses))
+		l.withTarget(target, func() bool {
+			// Each clause falls through to the next one
+			next := live
+			for i := len(clauses) - 1; i >= 0; i-- {
+				clauseLive[i] = l.statements(clauses[i].AsCaseOrDefaultClause().Statements.Nodes, next)
+				next = clauseLive[i]
+			}
+			return next
+		})
+
+		// Case tests are evaluated in order, and the default clause is taken when none matches
+		testLive := live
+		for i, clause := range clauses {
+			if clause.Kind == ast.KindDefaultClause {
+				testLive = clauseLive[i]
+			}
+		}
+		for i := len(clauses) - 1; i >= 0; i-- {
+			if clauses[i].Kind == ast.KindCaseClause {
+				testLive = l.expression(clauses[i].Expression(), clauseLive[i] || testLive)
+			}
+		}
+		return l.expression(switchStatement.Expression, testLive)
+
+	case ast.KindTryStatement:
+		tryStatement := node.AsTryStatement()
+		outerTryLive := l.tryLive
+
+		// Any jump out of the try and catch blocks runs the finally block first
+		finallyLive := live
+		finallyReads := false
+		if tryStatement.FinallyBlock != nil {
+			finallyReads = l.statement(tryStatement.FinallyBlock, false)
+			finallyLive = l.statement(tryStatement.FinallyBlock, live)
+		}
+
+		l.tryLive = outerTryLive || finallyReads
+		catchLive := false
+		if tryStatement.CatchClause != nil {
+			catchLive = l.statement(tryStatement.CatchClause.AsCatchClause().Block, finallyLive)
+		}
+
+		l.tryLive = outerTryLive || finallyReads || catchLive
+		tryLive := l.statement(tryStatement.TryBlock, finallyLive)
+		l.tryLive = outerTryLive
+		return tryLive || catchLive || finallyReads
+
+	case ast.KindFunctionDeclaration, ast.KindClassDeclaration, ast.KindModuleDeclaration:
+		return live
+	}
+
+	return l.walk(node, live)
+}
+
+func (l *liveness) declarations(list *ast.Node, live bool) bool {
+	declarations := list.AsVariableDeclarationList().Declarations.Nodes
+	for i := len(declarations) - 1; i >= 0; i-- {
+		declaration := declarations[i]
+		initializer := declaration.Initializer()
+		if initializer == nil {
+			continue
+		}
+		name := declaration.Name()
+		if kind, ok := l.references[name]; ok && kind == referenceDeclaration {
+			l.recordWrite(name, live)
+			live = false
+		} else {
+			live = l.walk(name, live)
+		}
+		live = l.expression(initializer, live)
+	}
+	return live
+}
+
+func (l *liveness) expression(node *ast.Node, live bool) bool {
+	if node == nil {
+		return live
+	}
+
+	switch node.Kind {
+	case ast.KindIdentifier:
+		if kind, ok := l.references[node]; ok && (kind == referenceRead || kind == referenceReadWrite) {
+			return true
+		}
+		return live
+
+	case ast.KindBinaryExpression:
+		binary := node.AsBinaryExpression()
+		switch binary.OperatorToken.Kind {
+		case ast.KindEqualsToken:
+			left := ast.SkipParentheses(binary.Left)
+			if kind, ok := l.references[left]; ok && kind == referenceWrite {
+				l.recordWrite(left, live)
+				return l.expression(binary.Right, false)
+			}
+		case ast.KindAmpersandAmpersandToken, ast.KindBarBarToken, ast.KindQuestionQuestionToken:
+			rightLive := l.expression(binary.Right, live)
+			return l.expression(binary.Left, live || rightLive)
+		}
+
+	case ast.KindConditionalExpression:
+		conditional := node.AsConditionalExpression()
+		whenTrueLive := l.expression(conditional.WhenTrue, live)
+		whenFalseLive := l.expression(conditional.WhenFalse, live)
+		return l.expression(conditional.Condition, whenTrueLive || whenFalseLive)
+	}
+
+	if ast.IsOptionalChain(node) {
+		// The rest of the chain may be skipped
+		chainLive := l.walk(node, live)
+		return chainLive || live
+	}
+	return l.walk(node, live)
+}
+
+// walk visits the children of a node in reverse evaluation order
+func (l *liveness) walk(node *ast.Node, live bool) bool {
+	var children []*ast.Node
+	node.ForEachChild(func(child *ast.Node) bool {
+		children = append(children, child)
+		return false
+	})
+	for i := len(children) - 1; i >= 0; i-- {
+		child := children[i]
+		if ast.IsFunctionLike(child) || ast.IsClassLike(child) || ast.IsTypeNode(child) {
+			continue
+		}
+		live = l.expression(child, live)
+	}
+	return live
+}
+
+// isScopeBoundary reports whether references inside node may run at another time than the enclosing code
+func isScopeBoundary(node *ast.Node) bool {
+	return ast.IsFunctionLike(node) || ast.IsClassLike(node) || node.Kind == ast.KindModuleDeclaration
+}
+
+// hasDynamicScope checks for `with` statements and direct `eval()` calls, which may access any variable
+func hasDynamicScope(node *ast.Node) bool {
+	var visit func(node *ast.Node) bool
+	visit = func(node *ast.Node) bool {
+		switch node.Kind {
+		case ast.KindWithStatement:
+			return true
+		case ast.KindCallExpression:
+			callee := node.Expression()
+			if callee.Kind == ast.KindIdentifier && callee.Text() == "eval" {
+				return true
+			}
+		}
+		return node.ForEachChild(visit)
+	}
+	return visit(node)
+}
+
+func getReferenceKind(node *ast.Node) referenceKind {
+	switch node.Parent.Kind {
+	case ast.KindVariableDeclaration, ast.KindBindingElement:
+		if node.Parent.Name() == node {
+			return referenceDeclaration
+		}
+	}
+
+	target := ast.GetAssignmentTarget(node)
+	if target == nil {
+		return referenceRead
+	}
+	if target.Kind != ast.KindBinaryExpression {
+		if target.Kind == ast.KindForInStatement || target.Kind == ast.KindForOfStatement {
+			return referenceOtherWrite
+		}
+		return referenceReadWrite
+	}
+	binary := target.AsBinaryExpression()
+	switch {
+	case binary.OperatorToken.Kind != ast.KindEqualsToken:
+		return referenceReadWrite
+	case ast.SkipParentheses(binary.Left) == node:
+		return referenceWrite
+	default:
+		return referenceOtherWrite
+	}
+}
+
+// NoUselessAssignmentRule disallows assignments whose value is never read
+var NoUselessAssignmentRule = rule.CreateRule(rule.Rule{
+	Name: "no-useless-assignment",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		if ctx.TypeChecker == nil {
+			return rule.RuleListeners{}
+		}
+
+		getSymbol := func(node *ast.Node) *ast.Symbol {
+			if node.Parent.Kind == ast.KindShorthandPropertyAssignment && node.Parent.Name() == node {
+				return ctx.TypeChecker.GetShorthandAssignmentValueSymbol(node.Parent)
+			}
+			return ctx.TypeChecker.GetSymbolAtLocation(node)
+		}
+
+		checkScope := func(body *ast.Node) {
+			if body == nil || hasDynamicScope(body) {
+				return
+			}
+
+			// Collect the variables declared in this scope, and the names listed in `export { ... }`
+			var declarations []*ast.Node
+			exportedNames := map[string]bool{}
+			var collect func(node *ast.Node) bool
+			collect = func(node *ast.Node) bool {
+				if isScopeBoundary(node) {
+					return false
+				}
+				switch node.Kind {
+				case ast.KindVariableStatement:
+					if ast.HasSyntacticModifier(node, ast.ModifierFlagsExport|ast.ModifierFlagsAmbient) {
+						return false
+					}
+				case ast.KindVariableDeclarationList:
+					if node.Flags&(ast.NodeFlagsConst|ast.NodeFlagsUsing) == 0 {
+						for _, declaration := range node.AsVariableDeclarationList().Declarations.Nodes {
+							if declaration.Name().Kind == ast.KindIdentifier {
+								declarations = append(declarations, declaration.Name())
+							}
+						}
+					}
+				case ast.KindExportSpecifier:
+					specifier := node.AsExportSpecifier()
+					if specifier.PropertyName != nil {
+						exportedNames[specifier.PropertyName.Text()] = true
+					} else {
+						exportedNames[specifier.Name().Text()] = true
+					}
+				}
+				return node.ForEachChild(collect)
+			}
+			body.ForEachChild(collect)
+			if len(declarations) == 0 {
+				return
+			}
+
+			// Collect all identifiers in this scope by name, including those in nested functions
+			identifiers := map[string][]*ast.Node{}
+			var collectIdentifiers func(node *ast.Node) bool
+			collectIdentifiers = func(node *ast.Node) bool {
+				if node.Kind == ast.KindIdentifier {
+					identifiers[node.Text()] = append(identifiers[node.Text()], node)
+				}
+				return node.ForEachChild(collectIdentifiers)
+			}
+			body.ForEachChild(collectIdentifiers)
+
+			var deadWrites []*ast.Node
+			checked := map[*ast.Symbol]bool{}
+			for _, name := range declarations {
+				symbol := ctx.TypeChecker.GetSymbolAtLocation(name)
+				if symbol == nil || checked[symbol] || exportedNames[name.Text()] {
+					continue
+				}
+				checked[symbol] = true
+
+				references := map[*ast.Node]referenceKind{}
+				captured := false
+				hasRead := false
+				for _, identifier := range identifiers[name.Text()] {
+					if getSymbol(identifier) != symbol {
+						continue
+					}
+					for current := identifier.Parent; current != body; current = current.Parent {
+						if isScopeBoundary(current) {
+							captured = true
+							break
+						}
+					}
+					kind := getReferenceKind(identifier)
+					if kind == referenceRead || kind == referenceReadWrite {
+						hasRead = true
+					}
+					references[identifier] = kind
+				}
+				// Variables used by closures may be read at any time, and unread variables are left to no-unused-vars
+				if captured || !hasRead {
+					continue
+				}
+
+				analysis := &liveness{
+					references: references,
+					dead:       map[*ast.Node]bool{},
+				}
+				if body.Kind == ast.KindBlock || body.Kind == ast.KindSourceFile {
+					analysis.statements(body.Statements(), false)
+				} else {
+					analysis.expression(body, false)
+				}
+				for _, write := range analysis.writes {
+					if analysis.dead[write] {
+						deadWrites = append(deadWrites, write)
+					}
+				}
+			}
+
+			slices.SortFunc(deadWrites, func(a, b *ast.Node) int {
+				return a.Pos() - b.Pos()
+			})
+			for _, write := range deadWrites {
+				ctx.ReportNode(write, buildUnnecessaryAssignmentMessage())
+			}
+		}
+
+		checkFunction := func(node *ast.Node) {
+			checkScope(node.Body())
+		}
+
+		// Top-level variables of scripts are globals that other files may read. The linter
+		// doesn't visit the source file node itself, so modules are checked up front.
+		if ast.IsExternalModule(ctx.SourceFile) {
+			checkScope(&ctx.SourceFile.Node)
+		}
+
+		return rule.RuleListeners{
+			ast.KindFunctionDeclaration:         checkFunction,
+			ast.KindFunctionExpression:          checkFunction,
+			ast.KindArrowFunction:               checkFunction,
+			ast.KindMethodDeclaration:           checkFunction,
+			ast.KindConstructor:                 checkFunction,
+			ast.KindGetAccessor:                 checkFunction,
+			ast.KindSetAccessor:                 checkFunction,
+			ast.KindClassStaticBlockDeclaration: checkFunction,
+		}
+	},
+})
@@ -0,0 +1,157 @@
+package no_useless_assignment
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoUselessAssignmentRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoUselessAssignmentRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `function f() { let v = 'used'; doSomething(v); v = 'used-2'; doSomething(v); }`},
+			{Code: `function f() { let v = 'used'; if (condition) { v = 'used-2'; } doSomething(v); }`},
+			{Code: `function f() { let v; if (condition) { v = 'a'; } else { v = 'b'; } doSomething(v); }`},
+			{Code: `function f() { let v = 'used'; if (condition) { doSomething(v); } else { v = 'used-2'; doSomething(v); } }`},
+			{Code: `function f() { let v = condition ? 'a' : 'b'; doSomething(v); }`},
+			{Code: `function f() { let v = 0; v += 1; doSomething(v); }`},
+			{Code: `function f() { let v = 0; v++; return v; }`},
+			{Code: `function f() { let v = 0; v = v + 1; return v; }`},
+			{Code: `function f() { let { a } = obj; return a; }`},
+
+			// Loops
+			{Code: `function f() { let v = 0; while (v < 10) { v = v + 1; } }`},
+			{Code: `function f() { let sum = 0; for (let i = 0; i < 10; i++) { sum += i; } return sum; }`},
+			{Code: `function f() { let v = 'used'; for (const x of xs) { doSomething(v); v = x; } }`},
+			{Code: `function f() { let v = 0; do { v = next(v); } while (v < 10); }`},
+			{Code: `function f() { let v; for (v of xs) { doSomething(v); } }`},
+			{Code: `function f() { let v = 0; for (;;) { if (condition) { break; } v = 1; } return v; }`},
+			{Code: `function f() { let v = 0; outer: for (const x of xs) { for (const y of ys) { v = y; continue outer; } } return v; }`},
+			{Code: `function f() { let v = 'a'; while (condition) { if (v === 'b') { return; } v = 'b'; } }`},
+
+			// Control flow
+			{Code: `function f() { let v = 'used'; try { v = compute(); } catch { doSomething(v); } }`},
+			{Code: `function f() { let v = 'used'; try { v = compute(); other(); } finally { doSomething(v); } }`},
+			{Code: `function f() { let v = 'a'; switch (x) { case 1: v = 'b'; case 2: doSomething(v); break; } }`},
+			{Code: `function f() { let v = 'a'; switch (x) { case 1: v = 'b'; break; default: break; } return v; }`},
+			{Code: `function f() { let v = 'a'; label: { if (condition) { break label; } v = 'b'; } return v; }`},
+			{Code: `function f() { let v = 'a'; condition && (v = 'b'); return v; }`},
+			{Code: `function f() { let v = 'a'; obj?.foo((v = 'b')); return v; }`},
+
+			// Closures may read the variable at any time
+			{Code: `function f() { let v = 'used'; const g = () => v; v = 'used-2'; return g; }`},
+			{Code: `function f() { let v = 'a'; setTimeout(() => { v = 'b'; }); return v; }`},
+
+			// Unread variables are reported by no-unused-vars
+			{Code: `function f() { let v = 'a'; v = 'b'; }`},
+
+			// Dynamic scopes
+			{Code: `function f() { let v = 'a'; eval('doSomething(v)'); v = 'b'; doSomething(v); }`},
+
+			// Variables of scripts are globals
+			{Code: `var v = 'a'; v = 'b'; doSomething(v);`},
+
+			// Exported variables
+			{Code: `export let v = 'a'; v = 'b'; doSomething(v);`},
+			{Code: `let v = 'a'; v = 'b'; doSomething(v); export { v };`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			// Reassigned before read
+			{
+				Code: `function f() { let v = 'unused'; v = 'used'; doSomething(v); }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryAssignment", Line: 1, Column: 20},
+				},
+			},
+			{
+				Code: `function f() { let v = 'used'; doSomething(v); v = 'unused'; v = 'used-2'; doSomething(v); }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryAssignment", Line: 1, Column: 48},
+				},
+			},
+			{
+				Code: `function f() {
+  let v = 'unused';
+  if (condition) {
+    v = 'a';
+  } else {
+    v = 'b';
+  }
+  doSomething(v);
+}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryAssignment", Line: 2, Column: 7},
+				},
+			},
+			{
+				Code: `const f = () => { var v = 1; v = 2; return v; };`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryAssignment", Line: 1, Column: 23},
+				},
+			},
+			{
+				Code: `class A { m() { let v = 1; v = 2; return v; } }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryAssignment", Line: 1, Column: 21},
+				},
+			},
+			{
+				Code: `let v = 'unused'; v = 'used'; doSomething(v); export {};`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryAssignment", Line: 1, Column: 5},
+				},
+			},
+
+			// Never read before going out of scope
+			{
+				Code: `function f() { let v = 'used'; doSomething(v); v = 'unused'; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryAssignment", Line: 1, Column: 48},
+				},
+			},
+			{
+				Code: `function f() { let v = 'used'; if (condition) { v = 'unused'; return; } doSomething(v); }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryAssignment", Line: 1, Column: 49},
+				},
+			},
+			{
+				Code: `function f() { let v = 'used'; doSomething(v); try { v = 'unused'; } catch { } }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryAssignment", Line: 1, Column: 54},
+				},
+			},
+
+			// Loops
+			{
+				Code: `function f() { let v = 'unused'; for (const x of xs) { v = x; doSomething(v); } }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryAssignment", Line: 1, Column: 20},
+				},
+			},
+			{
+				Code: `function f() { let v = 0; while (condition) { v = 1; doSomething(v); v = 2; } }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryAssignment", Line: 1, Column: 20},
+					{MessageId: "unnecessaryAssignment", Line: 1, Column: 70},
+				},
+			},
+
+			// Multiple variables
+			{
+				Code: `function f() { let a = 1, b = 2; a = 3; b = 4; return a + b; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryAssignment", Line: 1, Column: 20},
+					{MessageId: "unnecessaryAssignment", Line: 1, Column: 27},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,157 @@
+package no_useless_backreference
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+func buildMessage(messageId string, bref string, group string, otherGroups string) rule.RuleMessage {
+	reference := "Backreference '" + bref + "' will be ignored. It references group '" + group + "'" + otherGroups
+	var description string
+	switch messageId {
+	case "nested":
+		description = reference + " from within that group."
+	case "forward":
+		description = reference + " which appears later in the pattern."
+	case "backward":
+		description = reference + " which appears before in the same lookbehind."
+	case "disjunctive":
+		description = reference + " which is in another alternative."
+	case "intoNegativeLookaround":
+		description = reference + " which is in a negative lookaround."
+	}
+	return rule.RuleMessage{
+		Id:          messageId,
+		Description: description,
+	}
+}
+
+// getPathToRoot returns node followed by all of its ancestors
+func getPathToRoot(node *utils.RegExpNode) []*utils.RegExpNode {
+	var path []*utils.RegExpNode
+	for current := node; current != nil; current = current.Parent {
+		path = append(path, current)
+	}
+	return path
+}
+
+func isLookaround(node *utils.RegExpNode) bool {
+	return node.Kind == utils.RegExpNodeLookaround
+}
+
+func isNegativeLookaround(node *utils.RegExpNode) bool {
+	return isLookaround(node) && node.Negate
+}
+
+type problem struct {
+	messageId string
+	group     *utils.RegExpNode
+}
+
+// checkGroup returns why bref can never match group, or nil if it can
+func checkGroup(bref *utils.RegExpNode, brefPath []*utils.RegExpNode, group *utils.RegExpNode) *problem {
+	if slices.Contains(brefPath, group) {
+		// The group hasn't finished matching when the backreference is reached
+		return &problem{"nested", group}
+	}
+
+	// Start from the root to find the lowest common ancestor
+	groupPath := getPathToRoot(group)
+	i := len(brefPath) - 1
+	j := len(groupPath) - 1
+	for {
+		i--
+		j--
+		if brefPath[i] != groupPath[j] {
+			break
+		}
+	}
+
+	indexOfLowestCommonAncestor := j + 1
+	groupCut := groupPath[:indexOfLowestCommonAncestor]
+	commonPath := groupPath[indexOfLowestCommonAncestor:]
+
+	// Inside a lookbehind the pattern is matched from right to left
+	isMatchingBackward := false
+	if index := slices.IndexFunc(commonPath, isLookaround); index >= 0 {
+		isMatchingBackward = commonPath[index].Lookbehind
+	}
+
+	if groupCut[len(groupCut)-1].Kind == utils.RegExpNodeAlternative {
+		// The group and the backreference are in sibling alternatives
+		return &problem{"disjunctive", group}
+	}
+	if !isMatchingBackward && bref.End <= group.Start {
+		return &problem{"forward", group}
+	}
+	if isMatchingBackward && group.End <= bref.Start {
+		return &problem{"backward", group}
+	}
+	if slices.ContainsFunc(groupCut, isNegativeLookaround) {
+		// The group has already failed when the backreference is reached
+		return &problem{"intoNegativeLookaround", group}
+	}
+	return nil
+}
+
+// NoUselessBackreferenceRule disallows useless backreferences in regular expressions
+var NoUselessBackreferenceRule = rule.CreateRule(rule.Rule{
+	Name: "no-useless-backreference",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		checkBackreference := func(node *ast.Node, bref *utils.RegExpNode) {
+			brefPath := getPathToRoot(bref)
+
+			problems := make([]*problem, 0, len(bref.Resolved))
+			for _, group := range bref.Resolved {
+				p := checkGroup(bref, brefPath, group)
+				if p == nil {
+					// The backreference is useful if any of its groups can match
+					return
+				}
+				problems = append(problems, p)
+			}
+			if len(problems) == 0 {
+				return
+			}
+
+			// Prefer problems with groups in the same disjunction as the backreference
+			problemsToReport := problems
+			if sameDisjunction := slices.DeleteFunc(slices.Clone(problems), func(p *problem) bool {
+				return p.messageId == "disjunctive"
+			}); len(sameDisjunction) > 0 {
+				problemsToReport = sameDisjunction
+			}
+
+			otherGroups := ""
+			if other := len(problemsToReport) - 1; other == 1 {
+				otherGroups = " and another group"
+			} else if other > 1 {
+				otherGroups = " and other " + strconv.Itoa(other) + " groups"
+			}
+
+			first := problemsToReport[0]
+			ctx.ReportNode(node, buildMessage(first.messageId, bref.Raw, first.group.Raw, otherGroups))
+		}
+
+		return rule.RuleListeners{
+			ast.KindRegularExpressionLiteral: func(node *ast.Node) {
+				pattern, flags := utils.ExtractRegexLiteral(node)
+				root, err := utils.ParseRegExp(pattern, flags)
+				if err != nil {
+					// Invalid patterns are reported by the compiler
+					return
+				}
+
+				utils.ForEachRegExpNode(root, func(regExpNode *utils.RegExpNode) {
+					if regExpNode.Kind == utils.RegExpNodeBackreference {
+						checkBackreference(node, regExpNode)
+					}
+				})
+			},
+		}
+	},
+})
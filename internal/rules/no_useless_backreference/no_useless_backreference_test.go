@@ -0,0 +1,144 @@
+package no_useless_backreference
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoUselessBackreferenceRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoUselessBackreferenceRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `/(?:)/`},
+			{Code: `/(a)\1/`},
+			{Code: `/(a)(b)\2\1/`},
+			{Code: `/(?<foo>a)\k<foo>/`},
+			{Code: `/(a)|(b)/`},
+			{Code: `/\1/`},
+			{Code: `/\2(a)/`},
+			{Code: `/(a)\1|b/`},
+			{Code: `/a|(b)\1/`},
+			{Code: `/(a)(?:b|c)\1/`},
+			{Code: `/(?:(a)|b)\1/`},
+			{Code: `/(?:a|(b))\1/`},
+			{Code: `/(a)+\1/`},
+			{Code: `/(?:(a)\1)+/`},
+			{Code: `/(?=(a))\1/`},
+			{Code: `/(?<=(a))\1/`},
+			{Code: `/(?<=\1(a))/`},
+			{Code: `/(?<=(?:\1|b)(a))/`},
+			{Code: `/(?!(a)\1)/`},
+			{Code: `/(?<!\1(a))/`},
+			{Code: `/[\1](a)/`},
+			{Code: `/(a)\1/u`},
+			{Code: `/(?<a>x)|(?<a>y)\k<a>/`},
+			{Code: `/(?:(?<a>x)|(?<a>y))\k<a>/`},
+			{Code: `/(?<a>x)\k<a>|(?<a>y)/`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			// nested
+			{
+				Code:   `/(a\1)/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "nested", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `/(a(?:b|\1))/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "nested", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `/(?<foo>(.)b\k<foo>)/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "nested", Line: 1, Column: 1}},
+			},
+
+			// forward
+			{
+				Code:   `/\1(a)/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "forward", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `/\k<foo>(?<foo>a)/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "forward", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `/(?:a|b)\1(c)/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "forward", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `/\1(?=(a))/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "forward", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `const r = /(?<=(a))b\1/; const s = /\2(a)(b)/;`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "forward", Line: 1, Column: 36}},
+			},
+
+			// backward
+			{
+				Code:   `/(?<=(a)\1)b/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "backward", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `/(?<!(a)(?:\1|b))c/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "backward", Line: 1, Column: 1}},
+			},
+
+			// disjunctive
+			{
+				Code:   `/(a)|\1b/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "disjunctive", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `/(?:(a)|b\1)c/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "disjunctive", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `/(?<a>a)|\k<a>/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "disjunctive", Line: 1, Column: 1}},
+			},
+
+			// intoNegativeLookaround
+			{
+				Code:   `/(?!(a))\1/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "intoNegativeLookaround", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `/(?!(a))(?!\1)b/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "intoNegativeLookaround", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `/(?<!(a))b\1/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "intoNegativeLookaround", Line: 1, Column: 1}},
+			},
+
+			// Every reference to a duplicate named group is useless
+			{
+				Code:   `/\k<a>(?:(?<a>x)|(?<a>y))/`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "forward", Line: 1, Column: 1}},
+			},
+
+			// Each useless backreference is reported
+			{
+				Code: `/\1(a)\2(b)/`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "forward", Line: 1, Column: 1},
+					{MessageId: "forward", Line: 1, Column: 1},
+				},
+			},
+		},
+	)
+}
+
+func TestBuildMessage(t *testing.T) {
+	message := buildMessage("forward", `\k<a>`, "(?<a>x)", " and another group")
+	expected := "Backreference '\\k<a>' will be ignored. It references group '(?<a>x)' and another group which appears later in the pattern."
+	if message.Description != expected {
+		t.Errorf("expected %q, got %q", expected, message.Description)
+	}
+}
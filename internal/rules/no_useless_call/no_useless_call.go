@@ -0,0 +1,113 @@
+package no_useless_call
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builder
+func buildUnnecessaryCallMessage(name string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unnecessaryCall",
+		Description: "Unnecessary '." + name + "()'.",
+	}
+}
+
+// getCallOrNonVariadicApplyName returns "call" or "apply" for `fn.call(thisArg, ...)` and
+// `fn.apply(thisArg, [...])`, and an empty string for everything else
+func getCallOrNonVariadicApplyName(node *ast.Node) string {
+	callee := ast.SkipParentheses(node.AsCallExpression().Expression)
+	if callee.Kind != ast.KindPropertyAccessExpression {
+		return ""
+	}
+	name := callee.AsPropertyAccessExpression().Name()
+	if name.Kind != ast.KindIdentifier {
+		return ""
+	}
+
+	args := node.Arguments()
+	switch name.Text() {
+	case "call":
+		if len(args) >= 1 {
+			return "call"
+		}
+	case "apply":
+		// The arguments of `fn.apply(thisArg, args)` cannot be spread statically unless they are an array literal
+		if len(args) == 2 && ast.SkipParentheses(args[1]).Kind == ast.KindArrayLiteralExpression {
+			return "apply"
+		}
+	}
+	return ""
+}
+
+// isNullOrUndefined checks for `null`, `undefined` and `void x`
+func isNullOrUndefined(node *ast.Node) bool {
+	switch node.Kind {
+	case ast.KindNullKeyword, ast.KindVoidExpression:
+		return true
+	case ast.KindIdentifier:
+		return node.Text() == "undefined"
+	}
+	return false
+}
+
+// NoUselessCallRule disallows unnecessary `.call()` and `.apply()`
+var NoUselessCallRule = rule.CreateRule(rule.Rule{
+	Name: "no-useless-call",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		// getTokenTexts returns the texts of all tokens of a node, ignoring whitespace and comments
+		getTokenTexts := func(node *ast.Node) []string {
+			nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			s := scanner.GetScannerForSourceFile(ctx.SourceFile, nodeRange.Pos())
+			var texts []string
+			for s.Token() != ast.KindEndOfFile && s.TokenStart() < nodeRange.End() {
+				texts = append(texts, s.TokenText())
+				s.Scan()
+			}
+			return texts
+		}
+
+		// equalTokens checks whether two nodes consist of the same tokens
+		equalTokens := func(left *ast.Node, right *ast.Node) bool {
+			leftTokens := getTokenTexts(left)
+			rightTokens := getTokenTexts(right)
+			if len(leftTokens) != len(rightTokens) {
+				return false
+			}
+			for i := range leftTokens {
+				if leftTokens[i] != rightTokens[i] {
+					return false
+				}
+			}
+			return true
+		}
+
+		return rule.RuleListeners{
+			ast.KindCallExpression: func(node *ast.Node) {
+				name := getCallOrNonVariadicApplyName(node)
+				if name == "" {
+					return
+				}
+
+				// `obj.foo.call(obj)` is bound to `obj`, while `foo.call(thisArg)` is unbound
+				callee := ast.SkipParentheses(node.AsCallExpression().Expression)
+				applied := ast.SkipParentheses(callee.AsPropertyAccessExpression().Expression)
+				thisArg := ast.SkipParentheses(node.Arguments()[0])
+
+				var isUseless bool
+				switch applied.Kind {
+				case ast.KindPropertyAccessExpression, ast.KindElementAccessExpression:
+					isUseless = equalTokens(ast.SkipParentheses(applied.Expression()), thisArg)
+				default:
+					isUseless = isNullOrUndefined(thisArg)
+				}
+
+				if isUseless {
+					ctx.ReportNode(node, buildUnnecessaryCallMessage(name))
+				}
+			},
+		}
+	},
+})
@@ -0,0 +1,190 @@
+package no_useless_call
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoUselessCallRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoUselessCallRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			// `this` binding is different
+			{Code: `foo.apply(obj, 1, 2);`},
+			{Code: `obj.foo.apply(null, 1, 2);`},
+			{Code: `obj.foo.apply(otherObj, 1, 2);`},
+			{Code: `a.b(x, y).c.foo.apply(a.b(x, z).c, 1, 2);`},
+			{Code: `foo.apply(obj, [1, 2]);`},
+			{Code: `obj.foo.apply(null, [1, 2]);`},
+			{Code: `obj.foo.apply(otherObj, [1, 2]);`},
+			{Code: `a.b(x, y).c.foo.apply(a.b(x, z).c, [1, 2]);`},
+			{Code: `a.b.foo.apply(a.b.c, [1, 2]);`},
+
+			// ignores variadic
+			{Code: `foo.apply(null, args);`},
+			{Code: `obj.foo.apply(obj, args);`},
+
+			// ignores computed property
+			{Code: `var call; foo[call](null, 1, 2);`},
+			{Code: `var apply; foo[apply](null, [1, 2]);`},
+
+			// ignores incomplete things
+			{Code: `foo.call();`},
+			{Code: `obj.foo.call();`},
+			{Code: `foo.apply();`},
+			{Code: `obj.foo.apply();`},
+
+			// Optional chaining
+			{Code: `obj?.foo.bar.call(obj.foo);`},
+			{Code: `obj.foo.call?.(obj.foo);`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			// call
+			{
+				Code: `foo.call(undefined, 1, 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `foo.call(void 0, 1, 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `foo.call(null, 1, 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `obj.foo.call(obj, 1, 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `a.b.c.foo.call(a.b.c, 1, 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `a.b(x, y).c.foo.call(a.b(x, y).c, 1, 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+
+			// apply
+			{
+				Code: `foo.apply(undefined, [1, 2]);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `foo.apply(void 0, [1, 2]);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `foo.apply(null, [1, 2]);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `obj.foo.apply(obj, [1, 2]);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `a.b.c.foo.apply(a.b.c, [1, 2]);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `a.b(x, y).c.foo.apply(a.b(x, y).c, [1, 2]);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `[].concat.apply([ ], [1, 2]);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `[].concat.apply([
+/*empty*/
+], [1, 2]);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `abc.get("foo", 0).concat.apply(abc . get("foo",  0 ), [1, 2]);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+
+			// Optional chaining and parentheses
+			{
+				Code: `foo.call?.(undefined, 1, 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `foo?.call(undefined, 1, 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `(foo?.call)(undefined, 1, 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `obj.foo.call?.(obj, 1, 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `obj?.foo.call(obj, 1, 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `(obj?.foo).call(obj, 1, 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `obj.foo.call((obj), 1, 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryCall", Line: 1, Column: 1},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,60 @@
+package no_useless_catch
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// Message builders
+func buildUnnecessaryCatchClauseMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unnecessaryCatchClause",
+		Description: "Unnecessary catch clause.",
+	}
+}
+
+func buildUnnecessaryCatchMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unnecessaryCatch",
+		Description: "Unnecessary try/catch wrapper.",
+	}
+}
+
+// NoUselessCatchRule disallows catch clauses that only rethrow the caught error
+var NoUselessCatchRule = rule.CreateRule(rule.Rule{
+	Name: "no-useless-catch",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		return rule.RuleListeners{
+			ast.KindCatchClause: func(node *ast.Node) {
+				clause := node.AsCatchClause()
+				if clause.VariableDeclaration == nil {
+					return
+				}
+				param := clause.VariableDeclaration.Name()
+				if param.Kind != ast.KindIdentifier {
+					return
+				}
+
+				statements := clause.Block.AsBlock().Statements.Nodes
+				if len(statements) == 0 || statements[0].Kind != ast.KindThrowStatement {
+					return
+				}
+				thrown := statements[0].Expression()
+				if thrown == nil {
+					return
+				}
+				thrown = ast.SkipParentheses(thrown)
+				if thrown.Kind != ast.KindIdentifier || thrown.Text() != param.Text() {
+					return
+				}
+
+				// Without a finally block, the whole try statement is useless
+				if node.Parent.AsTryStatement().FinallyBlock != nil {
+					ctx.ReportNode(node, buildUnnecessaryCatchClauseMessage())
+				} else {
+					ctx.ReportNode(node.Parent, buildUnnecessaryCatchMessage())
+				}
+			},
+		}
+	},
+})
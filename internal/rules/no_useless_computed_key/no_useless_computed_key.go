@@ -0,0 +1,137 @@
+package no_useless_computed_key
+
+import (
+	"slices"
+	"unicode/utf8"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Options for no-useless-computed-key rule
+type Options struct {
+	EnforceForClassMembers bool `json:"enforceForClassMembers"`
+}
+
+func parseOptions(options any) Options {
+	opts := Options{
+		EnforceForClassMembers: true,
+	}
+
+	if options == nil {
+		return opts
+	}
+
+	// Parse options with dual-format support (handles both array and object formats)
+	var optsMap map[string]interface{}
+	var ok bool
+
+	// Handle array format: [{ option: value }]
+	if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+		optsMap, ok = optArray[0].(map[string]interface{})
+	} else {
+		// Handle direct object format: { option: value }
+		optsMap, ok = options.(map[string]interface{})
+	}
+
+	if ok {
+		if v, ok := optsMap["enforceForClassMembers"].(bool); ok {
+			opts.EnforceForClassMembers = v
+		}
+	}
+	return opts
+}
+
+// Message builder
+func buildUnnecessarilyComputedPropertyMessage(property string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unnecessarilyComputedProperty",
+		Description: "Unnecessarily computed property [" + property + "] found.",
+	}
+}
+
+// getAllowedKeys returns the keys whose computed form has a different meaning than the plain one
+func getAllowedKeys(node *ast.Node) []string {
+	if !ast.IsClassLike(node.Parent) {
+		// `{ __proto__: x }` sets the prototype, `{ ['__proto__']: x }` defines a property
+		return []string{"__proto__"}
+	}
+	isStatic := ast.HasStaticModifier(node)
+	if node.Kind == ast.KindPropertyDeclaration {
+		// Fields named `constructor`, or static `prototype`, are syntax errors
+		if isStatic {
+			return []string{"constructor", "prototype"}
+		}
+		return []string{"constructor"}
+	}
+	if isStatic {
+		return []string{"prototype"}
+	}
+	return []string{"constructor"}
+}
+
+// NoUselessComputedKeyRule disallows unnecessary computed property keys in objects and classes
+var NoUselessComputedKeyRule = rule.CreateRule(rule.Rule{
+	Name: "no-useless-computed-key",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+
+		check := func(node *ast.Node, name *ast.Node) {
+			if name == nil || name.Kind != ast.KindComputedPropertyName {
+				return
+			}
+			key := ast.SkipParentheses(name.AsComputedPropertyName().Expression)
+			switch key.Kind {
+			case ast.KindStringLiteral:
+				if slices.Contains(getAllowedKeys(node), key.Text()) {
+					return
+				}
+			case ast.KindNumericLiteral:
+			default:
+				return
+			}
+
+			keyRange := utils.TrimNodeTextRange(ctx.SourceFile, key)
+			keyText := ctx.SourceFile.Text()[keyRange.Pos():keyRange.End()]
+			msg := buildUnnecessarilyComputedPropertyMessage(keyText)
+
+			nameRange := utils.TrimNodeTextRange(ctx.SourceFile, name)
+			if utils.HasCommentsInRange(ctx.SourceFile, nameRange) {
+				ctx.ReportNode(node, msg)
+				return
+			}
+
+			// Keep a space so `get[2]() {}` doesn't become `get2() {}`
+			replacement := keyText
+			if key.Kind == ast.KindNumericLiteral {
+				if token := ctx.GetTokenBefore(name); token != nil && token.Range.End() == nameRange.Pos() {
+					last, _ := utf8.DecodeLastRuneInString(token.Text)
+					if scanner.IsIdentifierPart(last) {
+						replacement = " " + replacement
+					}
+				}
+			}
+			ctx.ReportNodeWithFixes(node, msg, rule.RuleFixReplaceRange(nameRange, replacement))
+		}
+
+		checkMember := func(node *ast.Node) {
+			if ast.IsClassLike(node.Parent) && !opts.EnforceForClassMembers {
+				return
+			}
+			check(node, node.Name())
+		}
+
+		return rule.RuleListeners{
+			ast.KindPropertyAssignment:  checkMember,
+			ast.KindMethodDeclaration:   checkMember,
+			ast.KindGetAccessor:         checkMember,
+			ast.KindSetAccessor:         checkMember,
+			ast.KindPropertyDeclaration: checkMember,
+			ast.KindBindingElement: func(node *ast.Node) {
+				check(node, node.AsBindingElement().PropertyName)
+			},
+		}
+	},
+})
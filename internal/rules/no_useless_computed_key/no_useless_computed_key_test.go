@@ -0,0 +1,154 @@
+package no_useless_computed_key
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoUselessComputedKeyRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoUselessComputedKeyRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `({ 'a': 0, b(){} })`},
+			{Code: `({ [x]: 0 });`},
+			{Code: `({ a: 0, [b](){} })`},
+			{Code: "({ [`a`]: 0 })"},
+			{Code: `({ [-1]: 0 })`},
+			{Code: `({ ['__proto__']: [] })`},
+			{Code: `const { [a]: b } = obj;`},
+			{Code: `class Foo { a() {} }`},
+			{Code: `class Foo { [x]() {} }`},
+			{Code: `class Foo { ['constructor']() {} }`},
+			{Code: `class Foo { static ['prototype']() {} }`},
+			{Code: `class Foo { static get ['prototype']() { return 1; } }`},
+			{Code: `class Foo { ['constructor'] = 1 }`},
+			{Code: `class Foo { static ['constructor'] = 1 }`},
+			{Code: `class Foo { static ['prototype'] = 1 }`},
+			{Code: `class Foo { ['x']() {} }`, Options: map[string]interface{}{"enforceForClassMembers": false}},
+			{Code: `class Foo { [0] = 1 }`, Options: []interface{}{map[string]interface{}{"enforceForClassMembers": false}}},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:   `({ ['0']: 0 })`,
+				Output: []string{`({ '0': 0 })`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 4},
+				},
+			},
+			{
+				Code:   `({ [0]: 1 })`,
+				Output: []string{`({ 0: 1 })`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 4},
+				},
+			},
+			{
+				Code:   `({ ['x']() {} })`,
+				Output: []string{`({ 'x'() {} })`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 4},
+				},
+			},
+			{
+				Code:   `({ [('x')]: 1 })`,
+				Output: []string{`({ 'x': 1 })`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 4},
+				},
+			},
+			{
+				Code:   `({ get[2]() { return 1; } })`,
+				Output: []string{`({ get 2() { return 1; } })`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 4},
+				},
+			},
+			{
+				Code:   `({ async*['x']() {} })`,
+				Output: []string{`({ async*'x'() {} })`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 4},
+				},
+			},
+			{
+				Code:   `const { ['x']: y } = obj;`,
+				Output: []string{`const { 'x': y } = obj;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 9},
+				},
+			},
+			{
+				Code: `({ [/* comment */ 'x']: 1 })`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 4},
+				},
+			},
+
+			// Class members
+			{
+				Code:   `class C { ['foo']() {} }`,
+				Output: []string{`class C { 'foo'() {} }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 11},
+				},
+			},
+			{
+				Code:   `class C { static ['foo']() {} }`,
+				Output: []string{`class C { static 'foo'() {} }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 11},
+				},
+			},
+			{
+				Code:   `class C { static[0]() {} }`,
+				Output: []string{`class C { static 0() {} }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 11},
+				},
+			},
+			{
+				Code:   `class C { get ['foo']() { return 1; } }`,
+				Output: []string{`class C { get 'foo'() { return 1; } }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 11},
+				},
+			},
+			{
+				Code:   `class C { ['prototype']() {} }`,
+				Output: []string{`class C { 'prototype'() {} }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 11},
+				},
+			},
+			{
+				Code:   `class C { static ['constructor']() {} }`,
+				Output: []string{`class C { static 'constructor'() {} }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 11},
+				},
+			},
+			{
+				Code:   `class C { [0] = 1; ['a']: string; }`,
+				Output: []string{`class C { 0 = 1; 'a': string; }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 11},
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 20},
+				},
+			},
+			{
+				Code:   `class C { ['__proto__'] = 1 }`,
+				Output: []string{`class C { '__proto__' = 1 }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyComputedProperty", Line: 1, Column: 11},
+				},
+			},
+		},
+	)
+}
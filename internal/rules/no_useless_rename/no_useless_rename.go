@@ -0,0 +1,178 @@
+package no_useless_rename
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Options for no-useless-rename rule
+type Options struct {
+	IgnoreDestructuring bool `json:"ignoreDestructuring"`
+	IgnoreImport        bool `json:"ignoreImport"`
+	IgnoreExport        bool `json:"ignoreExport"`
+}
+
+func parseOptions(options any) Options {
+	opts := Options{}
+
+	if options == nil {
+		return opts
+	}
+
+	// Parse options with dual-format support (handles both array and object formats)
+	var optsMap map[string]interface{}
+	var ok bool
+
+	// Handle array format: [{ option: value }]
+	if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+		optsMap, ok = optArray[0].(map[string]interface{})
+	} else {
+		// Handle direct object format: { option: value }
+		optsMap, ok = options.(map[string]interface{})
+	}
+
+	if ok {
+		if v, ok := optsMap["ignoreDestructuring"].(bool); ok {
+			opts.IgnoreDestructuring = v
+		}
+		if v, ok := optsMap["ignoreImport"].(bool); ok {
+			opts.IgnoreImport = v
+		}
+		if v, ok := optsMap["ignoreExport"].(bool); ok {
+			opts.IgnoreExport = v
+		}
+	}
+	return opts
+}
+
+// Message builder
+func buildUnnecessarilyRenamedMessage(renameType string, name string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unnecessarilyRenamed",
+		Description: renameType + " " + name + " unnecessarily renamed.",
+	}
+}
+
+// getStaticName returns the name of an identifier or string literal, or "" for anything else
+func getStaticName(node *ast.Node) string {
+	if node == nil {
+		return ""
+	}
+	switch node.Kind {
+	case ast.KindIdentifier, ast.KindStringLiteral:
+		return node.Text()
+	}
+	return ""
+}
+
+// NoUselessRenameRule disallows renaming import, export, and destructured assignments to the same name
+var NoUselessRenameRule = rule.CreateRule(rule.Rule{
+	Name: "no-useless-rename",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+
+		// report reports node, removing removeRange when that doesn't drop any comments
+		report := func(node *ast.Node, renameType string, name string, removeRange core.TextRange) {
+			msg := buildUnnecessarilyRenamedMessage(renameType, name)
+			if utils.HasCommentsInRange(ctx.SourceFile, removeRange) {
+				ctx.ReportNode(node, msg)
+				return
+			}
+			ctx.ReportNodeWithFixes(node, msg, rule.RuleFixRemoveRange(removeRange))
+		}
+
+		// removeBefore is the range from the start of from up to the start of to
+		removeBefore := func(from *ast.Node, to *ast.Node) core.TextRange {
+			return core.NewTextRange(utils.TrimNodeTextRange(ctx.SourceFile, from).Pos(), utils.TrimNodeTextRange(ctx.SourceFile, to).Pos())
+		}
+
+		return rule.RuleListeners{
+			// const { foo: foo } = bar
+			ast.KindBindingElement: func(node *ast.Node) {
+				if opts.IgnoreDestructuring || node.Parent.Kind != ast.KindObjectBindingPattern {
+					return
+				}
+				element := node.AsBindingElement()
+				if element.PropertyName == nil || element.DotDotDotToken != nil {
+					return
+				}
+				key := getStaticName(element.PropertyName)
+				if key == "" || element.Name().Kind != ast.KindIdentifier || element.Name().Text() != key {
+					return
+				}
+				report(node, "Destructuring assignment", key, removeBefore(element.PropertyName, element.Name()))
+			},
+
+			// ({ foo: foo } = bar)
+			rule.ListenerOnAllowPattern(ast.KindObjectLiteralExpression): func(node *ast.Node) {
+				if opts.IgnoreDestructuring {
+					return
+				}
+				for _, property := range node.AsObjectLiteralExpression().Properties.Nodes {
+					if property.Kind != ast.KindPropertyAssignment {
+						continue
+					}
+					key := getStaticName(property.Name())
+					if key == "" {
+						continue
+					}
+
+					value := property.Initializer()
+					target := value
+					if ast.IsAssignmentExpression(value, true) {
+						target = value.AsBinaryExpression().Left
+					}
+					if ast.SkipParentheses(target).Kind != ast.KindIdentifier || ast.SkipParentheses(target).Text() != key {
+						continue
+					}
+
+					msg := buildUnnecessarilyRenamedMessage("Destructuring assignment", key)
+					switch {
+					case target.Kind == ast.KindIdentifier:
+						report(property, "Destructuring assignment", key, removeBefore(property.Name(), value))
+					case target == value && !utils.HasCommentsInRange(ctx.SourceFile, utils.TrimNodeTextRange(ctx.SourceFile, property)):
+						// `({ foo: (foo) } = bar)`; parentheses aren't allowed in shorthand properties
+						ctx.ReportNodeWithFixes(property, msg, rule.RuleFixReplace(ctx.SourceFile, property, key))
+					default:
+						// `({ foo: (foo) = baz } = bar)` can't be written as a shorthand property
+						ctx.ReportNode(property, msg)
+					}
+				}
+			},
+
+			// import { foo as foo } from 'bar'
+			ast.KindImportSpecifier: func(node *ast.Node) {
+				if opts.IgnoreImport {
+					return
+				}
+				specifier := node.AsImportSpecifier()
+				if specifier.PropertyName == nil {
+					return
+				}
+				imported := getStaticName(specifier.PropertyName)
+				if imported == "" || imported != specifier.Name().Text() {
+					return
+				}
+				report(node, "Import", imported, removeBefore(specifier.PropertyName, specifier.Name()))
+			},
+
+			// export { foo as foo }
+			ast.KindExportSpecifier: func(node *ast.Node) {
+				if opts.IgnoreExport {
+					return
+				}
+				specifier := node.AsExportSpecifier()
+				if specifier.PropertyName == nil {
+					return
+				}
+				local := getStaticName(specifier.PropertyName)
+				if local == "" || local != getStaticName(specifier.Name()) {
+					return
+				}
+				report(node, "Export", local, core.NewTextRange(specifier.PropertyName.End(), specifier.Name().End()))
+			},
+		}
+	},
+})
@@ -0,0 +1,178 @@
+package no_useless_rename
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoUselessRenameRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoUselessRenameRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `let {foo} = obj;`},
+			{Code: `let {foo: bar} = obj;`},
+			{Code: `let {foo: bar, baz: qux} = obj;`},
+			{Code: `let {foo: {bar: baz}} = obj;`},
+			{Code: `let {foo, bar: {baz: qux}} = obj;`},
+			{Code: `let {'foo': bar} = obj;`},
+			{Code: `let {[foo]: foo} = obj;`},
+			{Code: `let {['foo']: foo} = obj;`},
+			{Code: `let {1: foo} = obj;`},
+			{Code: `let {...stuff} = obj;`},
+			{Code: `function func({foo}) {}`},
+			{Code: `function func({foo: bar}) {}`},
+			{Code: `const func = ({foo: bar}) => {}`},
+			{Code: `({foo} = obj);`},
+			{Code: `({foo: bar} = obj);`},
+			{Code: `({foo: bar = 1} = obj);`},
+			{Code: `({[foo]: foo} = obj);`},
+			{Code: `import {foo} from 'foo';`},
+			{Code: `import {foo as bar} from 'foo';`},
+			{Code: `import {"foo" as bar} from 'foo';`},
+			{Code: `import * as foo from 'foo';`},
+			{Code: `import foo, {bar} from 'foo';`},
+			{Code: `export {foo} from 'foo';`},
+			{Code: `export {foo as bar} from 'foo';`},
+			{Code: `export {"foo" as bar} from 'foo';`},
+			{Code: `export {foo as "bar"} from 'foo';`},
+			{Code: `export * as foo from 'foo';`},
+			{Code: `const foo = 1; export {foo};`},
+			{Code: `const foo = 1; export {foo as bar};`},
+
+			// Options
+			{Code: `const {foo: foo} = obj;`, Options: map[string]interface{}{"ignoreDestructuring": true}},
+			{Code: `({foo: foo} = obj);`, Options: map[string]interface{}{"ignoreDestructuring": true}},
+			{Code: `import {foo as foo} from 'foo';`, Options: map[string]interface{}{"ignoreImport": true}},
+			{Code: `export {foo as foo} from 'foo';`, Options: map[string]interface{}{"ignoreExport": true}},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			// Destructuring
+			{
+				Code:   `let {foo: foo} = obj;`,
+				Output: []string{`let {foo} = obj;`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 6}},
+			},
+			{
+				Code:   `let {a, foo: foo} = obj;`,
+				Output: []string{`let {a, foo} = obj;`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 9}},
+			},
+			{
+				Code:   `let {foo: foo, bar: bar} = obj;`,
+				Output: []string{`let {foo, bar} = obj;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessarilyRenamed", Line: 1, Column: 6},
+					{MessageId: "unnecessarilyRenamed", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code:   `let {foo: {bar: bar}} = obj;`,
+				Output: []string{`let {foo: {bar}} = obj;`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 12}},
+			},
+			{
+				Code:   `let {'foo': foo} = obj;`,
+				Output: []string{`let {foo} = obj;`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 6}},
+			},
+			{
+				Code:   `let {foo: foo = 1} = obj;`,
+				Output: []string{`let {foo = 1} = obj;`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 6}},
+			},
+			{
+				Code:   `function func({foo: foo}) {}`,
+				Output: []string{`function func({foo}) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 16}},
+			},
+			{
+				Code:   `const func = ({foo: foo, bar}) => {}`,
+				Output: []string{`const func = ({foo, bar}) => {}`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 16}},
+			},
+			{
+				Code:   `({foo: foo} = obj);`,
+				Output: []string{`({foo} = obj);`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 3}},
+			},
+			{
+				Code:   `({foo: foo = 1} = obj);`,
+				Output: []string{`({foo = 1} = obj);`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 3}},
+			},
+			{
+				Code:   `({foo: (foo)} = obj);`,
+				Output: []string{`({foo} = obj);`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 3}},
+			},
+			{
+				Code:   `({foo: (foo) = a} = obj);`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 3}},
+			},
+			{
+				Code:   `let {foo /* comment */: foo} = obj;`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 6}},
+			},
+
+			// Import
+			{
+				Code:   `import {foo as foo} from 'foo';`,
+				Output: []string{`import {foo} from 'foo';`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 9}},
+			},
+			{
+				Code:   `import {"foo" as foo} from 'foo';`,
+				Output: []string{`import {foo} from 'foo';`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 9}},
+			},
+			{
+				Code:   `import {foo as foo, bar as baz} from 'foo';`,
+				Output: []string{`import {foo, bar as baz} from 'foo';`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 9}},
+			},
+			{
+				Code:   `import {type foo as foo} from 'foo';`,
+				Output: []string{`import {type foo} from 'foo';`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 9}},
+			},
+			{
+				Code:   `import {foo /* comment */ as foo} from 'foo';`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 9}},
+			},
+
+			// Export
+			{
+				Code:   `const foo = 1; export {foo as foo};`,
+				Output: []string{`const foo = 1; export {foo};`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 24}},
+			},
+			{
+				Code:   `export {foo as foo} from 'foo';`,
+				Output: []string{`export {foo} from 'foo';`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 9}},
+			},
+			{
+				Code:   `export {"foo" as "foo"} from 'foo';`,
+				Output: []string{`export {"foo"} from 'foo';`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 9}},
+			},
+			{
+				Code:   `export {foo as "foo"} from 'foo';`,
+				Output: []string{`export {foo} from 'foo';`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 9}},
+			},
+			{
+				Code:   `export {foo as foo /* comment */} from 'foo';`,
+				Output: []string{`export {foo /* comment */} from 'foo';`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "unnecessarilyRenamed", Line: 1, Column: 9}},
+			},
+		},
+	)
+}
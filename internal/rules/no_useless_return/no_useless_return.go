@@ -0,0 +1,118 @@
+package no_useless_return
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builder
+func buildUnnecessaryReturnMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unnecessaryReturn",
+		Description: "Unnecessary return statement.",
+	}
+}
+
+// isExecuted checks whether reaching statement runs any code, function declarations are hoisted and
+// type-only or ambient declarations are erased
+func isExecuted(statement *ast.Node) bool {
+	switch statement.Kind {
+	case ast.KindFunctionDeclaration, ast.KindEmptyStatement, ast.KindInterfaceDeclaration, ast.KindTypeAliasDeclaration:
+		return false
+	}
+	return !ast.HasSyntacticModifier(statement, ast.ModifierFlagsAmbient)
+}
+
+// executesAfter checks whether any statement following node in statements runs
+func executesAfter(statements []*ast.Node, node *ast.Node) bool {
+	after := false
+	for _, statement := range statements {
+		if after && isExecuted(statement) {
+			return true
+		}
+		after = after || statement == node
+	}
+	return false
+}
+
+// isUseless checks whether the function ends right after node anyway, in which case returning early
+// doesn't skip anything
+func isUseless(node *ast.Node) bool {
+	for current := node; ; current = current.Parent {
+		parent := current.Parent
+		switch parent.Kind {
+		case ast.KindBlock:
+			if executesAfter(parent.AsBlock().Statements.Nodes, current) {
+				return false
+			}
+			if ast.IsFunctionLike(parent.Parent) {
+				return true
+			}
+		case ast.KindCaseClause, ast.KindDefaultClause:
+			if executesAfter(parent.AsCaseOrDefaultClause().Statements.Nodes, current) {
+				return false
+			}
+			// Without the return, control would fall through the following clauses
+			for _, clause := range clausesAfter(parent) {
+				for _, statement := range clause.AsCaseOrDefaultClause().Statements.Nodes {
+					if isExecuted(statement) {
+						return false
+					}
+				}
+			}
+		case ast.KindIfStatement, ast.KindLabeledStatement, ast.KindCaseBlock, ast.KindSwitchStatement,
+			ast.KindCatchClause, ast.KindWithStatement:
+			// Control continues after the enclosing statement
+		case ast.KindTryStatement:
+			// A return in finally overrides the completion of the try statement
+			if parent.AsTryStatement().FinallyBlock == current {
+				return false
+			}
+		default:
+			// Loops, where the return exits the iteration, and anything unexpected
+			return false
+		}
+	}
+}
+
+// clausesAfter returns the clauses following clause in its switch statement
+func clausesAfter(clause *ast.Node) []*ast.Node {
+	clauses := clause.Parent.AsCaseBlock().Clauses.Nodes
+	for i, c := range clauses {
+		if c == clause {
+			return clauses[i+1:]
+		}
+	}
+	return nil
+}
+
+// isRemovable checks whether node is in a statement list, where removing it leaves valid code
+func isRemovable(node *ast.Node) bool {
+	switch node.Parent.Kind {
+	case ast.KindBlock, ast.KindCaseClause, ast.KindDefaultClause:
+		return true
+	}
+	return false
+}
+
+// NoUselessReturnRule disallows redundant return statements
+var NoUselessReturnRule = rule.CreateRule(rule.Rule{
+	Name: "no-useless-return",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		return rule.RuleListeners{
+			ast.KindReturnStatement: func(node *ast.Node) {
+				if node.AsReturnStatement().Expression != nil || !isUseless(node) {
+					return
+				}
+
+				textRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+				if !isRemovable(node) || utils.HasCommentsInRange(ctx.SourceFile, textRange) {
+					ctx.ReportNode(node, buildUnnecessaryReturnMessage())
+					return
+				}
+				ctx.ReportNodeWithFixes(node, buildUnnecessaryReturnMessage(), rule.RuleFixRemoveRange(textRange))
+			},
+		}
+	},
+})
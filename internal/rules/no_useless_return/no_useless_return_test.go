@@ -0,0 +1,180 @@
+package no_useless_return
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoUselessReturnRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoUselessReturnRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `function foo() { return 5; }`},
+			{Code: `function foo() { return null; }`},
+			{Code: `function foo() { return doSomething(); }`},
+			{Code: `function foo() { if (bar) { doSomething(); return; } else { doSomethingElse(); } qux(); }`},
+			{Code: `function foo() { switch (bar) { case 1: doSomething(); return; default: doSomethingElse(); } }`},
+			{Code: `function foo() { switch (bar) { default: doSomething(); return; case 1: doSomething(); } }`},
+			{Code: `function foo() { switch (bar) { case 1: if (a) { doSomething(); return; } break; default: doSomethingElse(); } }`},
+			{Code: `function foo() { switch (bar) { case 1: if (a) { doSomething(); return; } else { doSomething(); } default: doSomethingElse(); } }`},
+			{Code: `function foo() { switch (bar) { case 1: if (a) { doSomething(); return; } } doSomethingElse(); }`},
+			{Code: `function foo() { for (const foo of bar) return; }`},
+			{Code: `function foo() { for (const foo of bar) { doSomething(); return; } }`},
+			{Code: `function foo() { while (bar) { if (baz) return; } }`},
+			{Code: `function foo() { do { return; } while (bar); }`},
+			{Code: `function foo() { for (;;) { return; } }`},
+			{Code: `function foo() { try { return 5; } finally { return; } }`},
+			{Code: `function foo() { try { bar(); } finally { if (baz) return; } }`},
+			{Code: `function foo() { try { bar(); return; } catch (err) {} baz(); }`},
+			{Code: `function foo() { if (foo) { return; } return 5; }`},
+			{Code: `function foo() { return; doSomething(); }`},
+			{Code: `function foo() { if (foo) { return; } doSomething(); }`},
+			{Code: `function foo() { bar: { if (foo) { return; } doSomething(); } }`},
+			{Code: `() => { if (foo) return; bar(); }`},
+			{Code: `() => 5`},
+			{Code: `class A { foo() { if (bar) return; baz(); } }`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:   `function foo() { return; }`,
+				Output: []string{`function foo() {  }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 18},
+				},
+			},
+			{
+				Code:   `function foo() { doSomething(); return; }`,
+				Output: []string{`function foo() { doSomething();  }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 33},
+				},
+			},
+			{
+				Code:   `function foo() { if (condition) { bar(); return; } else { baz(); } }`,
+				Output: []string{`function foo() { if (condition) { bar();  } else { baz(); } }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 42},
+				},
+			},
+			{
+				Code: `function foo() { if (foo) return; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 27},
+				},
+			},
+			{
+				Code: `function foo() { bar(); return/**/; }`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 25},
+				},
+			},
+			{
+				Code:   `function foo() { bar(); return; /* comment */ }`,
+				Output: []string{`function foo() { bar();  /* comment */ }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 25},
+				},
+			},
+			{
+				Code:   `function foo() { switch (bar) { case 1: doSomething(); default: doSomethingElse(); return; } }`,
+				Output: []string{`function foo() { switch (bar) { case 1: doSomething(); default: doSomethingElse();  } }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 84},
+				},
+			},
+			{
+				Code:   `function foo() { switch (bar) { default: doSomething(); case 1: doSomething(); return; } }`,
+				Output: []string{`function foo() { switch (bar) { default: doSomething(); case 1: doSomething();  } }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 80},
+				},
+			},
+			{
+				Code:   `function foo() { switch (bar) { case 1: doSomething(); return; default: } }`,
+				Output: []string{`function foo() { switch (bar) { case 1: doSomething();  default: } }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 56},
+				},
+			},
+			{
+				Code:   `function foo() { try { return; } catch (err) { foo(); } }`,
+				Output: []string{`function foo() { try {  } catch (err) { foo(); } }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 24},
+				},
+			},
+			{
+				Code:   `function foo() { try { foo(); } catch (err) { return; } }`,
+				Output: []string{`function foo() { try { foo(); } catch (err) {  } }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 47},
+				},
+			},
+			{
+				Code:   `function foo() { try { foo(); return; } finally { bar(); } }`,
+				Output: []string{`function foo() { try { foo();  } finally { bar(); } }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 31},
+				},
+			},
+			{
+				Code:   `function foo() { bar: { doSomething(); return; } }`,
+				Output: []string{`function foo() { bar: { doSomething();  } }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 40},
+				},
+			},
+			{
+				Code:   `const foo = () => { return; }`,
+				Output: []string{`const foo = () => {  }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 21},
+				},
+			},
+			{
+				Code:   `function foo() { for (const x of y) { bar(() => { return; }); } }`,
+				Output: []string{`function foo() { for (const x of y) { bar(() => {  }); } }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 51},
+				},
+			},
+			{
+				Code:   `class A { foo() { bar(); return; } }`,
+				Output: []string{`class A { foo() { bar();  } }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 26},
+				},
+			},
+
+			// Statements that never run don't need the early return
+			{
+				Code:   `function foo() { doSomething(); return; function bar() {} }`,
+				Output: []string{`function foo() { doSomething();  function bar() {} }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 33},
+				},
+			},
+			{
+				Code:   `function foo() { if (a) { return; } ; }`,
+				Output: []string{`function foo() { if (a) {  } ; }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 27},
+				},
+			},
+			{
+				Code:   `function foo() { doSomething(); return; interface Bar {} type Baz = Bar; }`,
+				Output: []string{`function foo() { doSomething();  interface Bar {} type Baz = Bar; }`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unnecessaryReturn", Line: 1, Column: 33},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,159 @@
+package no_warning_comments
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// Comments longer than this are truncated in the message
+const commentCharLimit = 40
+
+var (
+	// Inline configuration of this rule may mention its own terms
+	selfConfigPattern       = regexp.MustCompile(`\bno-warning-comments\b`)
+	directiveCommentPattern = regexp.MustCompile(`^\s*(?:eslint(?:-disable(?:-next)?-line|-disable|-enable|-env)?|globals?|exported)\b`)
+)
+
+// Options for no-warning-comments rule
+type Options struct {
+	Terms      []string `json:"terms"`
+	Location   string   `json:"location"`
+	Decoration []string `json:"decoration"`
+}
+
+func parseOptions(options any) Options {
+	opts := Options{
+		Terms:    []string{"todo", "fixme", "xxx"},
+		Location: "start",
+	}
+
+	if options == nil {
+		return opts
+	}
+
+	// Parse options with dual-format support (handles both array and object formats)
+	var optsMap map[string]interface{}
+	var ok bool
+
+	// Handle array format: [{ option: value }]
+	if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+		optsMap, ok = optArray[0].(map[string]interface{})
+	} else {
+		// Handle direct object format: { option: value }
+		optsMap, ok = options.(map[string]interface{})
+	}
+
+	if ok {
+		if terms, ok := optsMap["terms"].([]interface{}); ok {
+			opts.Terms = make([]string, 0, len(terms))
+			for _, term := range terms {
+				if s, ok := term.(string); ok {
+					opts.Terms = append(opts.Terms, s)
+				}
+			}
+		}
+		if v, ok := optsMap["location"].(string); ok {
+			opts.Location = v
+		}
+		if decoration, ok := optsMap["decoration"].([]interface{}); ok {
+			for _, d := range decoration {
+				if s, ok := d.(string); ok {
+					opts.Decoration = append(opts.Decoration, s)
+				}
+			}
+		}
+	}
+	return opts
+}
+
+// Message builders
+func buildUnexpectedCommentMessage(matchedTerm string, comment string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpectedComment",
+		Description: "Unexpected '" + matchedTerm + "' comment: '" + comment + "'.",
+	}
+}
+
+func isWordChar(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
+
+// escapeCharClass escapes characters for use inside a regexp character class
+func escapeCharClass(chars string) string {
+	var sb strings.Builder
+	for _, r := range chars {
+		// Any ASCII punctuation can be escaped, which keeps `]`, `^`, `-` and `\` literal
+		if r < utf8.RuneSelf && !isWordChar(r) {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// convertToRegExp builds the case-insensitive pattern matching term in a comment.
+// With location "start" the term may only be preceded by whitespace and decoration characters,
+// otherwise terms starting or ending with a word character must be whole words.
+func convertToRegExp(term string, location string, decoration string) *regexp.Regexp {
+	prefix := ""
+	if location == "start" {
+		prefix = `^[\s` + escapeCharClass(decoration) + `]*`
+	} else if term != "" && isWordChar(rune(term[0])) {
+		prefix = `\b`
+	}
+
+	suffix := ""
+	if term != "" && isWordChar(rune(term[len(term)-1])) {
+		suffix = `\b`
+	}
+
+	return regexp.MustCompile(`(?i)` + prefix + regexp.QuoteMeta(term) + suffix)
+}
+
+// getCommentToDisplay returns the words of comment up to the message length limit
+func getCommentToDisplay(comment string) string {
+	display := ""
+	for _, word := range strings.Fields(comment) {
+		candidate := word
+		if display != "" {
+			candidate = display + " " + word
+		}
+		if len(candidate) > commentCharLimit {
+			return display + "..."
+		}
+		display = candidate
+	}
+	return display
+}
+
+// NoWarningCommentsRule disallows specified warning terms in comments
+var NoWarningCommentsRule = rule.CreateRule(rule.Rule{
+	Name: "no-warning-comments",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+
+		decoration := strings.Join(opts.Decoration, "")
+		patterns := make([]*regexp.Regexp, len(opts.Terms))
+		for i, term := range opts.Terms {
+			patterns[i] = convertToRegExp(term, opts.Location, decoration)
+		}
+
+		for _, comment := range ctx.GetAllComments() {
+			if directiveCommentPattern.MatchString(comment.Text) && selfConfigPattern.MatchString(comment.Text) {
+				continue
+			}
+
+			for i, pattern := range patterns {
+				if !pattern.MatchString(comment.Text) {
+					continue
+				}
+				ctx.ReportRange(comment.Range, buildUnexpectedCommentMessage(opts.Terms[i], getCommentToDisplay(comment.Text)))
+			}
+		}
+
+		return rule.RuleListeners{}
+	},
+})
@@ -0,0 +1,180 @@
+package no_warning_comments
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoWarningCommentsRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoWarningCommentsRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `// any comment`, Options: map[string]interface{}{"terms": []interface{}{"fixme"}}},
+			{Code: `// any comment`, Options: map[string]interface{}{"terms": []interface{}{"fixme", "todo"}}},
+			{Code: `// any comment`},
+			{Code: `// any comment`, Options: map[string]interface{}{"location": "anywhere"}},
+			{Code: `// any comment with TODO, FIXME or XXX`, Options: map[string]interface{}{"location": "start"}},
+			{Code: `// any comment with TODO, FIXME or XXX`},
+			{Code: `// mentions todo in prose`},
+			{Code: `/* any block comment */`, Options: map[string]interface{}{"terms": []interface{}{"fixme"}}},
+			{Code: `/* any block comment with TODO, FIXME or XXX */`},
+			{Code: `/* any block comment with (TODO, FIXME's or XXX!) */`},
+			{Code: `// comments containing terms as substrings like TodoMVC`, Options: map[string]interface{}{"terms": []interface{}{"todo"}, "location": "anywhere"}},
+			{Code: `// special regex characters don't cause a problem`, Options: map[string]interface{}{"terms": []interface{}{"[aeiou]"}, "location": "anywhere"}},
+			{Code: "/*eslint no-warning-comments: [2, { \"terms\": [\"todo\", \"fixme\"], \"location\": \"anywhere\" }]*/\n\nvar x = 10;\n", Options: map[string]interface{}{"location": "anywhere"}},
+			{Code: `// foo`, Options: map[string]interface{}{"terms": []interface{}{"foo-bar"}}},
+			{Code: "/** multi-line block comment with lines starting with\nTODO\nFIXME or\nXXX\n*/"},
+			{Code: `//!TODO `, Options: map[string]interface{}{"decoration": []interface{}{"*"}}},
+			{Code: `// todos are fine`, Options: map[string]interface{}{"location": "anywhere"}},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `// fixme`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `// TODO: do something`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `// mentions todo in prose`,
+				Options: map[string]interface{}{"location": "anywhere"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `// any fixme`,
+				Options: map[string]interface{}{"terms": []interface{}{"fixme"}, "location": "anywhere"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `// any FIXME`,
+				Options: map[string]interface{}{"terms": []interface{}{"fixme"}, "location": "anywhere"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `// any fIxMe`,
+				Options: map[string]interface{}{"terms": []interface{}{"fixme"}, "location": "anywhere"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `/* any fixme */`,
+				Options: map[string]interface{}{"terms": []interface{}{"FIXME"}, "location": "anywhere"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `// any fixme or todo`,
+				Options: map[string]interface{}{"terms": []interface{}{"fixme", "todo"}, "location": "anywhere"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `/* TODO */`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `/* any block comment with (TODO, FIXME's or XXX!) */`,
+				Options: map[string]interface{}{"location": "anywhere"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `// regex [litera|$]`,
+				Options: map[string]interface{}{"terms": []interface{}{"[litera|$]"}, "location": "anywhere"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    "foo();\n/* with a todo inside */",
+				Options: map[string]interface{}{"location": "anywhere"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 2, Column: 1},
+				},
+			},
+
+			// Decoration characters before the term
+			{
+				Code:    `//!TODO `,
+				Options: map[string]interface{}{"decoration": []interface{}{"!"}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `//!!!TODO `,
+				Options: map[string]interface{}{"decoration": []interface{}{"!"}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `// * * *TODO `,
+				Options: []interface{}{map[string]interface{}{"decoration": []interface{}{"*"}}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `/** * - TODO */`,
+				Options: map[string]interface{}{"decoration": []interface{}{"*", "-"}},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedComment", Line: 1, Column: 1},
+				},
+			},
+		},
+	)
+}
+
+func TestUnexpectedCommentMessage(t *testing.T) {
+	tests := []struct {
+		term     string
+		comment  string
+		expected string
+	}{
+		{
+			term:     "todo",
+			comment:  " TODO: do something",
+			expected: "Unexpected 'todo' comment: 'TODO: do something'.",
+		},
+		{
+			term:     "todo",
+			comment:  " Lorem ipsum dolor sit amet, consectetur adipiscing elit. TODO",
+			expected: "Unexpected 'todo' comment: 'Lorem ipsum dolor sit amet, consectetur...'.",
+		},
+	}
+
+	for _, tt := range tests {
+		message := buildUnexpectedCommentMessage(tt.term, getCommentToDisplay(tt.comment))
+		if message.Description != tt.expected {
+			t.Errorf("expected %q, got %q", tt.expected, message.Description)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package no_whitespace_before_property
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builder
+func buildUnexpectedWhitespaceMessage(propName string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpectedWhitespace",
+		Description: "Unexpected whitespace before property " + propName + ".",
+	}
+}
+
+// decimalIntegerPattern matches number literals such as `5`, where `5.toString()` would be a syntax error
+var decimalIntegerPattern = regexp.MustCompile(`^(?:0|0[0-7]*[89]\d*|[1-9](?:_?\d)*)$`)
+
+// NoWhitespaceBeforePropertyRule disallows whitespace before properties
+var NoWhitespaceBeforePropertyRule = rule.CreateRule(rule.Rule{
+	Name: "no-whitespace-before-property",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		text := ctx.SourceFile.Text()
+
+		getLine := func(pos int) int {
+			line, _ := scanner.GetLineAndCharacterOfPosition(ctx.SourceFile, pos)
+			return line
+		}
+
+		// hasWhitespace checks for whitespace outside of comments between pos and end
+		hasWhitespace := func(pos int, end int) bool {
+			for comment := range utils.GetCommentsInRange(ctx.SourceFile, core.NewTextRange(pos, end)) {
+				if comment.Pos() < pos {
+					continue
+				}
+				if strings.ContainsFunc(text[pos:comment.Pos()], unicode.IsSpace) {
+					return true
+				}
+				pos = comment.End()
+			}
+			return strings.ContainsFunc(text[pos:end], unicode.IsSpace)
+		}
+
+		check := func(node *ast.Node, object *ast.Node, property *ast.Node, computed bool, optional bool) {
+			// The gap spans from the object to the property name, or to the `[` of a computed access
+			objectEnd := object.End()
+			propertyStart := utils.TrimNodeTextRange(ctx.SourceFile, property).Pos()
+			if getLine(objectEnd) != getLine(propertyStart) {
+				return
+			}
+
+			// Collect the trivia around the `.`, `?.` and `[` punctuators in the gap
+			var trivia []core.TextRange
+			gapEnd := propertyStart
+			pos := objectEnd
+			s := scanner.GetScannerForSourceFile(ctx.SourceFile, objectEnd)
+			for s.Token() != ast.KindEndOfFile && s.TokenStart() < propertyStart {
+				trivia = append(trivia, core.NewTextRange(pos, s.TokenStart()))
+				if s.Token() == ast.KindOpenBracketToken {
+					gapEnd = s.TokenStart()
+					break
+				}
+				pos = s.TokenEnd()
+				s.Scan()
+			}
+			if gapEnd == propertyStart {
+				trivia = append(trivia, core.NewTextRange(pos, propertyStart))
+			}
+
+			if !utils.Some(trivia, func(textRange core.TextRange) bool {
+				return hasWhitespace(textRange.Pos(), textRange.End())
+			}) {
+				return
+			}
+
+			msg := buildUnexpectedWhitespaceMessage(text[propertyStart:property.End()])
+
+			// Don't fix `5 .toString()` into a syntax error, or remove comments
+			objectText := text[utils.TrimNodeTextRange(ctx.SourceFile, object).Pos():objectEnd]
+			if (!computed && !optional && object.Kind == ast.KindNumericLiteral && decimalIntegerPattern.MatchString(objectText)) ||
+				utils.Some(trivia, func(textRange core.TextRange) bool {
+					return utils.HasCommentsInRange(ctx.SourceFile, textRange)
+				}) {
+				ctx.ReportNode(node, msg)
+				return
+			}
+
+			replacement := ""
+			if optional {
+				replacement = "?."
+			} else if !computed {
+				replacement = "."
+			}
+			ctx.ReportNodeWithFixes(node, msg, rule.RuleFixReplaceRange(core.NewTextRange(objectEnd, gapEnd), replacement))
+		}
+
+		return rule.RuleListeners{
+			ast.KindPropertyAccessExpression: func(node *ast.Node) {
+				access := node.AsPropertyAccessExpression()
+				check(node, access.Expression, access.Name(), false, access.QuestionDotToken != nil)
+			},
+			ast.KindElementAccessExpression: func(node *ast.Node) {
+				access := node.AsElementAccessExpression()
+				check(node, access.Expression, access.ArgumentExpression, true, access.QuestionDotToken != nil)
+			},
+		}
+	},
+})
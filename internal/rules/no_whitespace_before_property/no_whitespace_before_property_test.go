@@ -0,0 +1,153 @@
+package no_whitespace_before_property
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestNoWhitespaceBeforePropertyRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&NoWhitespaceBeforePropertyRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `foo.bar`},
+			{Code: `foo.bar()`},
+			{Code: `foo[bar]`},
+			{Code: `foo['bar']`},
+			{Code: `foo[0]`},
+			{Code: `foo[ bar ]`},
+			{Code: `foo?.bar`},
+			{Code: `foo?.[bar]`},
+			{Code: `foo.bar.baz`},
+			{Code: `foo.bar().baz()`},
+			{Code: `foo!.bar`},
+			{Code: `foo/* comment */.bar`},
+			{Code: `foo
+  .bar`},
+			{Code: `foo.
+  bar`},
+			{Code: `foo
+  [bar]`},
+			{Code: `foo
+  ?.bar`},
+			{Code: `(foo + bar).baz`},
+			{Code: `5..toString()`},
+			{Code: `5.0.toString()`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:   `foo .bar`,
+				Output: []string{`foo.bar`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `foo. bar`,
+				Output: []string{`foo.bar`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `foo . bar`,
+				Output: []string{`foo.bar`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `foo [bar]`,
+				Output: []string{`foo[bar]`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `foo [0]`,
+				Output: []string{`foo[0]`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `foo	.bar()`,
+				Output: []string{`foo.bar()`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `foo ?.bar`,
+				Output: []string{`foo?.bar`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `foo ?. [bar]`,
+				Output: []string{`foo?.[bar]`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `foo.bar .baz`,
+				Output: []string{`foo.bar.baz`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `foo .bar .baz`,
+				Output: []string{`foo.bar.baz`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `foo
+  .bar .baz`,
+				Output: []string{`foo
+  .bar.baz`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `(foo + bar) .baz`,
+				Output: []string{`(foo + bar).baz`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+				},
+			},
+
+			// Not fixed
+			{
+				Code: `5 .toString()`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `foo /* comment */ .bar`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `foo. /* comment */ bar`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "unexpectedWhitespace", Line: 1, Column: 1},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,196 @@
+package operator_assignment
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builders
+func buildReplacedMessage(operator string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "replaced",
+		Description: "Assignment (=) can be replaced with operator assignment (" + operator + ").",
+	}
+}
+
+func buildUnexpectedMessage(operator string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "unexpected",
+		Description: "Unexpected operator assignment (" + operator + ") shorthand.",
+	}
+}
+
+// Binary operators that have an operator assignment shorthand
+var commutativeOperators = map[ast.Kind]string{
+	ast.KindAsteriskToken:  "*",
+	ast.KindAmpersandToken: "&",
+	ast.KindCaretToken:     "^",
+	ast.KindBarToken:       "|",
+}
+
+var nonCommutativeOperators = map[ast.Kind]string{
+	ast.KindPlusToken:                              "+",
+	ast.KindMinusToken:                             "-",
+	ast.KindSlashToken:                             "/",
+	ast.KindPercentToken:                           "%",
+	ast.KindLessThanLessThanToken:                  "<<",
+	ast.KindGreaterThanGreaterThanToken:            ">>",
+	ast.KindGreaterThanGreaterThanGreaterThanToken: ">>>",
+	ast.KindAsteriskAsteriskToken:                  "**",
+}
+
+// Operator assignments mapped to their binary operator
+var compoundAssignmentOperators = map[ast.Kind]ast.Kind{
+	ast.KindPlusEqualsToken:                              ast.KindPlusToken,
+	ast.KindMinusEqualsToken:                             ast.KindMinusToken,
+	ast.KindAsteriskEqualsToken:                          ast.KindAsteriskToken,
+	ast.KindSlashEqualsToken:                             ast.KindSlashToken,
+	ast.KindPercentEqualsToken:                           ast.KindPercentToken,
+	ast.KindAsteriskAsteriskEqualsToken:                  ast.KindAsteriskAsteriskToken,
+	ast.KindLessThanLessThanEqualsToken:                  ast.KindLessThanLessThanToken,
+	ast.KindGreaterThanGreaterThanEqualsToken:            ast.KindGreaterThanGreaterThanToken,
+	ast.KindGreaterThanGreaterThanGreaterThanEqualsToken: ast.KindGreaterThanGreaterThanGreaterThanToken,
+	ast.KindAmpersandEqualsToken:                         ast.KindAmpersandToken,
+	ast.KindBarEqualsToken:                               ast.KindBarToken,
+	ast.KindCaretEqualsToken:                             ast.KindCaretToken,
+}
+
+func getOperatorText(kind ast.Kind) string {
+	if text, ok := commutativeOperators[kind]; ok {
+		return text
+	}
+	return nonCommutativeOperators[kind]
+}
+
+// canBeFixed checks whether evaluating node twice or once is indistinguishable,
+// i.e. it is a variable or a static property of a variable or `this`
+func canBeFixed(node *ast.Node) bool {
+	switch node.Kind {
+	case ast.KindIdentifier:
+		return true
+	case ast.KindPropertyAccessExpression, ast.KindElementAccessExpression:
+		object := node.Expression()
+		if object.Kind != ast.KindIdentifier && object.Kind != ast.KindThisKeyword {
+			return false
+		}
+		_, ok := utils.GetStaticMemberKey(node)
+		return ok
+	}
+	return false
+}
+
+// OperatorAssignmentRule requires or disallows assignment operator shorthand where possible
+var OperatorAssignmentRule = rule.CreateRule(rule.Rule{
+	Name: "operator-assignment",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		mode := "always"
+		switch v := options.(type) {
+		case string:
+			mode = v
+		case []interface{}:
+			if len(v) > 0 {
+				if s, ok := v[0].(string); ok {
+					mode = s
+				}
+			}
+		}
+
+		text := ctx.SourceFile.Text()
+
+		// verify reports `x = x + y` which can use `x += y`
+		verify := func(node *ast.Node) {
+			assignment := node.AsBinaryExpression()
+			if assignment.OperatorToken.Kind != ast.KindEqualsToken {
+				return
+			}
+			right := ast.SkipParentheses(assignment.Right)
+			if right.Kind != ast.KindBinaryExpression {
+				return
+			}
+			expr := right.AsBinaryExpression()
+			operator := getOperatorText(expr.OperatorToken.Kind)
+			if operator == "" {
+				return
+			}
+			replacementOperator := operator + "="
+
+			if utils.IsSameReference(assignment.Left, expr.Left) {
+				msg := buildReplacedMessage(replacementOperator)
+				if !canBeFixed(ast.SkipParentheses(assignment.Left)) || !canBeFixed(ast.SkipParentheses(expr.Left)) {
+					ctx.ReportNode(node, msg)
+					return
+				}
+
+				nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+				equalsRange := utils.TrimNodeTextRange(ctx.SourceFile, assignment.OperatorToken)
+				operatorRange := utils.TrimNodeTextRange(ctx.SourceFile, expr.OperatorToken)
+
+				// Check for comments that would be removed
+				if utils.HasCommentsInRange(ctx.SourceFile, core.NewTextRange(equalsRange.Pos(), operatorRange.End())) {
+					ctx.ReportNode(node, msg)
+					return
+				}
+
+				leftText := text[nodeRange.Pos():equalsRange.Pos()]
+				rightText := text[operatorRange.End():right.End()]
+				ctx.ReportNodeWithFixes(node, msg, rule.RuleFixReplace(ctx.SourceFile, node, leftText+replacementOperator+rightText))
+			} else if _, ok := commutativeOperators[expr.OperatorToken.Kind]; ok && utils.IsSameReference(assignment.Left, expr.Right) {
+				// This case can't be fixed safely. If `a` and `b` both have custom valueOf() behavior, then
+				// fixing `a = b * a` to `a *= b` would change the execution order of the valueOf() functions.
+				ctx.ReportNode(node, buildReplacedMessage(replacementOperator))
+			}
+		}
+
+		// prohibit reports `x += y` which should be `x = x + y`
+		prohibit := func(node *ast.Node) {
+			assignment := node.AsBinaryExpression()
+			operatorKind, ok := compoundAssignmentOperators[assignment.OperatorToken.Kind]
+			if !ok {
+				return
+			}
+
+			nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			operatorRange := utils.TrimNodeTextRange(ctx.SourceFile, assignment.OperatorToken)
+			msg := buildUnexpectedMessage(text[operatorRange.Pos():operatorRange.End()])
+
+			// Check for comments that would be duplicated
+			if !canBeFixed(assignment.Left) || utils.HasCommentsInRange(ctx.SourceFile, core.NewTextRange(nodeRange.Pos(), operatorRange.Pos())) {
+				ctx.ReportNode(node, msg)
+				return
+			}
+
+			leftText := text[nodeRange.Pos():operatorRange.Pos()]
+			newOperator := getOperatorText(operatorKind)
+			rightRange := utils.TrimNodeTextRange(ctx.SourceFile, assignment.Right)
+
+			var rightText string
+			if ast.GetExpressionPrecedence(assignment.Right) <= ast.GetBinaryOperatorPrecedence(operatorKind) {
+				// If this change would modify precedence (e.g. `foo *= bar + 1` => `foo = foo * (bar + 1)`), parenthesize the right side
+				rightText = text[operatorRange.End():rightRange.Pos()] + "(" + text[rightRange.Pos():rightRange.End()] + ")"
+			} else {
+				rightText = text[operatorRange.End():nodeRange.End()]
+				// foo+=+bar -> foo= foo+ +bar
+				if len(rightText) > 0 {
+					next := rightText[0]
+					last := newOperator[len(newOperator)-1]
+					if (next == last && (last == '+' || last == '-')) || (last == '/' && (next == '/' || next == '*')) {
+						rightText = " " + rightText
+					}
+				}
+			}
+
+			ctx.ReportNodeWithFixes(node, msg, rule.RuleFixReplace(ctx.SourceFile, node, leftText+"= "+leftText+newOperator+rightText))
+		}
+
+		listener := verify
+		if mode == "never" {
+			listener = prohibit
+		}
+
+		return rule.RuleListeners{
+			ast.KindBinaryExpression: listener,
+		}
+	},
+})
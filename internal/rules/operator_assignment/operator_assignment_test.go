@@ -0,0 +1,253 @@
+package operator_assignment
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestOperatorAssignmentRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&OperatorAssignmentRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `x = y`},
+			{Code: `x = y + x`},
+			{Code: `x += x + y`},
+			{Code: `x = (x + y) - z`},
+			{Code: `x -= y`},
+			{Code: `x = y - x`},
+			{Code: `x *= x`},
+			{Code: `x = y * z`},
+			{Code: `x = (x * y) * z`},
+			{Code: `x = y / x`},
+			{Code: `x /= y`},
+			{Code: `x %= y`},
+			{Code: `x <<= y`},
+			{Code: `x >>= x >> y`},
+			{Code: `x >>>= y`},
+			{Code: `x &= y`},
+			{Code: `x **= y`},
+			{Code: `x ^= y ^ z`},
+			{Code: `x |= x | y`},
+			{Code: `x = x && y`},
+			{Code: `x = x || y`},
+			{Code: `x = x ?? y`},
+			{Code: `x = x < y`},
+			{Code: `x = x > y`},
+			{Code: `x = x <= y`},
+			{Code: `x = x >= y`},
+			{Code: `x = x instanceof y`},
+			{Code: `x = x in y`},
+			{Code: `x = x == y`},
+			{Code: `x = x != y`},
+			{Code: `x = x === y`},
+			{Code: `x = x !== y`},
+			{Code: `x[y] = x['y'] + z`},
+			{Code: `x.y = x['z'] / a.b`},
+			{Code: `x.y = z + x.y`},
+			{Code: `x[fn()] = x[fn()] + y`},
+			{Code: `x += x + y`, Options: "always"},
+			{Code: `x = x + y`, Options: "never"},
+			{Code: `x = x ** y`, Options: "never"},
+			{Code: `x = y ** x`, Options: "always"},
+			{Code: `x = x * y + z`, Options: "always"},
+			{Code: `this.x = this.y + z`, Options: "always"},
+			{Code: `this.x = foo.x + y`, Options: "always"},
+			{Code: `this.x = foo.this.x + y`, Options: "always"},
+			{Code: `foo.x &&= y`, Options: "never"},
+			{Code: `foo.x ||= y`, Options: "never"},
+			{Code: `foo.x ??= y`, Options: "never"},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:   `x = x + y`,
+				Output: []string{`x += y`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `x = x - y`,
+				Output: []string{`x -= y`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x = x * y`,
+				Output: []string{`x *= y`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x = y * x`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x = (y * z) * x`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x = x / y`,
+				Output: []string{`x /= y`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x = x % y`,
+				Output: []string{`x %= y`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x = x << y`,
+				Output: []string{`x <<= y`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x = x >> y`,
+				Output: []string{`x >>= y`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x = x >>> y`,
+				Output: []string{`x >>>= y`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x = x & y`,
+				Output: []string{`x &= y`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x = x ^ y`,
+				Output: []string{`x ^= y`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x = x | y`,
+				Output: []string{`x |= y`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x = x ** y`,
+				Output: []string{`x **= y`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x[0] = x[0] - y`,
+				Output: []string{`x[0] -= y`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x.y[z['a']][0].b = x.y[z['a']][0].b * 2`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:    `x = x + y`,
+				Options: "always",
+				Output:  []string{`x += y`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x = (x + y)`,
+				Output: []string{`x += y`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `x = x + (y)`,
+				Output: []string{`x += (y)`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:    `x += (y)`,
+				Options: "never",
+				Output:  []string{`x = x + (y)`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpected"}},
+			},
+			{
+				Code:    `x += y`,
+				Options: "never",
+				Output:  []string{`x = x + y`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpected", Line: 1, Column: 1}},
+			},
+			{
+				Code:    `x.y += 5`,
+				Options: "never",
+				Output:  []string{`x.y = x.y + 5`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpected"}},
+			},
+			{
+				Code:    `x[y] -= 5`,
+				Options: "never",
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpected"}},
+			},
+			{
+				Code:    `x['y'] -= 5`,
+				Options: "never",
+				Output:  []string{`x['y'] = x['y'] - 5`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpected"}},
+			},
+			{
+				Code:    `this.x *= 2`,
+				Options: "never",
+				Output:  []string{`this.x = this.x * 2`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpected"}},
+			},
+			{
+				Code:    `x *= y + 1`,
+				Options: "never",
+				Output:  []string{`x = x * (y + 1)`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpected"}},
+			},
+			{
+				Code:    `x **= y ** 2`,
+				Options: "never",
+				Output:  []string{`x = x ** (y ** 2)`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpected"}},
+			},
+			{
+				Code:    `x+=+y`,
+				Options: "never",
+				Output:  []string{`x= x+ +y`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpected"}},
+			},
+			{
+				Code:    `x-=-y`,
+				Options: "never",
+				Output:  []string{`x= x- -y`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpected"}},
+			},
+
+			// Comments would be removed or duplicated
+			{
+				Code:   `x = /* comment */ x + y`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:    `x /* comment */ += y`,
+				Options: "never",
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpected"}},
+			},
+
+			// Left-hand sides with calls or getters on nested objects are reported without a fix
+			{
+				Code:   `foo.bar().baz = foo.bar().baz + 1`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:   `a.b.c = a.b.c + 1`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "replaced"}},
+			},
+			{
+				Code:    `foo.bar().baz += 1`,
+				Options: "never",
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpected"}},
+			},
+			{
+				Code:    `a.b.c += 1`,
+				Options: "never",
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "unexpected"}},
+			},
+		},
+	)
+}
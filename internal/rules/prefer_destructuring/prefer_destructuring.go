@@ -0,0 +1,213 @@
+package prefer_destructuring
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// DestructuringTypes selects which kinds of destructuring are enforced
+type DestructuringTypes struct {
+	Array  bool `json:"array"`
+	Object bool `json:"object"`
+}
+
+// Options for prefer-destructuring rule
+type Options struct {
+	VariableDeclarator          DestructuringTypes `json:"VariableDeclarator"`
+	AssignmentExpression        DestructuringTypes `json:"AssignmentExpression"`
+	EnforceForRenamedProperties bool               `json:"enforceForRenamedProperties"`
+}
+
+func parseDestructuringTypes(optsMap map[string]interface{}) DestructuringTypes {
+	types := DestructuringTypes{}
+	if v, ok := optsMap["array"].(bool); ok {
+		types.Array = v
+	}
+	if v, ok := optsMap["object"].(bool); ok {
+		types.Object = v
+	}
+	return types
+}
+
+func parseOptions(options any) Options {
+	opts := Options{
+		VariableDeclarator:   DestructuringTypes{Array: true, Object: true},
+		AssignmentExpression: DestructuringTypes{Array: true, Object: true},
+	}
+
+	var enabledTypes, extra map[string]interface{}
+	switch v := options.(type) {
+	case []interface{}:
+		if len(v) > 0 {
+			enabledTypes, _ = v[0].(map[string]interface{})
+		}
+		if len(v) > 1 {
+			extra, _ = v[1].(map[string]interface{})
+		}
+	case map[string]interface{}:
+		enabledTypes = v
+	}
+
+	if enabledTypes != nil {
+		_, hasArray := enabledTypes["array"]
+		_, hasObject := enabledTypes["object"]
+		if hasArray || hasObject {
+			// The same settings apply to declarations and assignments
+			opts.VariableDeclarator = parseDestructuringTypes(enabledTypes)
+			opts.AssignmentExpression = opts.VariableDeclarator
+		} else {
+			declarator, _ := enabledTypes["VariableDeclarator"].(map[string]interface{})
+			assignment, _ := enabledTypes["AssignmentExpression"].(map[string]interface{})
+			opts.VariableDeclarator = parseDestructuringTypes(declarator)
+			opts.AssignmentExpression = parseDestructuringTypes(assignment)
+		}
+	}
+
+	if v, ok := extra["enforceForRenamedProperties"].(bool); ok {
+		opts.EnforceForRenamedProperties = v
+	}
+	return opts
+}
+
+func buildPreferDestructuringMessage(destructuringType string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "preferDestructuring",
+		Description: "Use " + destructuringType + " destructuring.",
+	}
+}
+
+// isArrayIndexAccess checks for `arr[0]`-like accesses with an integer index
+func isArrayIndexAccess(node *ast.Node) bool {
+	if node.Kind != ast.KindElementAccessExpression {
+		return false
+	}
+	argument := node.AsElementAccessExpression().ArgumentExpression
+	if argument.Kind != ast.KindNumericLiteral {
+		return false
+	}
+	value, err := strconv.ParseFloat(argument.Text(), 64)
+	return err == nil && value == math.Trunc(value) && !math.IsInf(value, 0)
+}
+
+// PreferDestructuringRule requires destructuring from arrays and objects
+var PreferDestructuringRule = rule.CreateRule(rule.Rule{
+	Name: "prefer-destructuring",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+
+		// getFixes turns `const foo = object.foo` into `const {foo} = object`
+		getFixes := func(node *ast.Node, right *ast.Node) []rule.RuleFix {
+			if node.Kind != ast.KindVariableDeclaration {
+				return nil
+			}
+			declaration := node.AsVariableDeclaration()
+			// Rewriting would drop the type annotation or definite assignment assertion
+			if declaration.Type != nil || declaration.ExclamationToken != nil {
+				return nil
+			}
+			if declaration.Name().Kind != ast.KindIdentifier || right.Kind != ast.KindPropertyAccessExpression {
+				return nil
+			}
+			access := right.AsPropertyAccessExpression()
+			if access.Name().Kind != ast.KindIdentifier || declaration.Name().Text() != access.Name().Text() {
+				return nil
+			}
+
+			// Only comments inside the object can be preserved
+			nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			objectRange := utils.TrimNodeTextRange(ctx.SourceFile, access.Expression)
+			if utils.HasCommentsInRange(ctx.SourceFile, core.NewTextRange(nodeRange.Pos(), objectRange.Pos())) ||
+				utils.HasCommentsInRange(ctx.SourceFile, core.NewTextRange(objectRange.End(), nodeRange.End())) {
+				return nil
+			}
+
+			objectText := ctx.SourceFile.Text()[objectRange.Pos():objectRange.End()]
+			if ast.GetExpressionPrecedence(access.Expression) < ast.OperatorPrecedenceAssignment {
+				objectText = "(" + objectText + ")"
+			}
+			return []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, node, "{"+access.Name().Text()+"} = "+objectText)}
+		}
+
+		report := func(node *ast.Node, destructuringType string, fixes []rule.RuleFix) {
+			message := buildPreferDestructuringMessage(destructuringType)
+			if len(fixes) > 0 {
+				ctx.ReportNodeWithFixes(node, message, fixes...)
+			} else {
+				ctx.ReportNode(node, message)
+			}
+		}
+
+		performCheck := func(left *ast.Node, right *ast.Node, node *ast.Node, types DestructuringTypes) {
+			right = ast.SkipParentheses(right)
+			if !ast.IsAccessExpression(right) || ast.IsOptionalChain(right) {
+				return
+			}
+			object := right.Expression()
+			if object.Kind == ast.KindSuperKeyword {
+				return
+			}
+			if right.Kind == ast.KindPropertyAccessExpression && right.AsPropertyAccessExpression().Name().Kind == ast.KindPrivateIdentifier {
+				return
+			}
+
+			if isArrayIndexAccess(right) {
+				if types.Array {
+					report(node, "array", nil)
+				}
+				return
+			}
+
+			if !types.Object {
+				return
+			}
+			if opts.EnforceForRenamedProperties {
+				report(node, "object", getFixes(node, right))
+				return
+			}
+
+			if left.Kind != ast.KindIdentifier {
+				return
+			}
+			var propertyName string
+			switch right.Kind {
+			case ast.KindPropertyAccessExpression:
+				propertyName = right.AsPropertyAccessExpression().Name().Text()
+			case ast.KindElementAccessExpression:
+				argument := right.AsElementAccessExpression().ArgumentExpression
+				if argument.Kind != ast.KindStringLiteral {
+					return
+				}
+				propertyName = argument.Text()
+			}
+			if left.Text() == propertyName {
+				report(node, "object", getFixes(node, right))
+			}
+		}
+
+		return rule.RuleListeners{
+			ast.KindVariableDeclaration: func(node *ast.Node) {
+				declaration := node.AsVariableDeclaration()
+				if declaration.Initializer == nil {
+					return
+				}
+				// `using` declarations cannot be destructured
+				if ast.GetCombinedNodeFlags(node)&ast.NodeFlagsUsing != 0 {
+					return
+				}
+				performCheck(declaration.Name(), declaration.Initializer, node, opts.VariableDeclarator)
+			},
+			ast.KindBinaryExpression: func(node *ast.Node) {
+				expr := node.AsBinaryExpression()
+				if expr.OperatorToken.Kind != ast.KindEqualsToken {
+					return
+				}
+				performCheck(ast.SkipParentheses(expr.Left), expr.Right, node, opts.AssignmentExpression)
+			},
+		}
+	},
+})
@@ -0,0 +1,143 @@
+package prefer_destructuring
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestPreferDestructuringRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&PreferDestructuringRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `var [foo] = array;`},
+			{Code: `var { foo } = object;`},
+			{Code: `var foo;`},
+			{Code: `var foo = object.bar;`},
+			{Code: `var foo = object['bar'];`},
+			{Code: `var foo = array[someIndex];`},
+			{Code: `var foo = object.foo;`, Options: []interface{}{map[string]interface{}{"object": false}}},
+			{Code: `var foo = object.foo;`, Options: []interface{}{map[string]interface{}{"VariableDeclarator": map[string]interface{}{"object": false}}}},
+			{Code: `var foo = array[0];`, Options: []interface{}{map[string]interface{}{"array": false}}},
+			{Code: `var foo = array[0];`, Options: []interface{}{map[string]interface{}{"VariableDeclarator": map[string]interface{}{"array": false}}}},
+			{Code: `var foo = object.bar;`, Options: []interface{}{map[string]interface{}{"object": true}, map[string]interface{}{"enforceForRenamedProperties": false}}},
+			{Code: `var foo = object[bar];`, Options: []interface{}{map[string]interface{}{"object": true}}},
+			{Code: `var foo = array[0];`, Options: []interface{}{map[string]interface{}{"object": true}}},
+			{Code: `var foo = object.foo;`, Options: []interface{}{map[string]interface{}{"AssignmentExpression": map[string]interface{}{"object": true}}}},
+			{Code: `[foo] = array;`},
+			{Code: `({ foo } = object);`},
+			{Code: `foo = object.foo;`, Options: []interface{}{map[string]interface{}{"AssignmentExpression": map[string]interface{}{"object": false}}}},
+			{Code: `foo = array[0];`, Options: []interface{}{map[string]interface{}{"AssignmentExpression": map[string]interface{}{"array": false}}}},
+			{Code: `foo += array[0];`},
+			{Code: `foo ??= object.foo;`},
+			{Code: `foo = object.bar;`},
+			{Code: `var foo = object?.foo;`},
+			{Code: `class Foo extends Bar { static foo() { var foo = super.foo; } }`},
+			{Code: `class Foo extends Bar { foo() { foo = super.foo; } }`},
+			{Code: `class C { #x: any; foo() { const x = this.#x; } }`},
+			{Code: `using foo = object.foo;`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:   `var foo = array[0];`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 5}},
+			},
+			{
+				Code:   `foo = array[0];`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `var foo = object.foo;`,
+				Output: []string{`var {foo} = object;`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 5}},
+			},
+			{
+				Code:   `var foo = (a, b).foo;`,
+				Output: []string{`var {foo} = (a, b);`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 5}},
+			},
+			{
+				Code:   `var foo = object.foo.foo;`,
+				Output: []string{`var {foo} = object.foo;`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 5}},
+			},
+			{
+				Code:   `var foo = object['foo'];`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 5}},
+			},
+			{
+				Code:   `foo = object.foo;`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `foo = object['foo'];`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 1}},
+			},
+			{
+				Code:    `var foo = object.foo;`,
+				Output:  []string{`var {foo} = object;`},
+				Options: []interface{}{map[string]interface{}{"VariableDeclarator": map[string]interface{}{"object": true}}},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 5}},
+			},
+			{
+				Code:    `var foo = array[0];`,
+				Options: []interface{}{map[string]interface{}{"VariableDeclarator": map[string]interface{}{"array": true}}},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 5}},
+			},
+			{
+				Code:    `foo = array[0];`,
+				Options: []interface{}{map[string]interface{}{"AssignmentExpression": map[string]interface{}{"array": true}}},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 1}},
+			},
+			{
+				Code:    `var foo = object.bar;`,
+				Options: []interface{}{map[string]interface{}{"object": true}, map[string]interface{}{"enforceForRenamedProperties": true}},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 5}},
+			},
+			{
+				Code:    `var foo = object[bar];`,
+				Options: []interface{}{map[string]interface{}{"object": true}, map[string]interface{}{"enforceForRenamedProperties": true}},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 5}},
+			},
+			{
+				Code:    `var foo = array[0];`,
+				Options: []interface{}{map[string]interface{}{"array": true}, map[string]interface{}{"enforceForRenamedProperties": true}},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 5}},
+			},
+			{
+				Code:   `class Foo extends Bar { static foo() { var bar = super.foo.bar; } }`,
+				Output: []string{`class Foo extends Bar { static foo() { var {bar} = super.foo; } }`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 44}},
+			},
+			{
+				Code:   `var /* comment */ foo = object.foo;`,
+				Output: []string{`var /* comment */ {foo} = object;`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 19}},
+			},
+
+			// Fixes that would lose comments or type annotations are not offered
+			{
+				Code:   `var foo /* comment */ = object.foo;`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 5}},
+			},
+			{
+				Code:   `var foo = object./* comment */foo;`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 5}},
+			},
+			{
+				Code:   `var foo = /* comment */ object.foo;`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 5}},
+			},
+			{
+				Code:   `var foo: number = object.foo;`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "preferDestructuring", Line: 1, Column: 5}},
+			},
+		},
+	)
+}
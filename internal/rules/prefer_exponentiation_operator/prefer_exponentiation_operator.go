@@ -0,0 +1,167 @@
+package prefer_exponentiation_operator
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builder
+func buildUseExponentiationMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "useExponentiation",
+		Description: "Use the '**' operator instead of 'Math.pow'.",
+	}
+}
+
+// isMathPowCall checks for `Math.pow(...)` and `Math['pow'](...)`
+func isMathPowCall(node *ast.Node) bool {
+	callee := ast.SkipParentheses(node.AsCallExpression().Expression)
+	var object *ast.Node
+	switch callee.Kind {
+	case ast.KindPropertyAccessExpression:
+		access := callee.AsPropertyAccessExpression()
+		if access.Name().Text() != "pow" {
+			return false
+		}
+		object = access.Expression
+	case ast.KindElementAccessExpression:
+		access := callee.AsElementAccessExpression()
+		argument := ast.SkipParentheses(access.ArgumentExpression)
+		if (argument.Kind != ast.KindStringLiteral && argument.Kind != ast.KindNoSubstitutionTemplateLiteral) || argument.Text() != "pow" {
+			return false
+		}
+		object = access.Expression
+	default:
+		return false
+	}
+	object = ast.SkipParentheses(object)
+	return object.Kind == ast.KindIdentifier && object.Text() == "Math"
+}
+
+// doesBaseNeedParens checks whether the base of `a ** b` must be parenthesized
+func doesBaseNeedParens(base *ast.Node) bool {
+	// '**' is right-associative, parens are needed when Math.pow(a ** b, c) is converted to (a ** b) ** c
+	if ast.GetExpressionPrecedence(base) <= ast.OperatorPrecedenceExponentiation {
+		return true
+	}
+
+	// An unary operator cannot be used immediately before an exponentiation expression
+	switch base.Kind {
+	case ast.KindPrefixUnaryExpression:
+		operator := base.AsPrefixUnaryExpression().Operator
+		return operator != ast.KindPlusPlusToken && operator != ast.KindMinusMinusToken
+	case ast.KindTypeOfExpression, ast.KindVoidExpression, ast.KindDeleteExpression, ast.KindAwaitExpression, ast.KindTypeAssertionExpression:
+		return true
+	}
+	return false
+}
+
+// doesExponentNeedParens checks whether the exponent of `a ** b` must be parenthesized
+func doesExponentNeedParens(exponent *ast.Node) bool {
+	// '**' is right-associative, there is no need for parens when Math.pow(a, b ** c) is converted to a ** b ** c
+	return ast.GetExpressionPrecedence(exponent) < ast.OperatorPrecedenceExponentiation
+}
+
+// doesExponentiationExpressionNeedParens checks whether `a ** b` must be parenthesized in place of node
+func doesExponentiationExpressionNeedParens(node *ast.Node) bool {
+	parent := node.Parent
+	switch parent.Kind {
+	case ast.KindBinaryExpression:
+		binary := parent.AsBinaryExpression()
+		return binary.OperatorToken.Kind == ast.KindAsteriskAsteriskToken && binary.Left == node
+	case ast.KindPrefixUnaryExpression, ast.KindTypeOfExpression, ast.KindVoidExpression, ast.KindDeleteExpression,
+		ast.KindAwaitExpression, ast.KindTypeAssertionExpression, ast.KindNonNullExpression, ast.KindExpressionWithTypeArguments:
+		return true
+	case ast.KindPropertyAccessExpression, ast.KindElementAccessExpression, ast.KindCallExpression, ast.KindNewExpression:
+		// Arguments and computed keys are fine, callees and objects are not
+		return parent.Expression() == node
+	case ast.KindTaggedTemplateExpression:
+		return parent.AsTaggedTemplateExpression().Tag == node
+	}
+	return false
+}
+
+// canTokensBeAdjacent checks whether two characters at a token boundary can be written without whitespace
+func canTokensBeAdjacent(left byte, right byte) bool {
+	if isIdentifierPartChar(left) && isIdentifierPartChar(right) {
+		return false
+	}
+	if (left == '+' || left == '-') && left == right {
+		return false
+	}
+	if left == '/' && (right == '/' || right == '*') {
+		return false
+	}
+	return true
+}
+
+func isIdentifierPartChar(ch byte) bool {
+	return ch == '_' || ch == '$' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9')
+}
+
+// PreferExponentiationOperatorRule prefers the `**` operator over `Math.pow`
+var PreferExponentiationOperatorRule = rule.CreateRule(rule.Rule{
+	Name: "prefer-exponentiation-operator",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		text := ctx.SourceFile.Text()
+
+		isGlobalMath := func(node *ast.Node) bool {
+			if ctx.TypeChecker == nil {
+				return true
+			}
+			object := ast.SkipParentheses(ast.SkipParentheses(node.AsCallExpression().Expression).Expression())
+			symbol := ctx.TypeChecker.GetSymbolAtLocation(object)
+			return symbol == nil || utils.IsSymbolFromDefaultLibrary(ctx.Program, symbol)
+		}
+
+		getNodeText := func(node *ast.Node) string {
+			nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			return text[nodeRange.Pos():nodeRange.End()]
+		}
+
+		return rule.RuleListeners{
+			ast.KindCallExpression: func(node *ast.Node) {
+				if !isMathPowCall(node) || !isGlobalMath(node) {
+					return
+				}
+
+				args := node.Arguments()
+				if len(args) != 2 || utils.Some(args, func(arg *ast.Node) bool { return arg.Kind == ast.KindSpreadElement }) || utils.HasCommentsInRange(ctx.SourceFile, utils.TrimNodeTextRange(ctx.SourceFile, node)) {
+					ctx.ReportNode(node, buildUseExponentiationMessage())
+					return
+				}
+
+				base, exponent := args[0], args[1]
+				baseText := getNodeText(base)
+				exponentText := getNodeText(exponent)
+				shouldParenthesizeBase := doesBaseNeedParens(base)
+				shouldParenthesizeExponent := doesExponentNeedParens(exponent)
+				shouldParenthesizeAll := doesExponentiationExpressionNeedParens(node)
+
+				if shouldParenthesizeBase {
+					baseText = "(" + baseText + ")"
+				}
+				if shouldParenthesizeExponent {
+					exponentText = "(" + exponentText + ")"
+				}
+				replacement := baseText + " ** " + exponentText
+				if shouldParenthesizeAll {
+					replacement = "(" + replacement + ")"
+				} else {
+					nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+					// a+Math.pow(++b, c) -> a+ ++b ** c
+					if nodeRange.Pos() > 0 && !canTokensBeAdjacent(text[nodeRange.Pos()-1], replacement[0]) {
+						replacement = " " + replacement
+					}
+					// Math.pow(a, b)in c -> a ** b in c
+					if nodeRange.End() < len(text) && !canTokensBeAdjacent(replacement[len(replacement)-1], text[nodeRange.End()]) {
+						replacement += " "
+					}
+				}
+
+				ctx.ReportNodeWithFixes(node, buildUseExponentiationMessage(), rule.RuleFixReplace(ctx.SourceFile, node, replacement))
+			},
+		}
+	},
+})
@@ -0,0 +1,234 @@
+package prefer_exponentiation_operator
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestPreferExponentiationOperatorRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&PreferExponentiationOperatorRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `Object.pow(a, b)`},
+			{Code: `Math.max(a, b)`},
+			{Code: `Math`},
+			{Code: `Math(a, b)`},
+			{Code: `pow(a, b)`},
+			{Code: `Math.pow`},
+			{Code: `Math.Pow(a, b)`},
+			{Code: `math.pow(a, b)`},
+			{Code: `foo.Math.pow(a, b)`},
+			{Code: `new Math.pow(a, b)`},
+			{Code: `Math[pow](a, b)`},
+
+			// Shadowed Math
+			{Code: `function foo(Math: any) { Math.pow(a, b); }`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:   `Math.pow(a, b)`,
+				Output: []string{`a ** b`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `(Math).pow(a, b)`,
+				Output: []string{`a ** b`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `Math['pow'](a, b)`,
+				Output: []string{`a ** b`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `x = Math.pow(a, b);`,
+				Output: []string{`x = a ** b;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 5},
+				},
+			},
+			{
+				Code:   `Math.pow(2, 3) + Math.pow(4, 5)`,
+				Output: []string{`2 ** 3 + 4 ** 5`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+					{MessageId: "useExponentiation", Line: 1, Column: 18},
+				},
+			},
+
+			// Parenthesized operands
+			{
+				Code:   `Math.pow(a + 1, -2)`,
+				Output: []string{`(a + 1) ** -2`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `Math.pow(-a, b)`,
+				Output: []string{`(-a) ** b`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `Math.pow(-2, 3)`,
+				Output: []string{`(-2) ** 3`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `Math.pow(typeof a, b)`,
+				Output: []string{`(typeof a) ** b`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `Math.pow(++a, b)`,
+				Output: []string{`++a ** b`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `Math.pow(a, b + c)`,
+				Output: []string{`a ** (b + c)`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `Math.pow(a ? b : c, d)`,
+				Output: []string{`(a ? b : c) ** d`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+
+			// Right-associativity of '**'
+			{
+				Code:   `Math.pow(a ** b, c)`,
+				Output: []string{`(a ** b) ** c`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `Math.pow(a, b ** c)`,
+				Output: []string{`a ** b ** c`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `Math.pow(a, b) ** c`,
+				Output: []string{`(a ** b) ** c`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `a ** Math.pow(b, c)`,
+				Output: []string{`a ** b ** c`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 6},
+				},
+			},
+			{
+				Code:   `Math.pow(Math.pow(a, b), c)`,
+				Output: []string{`Math.pow(a, b) ** c`, `(a ** b) ** c`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+					{MessageId: "useExponentiation", Line: 1, Column: 10},
+				},
+			},
+
+			// Parenthesized result
+			{
+				Code:   `-Math.pow(a, b)`,
+				Output: []string{`-(a ** b)`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 2},
+				},
+			},
+			{
+				Code:   `Math.pow(a, b).toString()`,
+				Output: []string{`(a ** b).toString()`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `a * Math.pow(b, c)`,
+				Output: []string{`a * b ** c`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 5},
+				},
+			},
+			{
+				Code:   `foo(Math.pow(a, b))`,
+				Output: []string{`foo(a ** b)`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 5},
+				},
+			},
+
+			// Token adjacency
+			{
+				Code:   `a+Math.pow(++b, c)`,
+				Output: []string{`a+ ++b ** c`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 3},
+				},
+			},
+			{
+				Code:   `if (Math.pow(a, b)in c) {}`,
+				Output: []string{`if (a ** b in c) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 5},
+				},
+			},
+
+			// Not fixable
+			{
+				Code: `Math.pow(a)`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `Math.pow(a, b, c)`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `Math.pow(...args)`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `Math.pow(a, /* exponent */ b)`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useExponentiation", Line: 1, Column: 1},
+				},
+			},
+		},
+	)
+}
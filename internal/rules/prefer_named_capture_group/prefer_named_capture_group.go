@@ -0,0 +1,123 @@
+package prefer_named_capture_group
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+func buildRequiredMessage(group string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "required",
+		Description: "Capture group '" + group + "' should be converted to a named or non-capturing group.",
+	}
+}
+
+func buildAddGroupNameMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "addGroupName",
+		Description: "Add name to capture group.",
+	}
+}
+
+// edit replaces the pattern text between start and end
+type edit struct {
+	start int
+	end   int
+	text  string
+}
+
+// applyEdits applies edits, which must be sorted and not overlap, to pattern
+func applyEdits(pattern string, edits []edit) string {
+	result := ""
+	last := 0
+	for _, e := range edits {
+		result += pattern[last:e.start] + e.text
+		last = e.end
+	}
+	return result + pattern[last:]
+}
+
+// PreferNamedCaptureGroupRule enforces using named capture groups in regular expressions
+var PreferNamedCaptureGroupRule = rule.CreateRule(rule.Rule{
+	Name: "prefer-named-capture-group",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		return rule.RuleListeners{
+			ast.KindRegularExpressionLiteral: func(node *ast.Node) {
+				pattern, flags := utils.ExtractRegexLiteral(node)
+				root, err := utils.ParseRegExp(pattern, flags)
+				if err != nil {
+					return
+				}
+
+				var groups, backreferences []*utils.RegExpNode
+				names := map[string]bool{}
+				utils.ForEachRegExpNode(root, func(regExpNode *utils.RegExpNode) {
+					switch regExpNode.Kind {
+					case utils.RegExpNodeCapturingGroup:
+						groups = append(groups, regExpNode)
+						if regExpNode.Name != "" {
+							names[regExpNode.Name] = true
+						}
+					case utils.RegExpNodeBackreference:
+						backreferences = append(backreferences, regExpNode)
+					}
+				})
+
+				// The pattern starts after the opening slash
+				patternStart := utils.TrimNodeTextRange(ctx.SourceFile, node).Pos() + 1
+
+				// getGroupNameEdits names group and turns numeric backreferences to it into named ones
+				getGroupNameEdits := func(group *utils.RegExpNode) []edit {
+					name := "group" + strconv.Itoa(group.Index)
+					if names[name] {
+						return nil
+					}
+
+					edits := []edit{{group.Start + 1, group.Start + 1, "?<" + name + ">"}}
+					for _, bref := range backreferences {
+						if bref.Name == "" && bref.Resolved[0] == group {
+							edits = append(edits, edit{bref.Start, bref.End, `\k<` + name + ">"})
+						}
+					}
+
+					slices.SortFunc(edits, func(a, b edit) int {
+						return a.start - b.start
+					})
+
+					// Adding the first named group turns identity escapes like `\k` into syntax errors
+					if _, err := utils.ParseRegExp(applyEdits(pattern, edits), flags); err != nil {
+						return nil
+					}
+					return edits
+				}
+
+				for _, group := range groups {
+					if group.Name != "" {
+						continue
+					}
+
+					message := buildRequiredMessage(group.Raw)
+					edits := getGroupNameEdits(group)
+					if edits == nil {
+						ctx.ReportNode(node, message)
+						continue
+					}
+
+					fixes := make([]rule.RuleFix, len(edits))
+					for i, e := range edits {
+						fixes[i] = rule.RuleFixReplaceRange(core.NewTextRange(patternStart+e.start, patternStart+e.end), e.text)
+					}
+					ctx.ReportNodeWithSuggestions(node, message, rule.RuleSuggestion{
+						Message:  buildAddGroupNameMessage(),
+						FixesArr: fixes,
+					})
+				}
+			},
+		}
+	},
+})
@@ -0,0 +1,125 @@
+package prefer_named_capture_group
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestPreferNamedCaptureGroupRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&PreferNamedCaptureGroupRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `/normal_regex/`},
+			{Code: `/(?:[0-9]{4})/`},
+			{Code: `/(?<year>[0-9]{4})/`},
+			{Code: `/\u{1F680}/u`},
+			{Code: `/(?=a)(?!b)(?<=c)(?<!d)/`},
+			{Code: `/[(]/`},
+			{Code: `/\(/`},
+			{Code: `'(a)'`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `/([0-9]{4})/`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "required", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addGroupName", Output: `/(?<group1>[0-9]{4})/`},
+						},
+					},
+				},
+			},
+			{
+				Code: `const date = /([0-9]{4})-(\w{5})/;`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "required", Line: 1, Column: 14,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addGroupName", Output: `const date = /(?<group1>[0-9]{4})-(\w{5})/;`},
+						},
+					},
+					{
+						MessageId: "required", Line: 1, Column: 14,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addGroupName", Output: `const date = /([0-9]{4})-(?<group2>\w{5})/;`},
+						},
+					},
+				},
+			},
+			{
+				Code: `/(?<year>[0-9]{4})-(\w{5})/`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "required", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addGroupName", Output: `/(?<year>[0-9]{4})-(?<group2>\w{5})/`},
+						},
+					},
+				},
+			},
+
+			// Numeric backreferences to the group become named ones
+			{
+				Code: `/(a)(b)\1\2\1/`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "required", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addGroupName", Output: `/(?<group1>a)(b)\k<group1>\2\k<group1>/`},
+						},
+					},
+					{
+						MessageId: "required", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addGroupName", Output: `/(a)(?<group2>b)\1\k<group2>\1/`},
+						},
+					},
+				},
+			},
+			{
+				Code: `/(?:(a)|b)+\1/u`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "required", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addGroupName", Output: `/(?:(?<group1>a)|b)+\k<group1>/u`},
+						},
+					},
+				},
+			},
+
+			// No suggestion when the name is taken or the pattern would become invalid
+			{
+				Code: `/(?<group1>a)(b)/`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "required", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addGroupName", Output: `/(?<group1>a)(?<group2>b)/`},
+						},
+					},
+				},
+			},
+			{
+				Code: `/(?<group2>a)(b)/`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "required", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `/(a)\k/`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "required", Line: 1, Column: 1},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,143 @@
+package prefer_numeric_literals
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/scanner"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+type radixInfo struct {
+	system        string
+	literalPrefix string
+	digits        string
+}
+
+var radixMap = map[float64]radixInfo{
+	2:  {system: "binary", literalPrefix: "0b", digits: "01"},
+	8:  {system: "octal", literalPrefix: "0o", digits: "01234567"},
+	16: {system: "hexadecimal", literalPrefix: "0x", digits: "0123456789abcdefABCDEF"},
+}
+
+// Message builder
+func buildUseLiteralMessage(system string, functionName string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "useLiteral",
+		Description: "Use " + system + " literals instead of " + functionName + "().",
+	}
+}
+
+// getStaticString returns the value of a string literal or a template literal without substitutions
+func getStaticString(node *ast.Node) (string, bool) {
+	node = ast.SkipParentheses(node)
+	if node.Kind == ast.KindStringLiteral || node.Kind == ast.KindNoSubstitutionTemplateLiteral {
+		return node.Text(), true
+	}
+	return "", false
+}
+
+// isValidLiteralDigits checks that str can follow the literal prefix and keeps the value parseInt gives
+func isValidLiteralDigits(str string, digits string) bool {
+	if str == "" {
+		return false
+	}
+	for _, r := range str {
+		if !strings.ContainsRune(digits, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// PreferNumericLiteralsRule disallows parseInt() and Number.parseInt() in favor of binary, octal, and hexadecimal literals
+var PreferNumericLiteralsRule = rule.CreateRule(rule.Rule{
+	Name: "prefer-numeric-literals",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		isGlobal := func(identifier *ast.Node) bool {
+			if ctx.TypeChecker == nil {
+				return true
+			}
+			symbol := ctx.TypeChecker.GetSymbolAtLocation(identifier)
+			return symbol == nil || utils.IsSymbolFromDefaultLibrary(ctx.Program, symbol)
+		}
+
+		// isParseInt checks for the global `parseInt` and `Number.parseInt`
+		isParseInt := func(callee *ast.Node) bool {
+			callee = ast.SkipParentheses(callee)
+			switch callee.Kind {
+			case ast.KindIdentifier:
+				return callee.Text() == "parseInt" && isGlobal(callee)
+			case ast.KindPropertyAccessExpression, ast.KindElementAccessExpression:
+				object := ast.SkipParentheses(callee.Expression())
+				if object.Kind != ast.KindIdentifier || object.Text() != "Number" || !isGlobal(object) {
+					return false
+				}
+				if callee.Kind == ast.KindPropertyAccessExpression {
+					return callee.AsPropertyAccessExpression().Name().Text() == "parseInt"
+				}
+				name, ok := getStaticString(callee.AsElementAccessExpression().ArgumentExpression)
+				return ok && name == "parseInt"
+			}
+			return false
+		}
+
+		return rule.RuleListeners{
+			ast.KindCallExpression: func(node *ast.Node) {
+				args := node.Arguments()
+				if len(args) != 2 {
+					return
+				}
+				str, ok := getStaticString(args[0])
+				if !ok {
+					return
+				}
+				radixNode := ast.SkipParentheses(args[1])
+				if radixNode.Kind != ast.KindNumericLiteral {
+					return
+				}
+				radix, err := strconv.ParseFloat(radixNode.Text(), 64)
+				if err != nil {
+					return
+				}
+				info, ok := radixMap[radix]
+				if !ok || !isParseInt(node.Expression()) {
+					return
+				}
+
+				calleeRange := utils.TrimNodeTextRange(ctx.SourceFile, node.Expression())
+				functionName := ctx.SourceFile.Text()[calleeRange.Pos():calleeRange.End()]
+				msg := buildUseLiteralMessage(info.system, functionName)
+
+				// parseInt accepts the hexadecimal prefix itself, e.g. parseInt('0xFF', 16)
+				digits := str
+				if radix == 16 && (strings.HasPrefix(str, "0x") || strings.HasPrefix(str, "0X")) {
+					digits = str[2:]
+				}
+
+				nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+				if !isValidLiteralDigits(digits, info.digits) || utils.HasCommentsInRange(ctx.SourceFile, nodeRange) {
+					ctx.ReportNode(node, msg)
+					return
+				}
+
+				// Keep tokens apart, e.g. `typeof parseInt("1", 2)` written without spaces
+				replacement := info.literalPrefix + digits
+				if token := ctx.GetTokenBefore(node); token != nil && token.Range.End() == nodeRange.Pos() {
+					if last, _ := utf8.DecodeLastRuneInString(token.Text); scanner.IsIdentifierPart(last) {
+						replacement = " " + replacement
+					}
+				}
+				if token := ctx.GetTokenAfter(node); token != nil && token.Range.Pos() == nodeRange.End() {
+					if first, _ := utf8.DecodeRuneInString(token.Text); scanner.IsIdentifierPart(first) {
+						replacement += " "
+					}
+				}
+				ctx.ReportNodeWithFixes(node, msg, rule.RuleFixReplaceRange(nodeRange, replacement))
+			},
+		}
+	},
+})
@@ -0,0 +1,163 @@
+package prefer_numeric_literals
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestPreferNumericLiteralsRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&PreferNumericLiteralsRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `parseInt(1);`},
+			{Code: `parseInt(1, 3);`},
+			{Code: `Number.parseInt(1);`},
+			{Code: `Number.parseInt(1, 3);`},
+			{Code: `0b111110111 === 503;`},
+			{Code: `0o767 === 503;`},
+			{Code: `0x1F7 === 503;`},
+			{Code: `a[parseInt](1,2);`},
+			{Code: `parseInt(foo);`},
+			{Code: `parseInt(foo, 2);`},
+			{Code: `Number.parseInt(foo);`},
+			{Code: `Number.parseInt(foo, 2);`},
+			{Code: `parseInt(11, 2);`},
+			{Code: `Number.parseInt(1, 8);`},
+			{Code: `parseInt(1e5, 16);`},
+			{Code: `parseInt('11', '2');`},
+			{Code: `Number.parseInt('11', '8');`},
+			{Code: `parseInt(/foo/, 2);`},
+			{Code: "parseInt(`11${foo}`, 2);"},
+			{Code: `parseInt('11', 10);`},
+			{Code: `foo.parseInt('11', 2);`},
+
+			// Shadowed functions
+			{Code: `function f(parseInt: (s: string, r: number) => number) { return parseInt('11', 2); }`},
+			{Code: `function f(Number: { parseInt(s: string, r: number): number }) { return Number.parseInt('11', 2); }`},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			// Binary
+			{
+				Code:   `parseInt("111110111", 2) === 503;`,
+				Output: []string{`0b111110111 === 503;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `Number.parseInt('111110111', 2) === 503;`,
+				Output: []string{`0b111110111 === 503;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   "parseInt(`111110111`, 2) === 503;",
+				Output: []string{`0b111110111 === 503;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 1},
+				},
+			},
+
+			// Octal
+			{
+				Code:   `parseInt("767", 8) === 503;`,
+				Output: []string{`0o767 === 503;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `Number['parseInt']('767', 8) === 503;`,
+				Output: []string{`0o767 === 503;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 1},
+				},
+			},
+
+			// Hexadecimal
+			{
+				Code:   `parseInt("1F7", 16) === 255;`,
+				Output: []string{`0x1F7 === 255;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `Number.parseInt('ff', 16);`,
+				Output: []string{`0xff;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `parseInt('0xFF', 16);`,
+				Output: []string{`0xFF;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 1},
+				},
+			},
+
+			// Token spacing
+			{
+				Code:   `typeof parseInt("1", 2);`,
+				Output: []string{`typeof 0b1;`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 8},
+				},
+			},
+			{
+				Code:   `if (parseInt("11", 2)in foo) {}`,
+				Output: []string{`if (0b11 in foo) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 5},
+				},
+			},
+
+			// Strings that aren't valid literals are reported without a fix
+			{
+				Code: `parseInt('0x', 16);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `parseInt('0b11', 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `parseInt('12', 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `parseInt('1_0', 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `parseInt('', 8);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `parseInt(/* comment */ '11', 2);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "useLiteral", Line: 1, Column: 1},
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,179 @@
+package prefer_object_spread
+
+import (
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builders
+func buildUseSpreadMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "useSpreadMessage",
+		Description: "Use an object spread instead of `Object.assign` eg: `{ ...foo }`.",
+	}
+}
+
+func buildUseLiteralMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "useLiteralMessage",
+		Description: "Use an object literal instead of `Object.assign`. eg: `{ foo: bar }`.",
+	}
+}
+
+// isObjectAssignCall checks for `Object.assign(...)`
+func isObjectAssignCall(node *ast.Node) bool {
+	callee := ast.SkipParentheses(node.AsCallExpression().Expression)
+	if callee.Kind != ast.KindPropertyAccessExpression {
+		return false
+	}
+	access := callee.AsPropertyAccessExpression()
+	object := ast.SkipParentheses(access.Expression)
+	return object.Kind == ast.KindIdentifier && object.Text() == "Object" &&
+		access.Name().Kind == ast.KindIdentifier && access.Name().Text() == "assign"
+}
+
+func isEmptyObjectLiteral(node *ast.Node) bool {
+	node = ast.SkipParentheses(node)
+	return node.Kind == ast.KindObjectLiteralExpression && len(node.AsObjectLiteralExpression().Properties.Nodes) == 0
+}
+
+func hasAccessors(node *ast.Node) bool {
+	node = ast.SkipParentheses(node)
+	if node.Kind != ast.KindObjectLiteralExpression {
+		return false
+	}
+	return utils.Some(node.AsObjectLiteralExpression().Properties.Nodes, func(property *ast.Node) bool {
+		return property.Kind == ast.KindGetAccessor || property.Kind == ast.KindSetAccessor
+	})
+}
+
+// needsParens checks whether an object literal replacing node would be parsed as
+// a block, i.e. it would start an expression statement or an arrow function body
+func needsParens(node *ast.Node) bool {
+	for {
+		parent := node.Parent
+		switch parent.Kind {
+		case ast.KindExpressionStatement:
+			return true
+		case ast.KindArrowFunction:
+			return parent.Body() == node
+		case ast.KindPropertyAccessExpression, ast.KindElementAccessExpression, ast.KindCallExpression,
+			ast.KindNonNullExpression, ast.KindAsExpression, ast.KindSatisfiesExpression:
+			if parent.Expression() != node {
+				return false
+			}
+		case ast.KindTaggedTemplateExpression:
+			if parent.AsTaggedTemplateExpression().Tag != node {
+				return false
+			}
+		case ast.KindBinaryExpression:
+			if parent.AsBinaryExpression().Left != node {
+				return false
+			}
+		case ast.KindConditionalExpression:
+			if parent.AsConditionalExpression().Condition != node {
+				return false
+			}
+		default:
+			return false
+		}
+		node = parent
+	}
+}
+
+// PreferObjectSpreadRule prefers object spread over `Object.assign`
+var PreferObjectSpreadRule = rule.CreateRule(rule.Rule{
+	Name: "prefer-object-spread",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		isGlobalObject := func(callee *ast.Node) bool {
+			if ctx.TypeChecker == nil {
+				return true
+			}
+			object := ast.SkipParentheses(ast.SkipParentheses(callee).AsPropertyAccessExpression().Expression)
+			symbol := ctx.TypeChecker.GetSymbolAtLocation(object)
+			return symbol == nil || utils.IsSymbolFromDefaultLibrary(ctx.Program, symbol)
+		}
+
+		getNodeText := func(node *ast.Node) string {
+			nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			return ctx.SourceFile.Text()[nodeRange.Pos():nodeRange.End()]
+		}
+
+		// buildSpreadText builds `{...a, ...b}` from the arguments following the leading `{}`
+		buildSpreadText := func(node *ast.Node, args []*ast.Node) string {
+			parts := make([]string, 0, len(args))
+			for _, arg := range args {
+				if isEmptyObjectLiteral(arg) {
+					continue
+				}
+				parts = append(parts, "..."+getNodeText(arg))
+			}
+			text := "{" + strings.Join(parts, ", ") + "}"
+			if needsParens(node) {
+				text = "(" + text + ")"
+			}
+			return text
+		}
+
+		return rule.RuleListeners{
+			ast.KindCallExpression: func(node *ast.Node) {
+				if !isObjectAssignCall(node) {
+					return
+				}
+
+				args := node.Arguments()
+				if len(args) == 0 {
+					return
+				}
+
+				// `Object.assign({}, ...sources)` can't be expressed with a fixed number of spreads
+				if utils.Some(args, func(arg *ast.Node) bool { return arg.Kind == ast.KindSpreadElement }) {
+					return
+				}
+
+				if !isGlobalObject(node.AsCallExpression().Expression) {
+					return
+				}
+
+				first := ast.SkipParentheses(args[0])
+
+				// `Object.assign(target, source)` mutates target, so it can't be rewritten
+				if first.Kind != ast.KindObjectLiteralExpression {
+					if len(args) > 1 {
+						ctx.ReportNode(node, buildUseSpreadMessage())
+					}
+					return
+				}
+
+				// Spreading doesn't invoke setters on the target object
+				if len(args) > 1 && hasAccessors(first) {
+					return
+				}
+
+				if len(args) == 1 {
+					if utils.HasCommentsInRange(ctx.SourceFile, utils.TrimNodeTextRange(ctx.SourceFile, node)) {
+						ctx.ReportNode(node, buildUseLiteralMessage())
+						return
+					}
+					text := getNodeText(first)
+					if needsParens(node) {
+						text = "(" + text + ")"
+					}
+					ctx.ReportNodeWithFixes(node, buildUseLiteralMessage(), rule.RuleFixReplace(ctx.SourceFile, node, text))
+					return
+				}
+
+				// Only the `{}`-first form is autofixed
+				if !isEmptyObjectLiteral(first) || utils.HasCommentsInRange(ctx.SourceFile, utils.TrimNodeTextRange(ctx.SourceFile, node)) {
+					ctx.ReportNode(node, buildUseSpreadMessage())
+					return
+				}
+
+				ctx.ReportNodeWithFixes(node, buildUseSpreadMessage(), rule.RuleFixReplace(ctx.SourceFile, node, buildSpreadText(node, args[1:])))
+			},
+		}
+	},
+})
@@ -0,0 +1,96 @@
+package prefer_object_spread
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestPreferObjectSpreadRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&PreferObjectSpreadRule,
+		[]rule_tester.ValidTestCase{
+			{Code: `const x = { ...foo };`},
+			{Code: `Object.assign(...foo);`},
+			{Code: `Object.assign({}, ...sources);`},
+			{Code: `Object.assign(foo);`},
+			{Code: `Object.assign;`},
+			{Code: `Object.keys({}, foo);`},
+			{Code: `assign({}, foo);`},
+			{Code: `foo.assign({}, bar);`},
+			// Spreading doesn't invoke setters
+			{Code: `Object.assign({ set a(v) {} }, b);`},
+			{Code: `Object.assign({ get a() { return 1; } }, b);`},
+			// Shadowed Object
+			{Code: `function f(Object: any) { Object.assign({}, foo); }`},
+		},
+		[]rule_tester.InvalidTestCase{
+			// `{}`-first form is fixable
+			{
+				Code:   `const x = Object.assign({}, a, b);`,
+				Output: []string{`const x = {...a, ...b};`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "useSpreadMessage", Line: 1, Column: 11}},
+			},
+			{
+				Code:   `Object.assign({}, foo);`,
+				Output: []string{`({...foo});`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "useSpreadMessage", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `const f = () => Object.assign({}, foo);`,
+				Output: []string{`const f = () => ({...foo});`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "useSpreadMessage", Line: 1, Column: 17}},
+			},
+			{
+				Code:   `Object.assign({}, foo).bar;`,
+				Output: []string{`({...foo}).bar;`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "useSpreadMessage", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `const x = Object.assign({}, a, {}, b);`,
+				Output: []string{`const x = {...a, ...b};`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "useSpreadMessage", Line: 1, Column: 11}},
+			},
+			{
+				Code:   `const x = Object.assign({}, a ? b : c);`,
+				Output: []string{`const x = {...a ? b : c};`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "useSpreadMessage", Line: 1, Column: 11}},
+			},
+			{
+				Code:   `const x = Object.assign({});`,
+				Output: []string{`const x = {};`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "useLiteralMessage", Line: 1, Column: 11}},
+			},
+			{
+				Code:   `const x = Object.assign({ a: 1 });`,
+				Output: []string{`const x = { a: 1 };`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "useLiteralMessage", Line: 1, Column: 11}},
+			},
+
+			// Non-empty object literal first is reported but not fixed
+			{
+				Code:   `const x = Object.assign({ a: 1 }, b);`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "useSpreadMessage", Line: 1, Column: 11}},
+			},
+			// Comments would be lost
+			{
+				Code:   `const x = Object.assign({}, /* keep */ a);`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "useSpreadMessage", Line: 1, Column: 11}},
+			},
+
+			// Mutating form is reported but never fixed
+			{
+				Code:   `Object.assign(target, src);`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "useSpreadMessage", Line: 1, Column: 1}},
+			},
+			{
+				Code:   `const x = Object.assign(target, a, b);`,
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "useSpreadMessage", Line: 1, Column: 11}},
+			},
+		},
+	)
+}
@@ -0,0 +1,97 @@
+package prefer_rest_params
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+)
+
+// Message builder
+func buildPreferRestParamsMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "preferRestParams",
+		Description: "Use the rest parameters instead of 'arguments'.",
+	}
+}
+
+// findArgumentsOwner returns the closest function that provides the implicit
+// `arguments` binding. Arrow functions don't have their own `arguments`, so they
+// are skipped; class field initializers and static blocks stop the search.
+func findArgumentsOwner(node *ast.Node) *ast.Node {
+	current := node.Parent
+	for current != nil {
+		switch current.Kind {
+		case ast.KindArrowFunction:
+			// Arrow functions inherit `arguments` from the enclosing function
+		case ast.KindFunctionDeclaration,
+			ast.KindFunctionExpression,
+			ast.KindMethodDeclaration,
+			ast.KindGetAccessor,
+			ast.KindSetAccessor,
+			ast.KindConstructor:
+			return current
+		case ast.KindPropertyDeclaration,
+			ast.KindClassStaticBlockDeclaration,
+			ast.KindSourceFile:
+			return nil
+		}
+		current = current.Parent
+	}
+	return nil
+}
+
+// isNormalMemberAccess checks for `arguments.length`-like accesses, which are allowed
+func isNormalMemberAccess(node *ast.Node) bool {
+	parent := node.Parent
+	return parent != nil &&
+		parent.Kind == ast.KindPropertyAccessExpression &&
+		parent.AsPropertyAccessExpression().Expression == node
+}
+
+// isMemberName checks for the name side of `obj.arguments` or `A.arguments`,
+// which is a property name rather than a reference to the `arguments` binding
+func isMemberName(node *ast.Node) bool {
+	parent := node.Parent
+	if parent == nil {
+		return false
+	}
+	switch parent.Kind {
+	case ast.KindPropertyAccessExpression:
+		return parent.AsPropertyAccessExpression().Name() == node
+	case ast.KindQualifiedName:
+		return parent.AsQualifiedName().Right == node
+	}
+	return false
+}
+
+// PreferRestParamsRule requires rest parameters instead of `arguments`
+var PreferRestParamsRule = rule.CreateRule(rule.Rule{
+	Name: "prefer-rest-params",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		return rule.RuleListeners{
+			ast.KindIdentifier: func(node *ast.Node) {
+				if node.Text() != "arguments" {
+					return
+				}
+
+				// Skip property names, declarations and other non-reference positions
+				if !ast.IsExpressionNode(node) || isMemberName(node) || isNormalMemberAccess(node) {
+					return
+				}
+
+				if findArgumentsOwner(node) == nil {
+					return
+				}
+
+				// A local variable or parameter named `arguments` shadows the implicit binding
+				if ctx.TypeChecker != nil {
+					symbol := ctx.TypeChecker.GetSymbolAtLocation(node)
+					if symbol != nil && !ctx.TypeChecker.IsArgumentsSymbol(symbol) {
+						return
+					}
+				}
+
+				ctx.ReportNode(node, buildPreferRestParamsMessage())
+			},
+		}
+	},
+})
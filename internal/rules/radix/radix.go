@@ -0,0 +1,179 @@
+package radix
+
+import (
+	"strconv"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+const (
+	modeAlways   = "always"
+	modeAsNeeded = "as-needed"
+)
+
+func parseOptions(options any) string {
+	mode := modeAlways
+	switch v := options.(type) {
+	case string:
+		mode = v
+	case []interface{}:
+		if len(v) > 0 {
+			if s, ok := v[0].(string); ok {
+				mode = s
+			}
+		}
+	}
+	return mode
+}
+
+// Message builders
+func buildMissingParametersMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "missingParameters",
+		Description: "Missing parameters.",
+	}
+}
+
+func buildRedundantRadixMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "redundantRadix",
+		Description: "Redundant radix parameter.",
+	}
+}
+
+func buildMissingRadixMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "missingRadix",
+		Description: "Missing radix parameter.",
+	}
+}
+
+func buildInvalidRadixMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "invalidRadix",
+		Description: "Invalid radix parameter, must be an integer between 2 and 36.",
+	}
+}
+
+// getNumericValue returns the value of a numeric literal
+func getNumericValue(node *ast.Node) (float64, bool) {
+	if node.Kind != ast.KindNumericLiteral {
+		return 0, false
+	}
+	if value, err := strconv.ParseFloat(node.Text(), 64); err == nil {
+		return value, true
+	}
+	// Hexadecimal, octal and binary literals
+	value, err := strconv.ParseInt(node.Text(), 0, 64)
+	return float64(value), err == nil
+}
+
+// isValidRadix checks that a radix is not a literal outside 2-36 or `undefined`
+func isValidRadix(radix *ast.Node) bool {
+	radix = ast.SkipParentheses(radix)
+	switch radix.Kind {
+	case ast.KindNumericLiteral:
+		value, ok := getNumericValue(radix)
+		return ok && value >= 2 && value <= 36 && value == float64(int(value))
+	case ast.KindStringLiteral,
+		ast.KindNoSubstitutionTemplateLiteral,
+		ast.KindBigIntLiteral,
+		ast.KindRegularExpressionLiteral,
+		ast.KindTrueKeyword,
+		ast.KindFalseKeyword,
+		ast.KindNullKeyword:
+		return false
+	case ast.KindIdentifier:
+		return radix.Text() != "undefined"
+	}
+	return true
+}
+
+func isDefaultRadix(radix *ast.Node) bool {
+	value, ok := getNumericValue(ast.SkipParentheses(radix))
+	return ok && value == 10
+}
+
+// RadixRule enforces the consistent use of the radix argument when using parseInt()
+var RadixRule = rule.CreateRule(rule.Rule{
+	Name: "radix",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		mode := parseOptions(options)
+
+		isGlobal := func(identifier *ast.Node) bool {
+			if ctx.TypeChecker == nil {
+				return true
+			}
+			symbol := ctx.TypeChecker.GetSymbolAtLocation(identifier)
+			return symbol == nil || utils.IsSymbolFromDefaultLibrary(ctx.Program, symbol)
+		}
+
+		// isParseInt checks for the global `parseInt` and `Number.parseInt`
+		isParseInt := func(callee *ast.Node) bool {
+			callee = ast.SkipParentheses(callee)
+			switch callee.Kind {
+			case ast.KindIdentifier:
+				return callee.Text() == "parseInt" && isGlobal(callee)
+			case ast.KindPropertyAccessExpression, ast.KindElementAccessExpression:
+				object := ast.SkipParentheses(callee.Expression())
+				if object.Kind != ast.KindIdentifier || object.Text() != "Number" || !isGlobal(object) {
+					return false
+				}
+				if callee.Kind == ast.KindPropertyAccessExpression {
+					return callee.AsPropertyAccessExpression().Name().Text() == "parseInt"
+				}
+				argument := ast.SkipParentheses(callee.AsElementAccessExpression().ArgumentExpression)
+				return (argument.Kind == ast.KindStringLiteral || argument.Kind == ast.KindNoSubstitutionTemplateLiteral) &&
+					argument.Text() == "parseInt"
+			}
+			return false
+		}
+
+		return rule.RuleListeners{
+			ast.KindCallExpression: func(node *ast.Node) {
+				if !isParseInt(node.Expression()) {
+					return
+				}
+
+				args := node.Arguments()
+				switch len(args) {
+				case 0:
+					ctx.ReportNode(node, buildMissingParametersMessage())
+
+				case 1:
+					if mode != modeAlways {
+						return
+					}
+					// A spread argument may already include the radix
+					if args[0].Kind == ast.KindSpreadElement {
+						ctx.ReportNode(node, buildMissingRadixMessage())
+						return
+					}
+					// Add the radix after the string, keeping a trailing comma
+					if token := ctx.GetTokenAfter(args[0]); token != nil && token.Kind == ast.KindCommaToken {
+						ctx.ReportNodeWithFixes(node, buildMissingRadixMessage(),
+							rule.RuleFixReplaceRange(core.NewTextRange(token.Range.End(), token.Range.End()), " 10,"))
+					} else {
+						ctx.ReportNodeWithFixes(node, buildMissingRadixMessage(), rule.RuleFixInsertAfter(args[0], ", 10"))
+					}
+
+				default:
+					radix := args[1]
+					if mode == modeAsNeeded && isDefaultRadix(radix) {
+						removeRange := core.NewTextRange(args[0].End(), radix.End())
+						if len(args) > 2 || utils.HasCommentsInRange(ctx.SourceFile, removeRange) {
+							ctx.ReportNode(node, buildRedundantRadixMessage())
+							return
+						}
+						ctx.ReportNodeWithFixes(node, buildRedundantRadixMessage(), rule.RuleFixRemoveRange(removeRange))
+					} else if !isValidRadix(radix) {
+						ctx.ReportNode(node, buildInvalidRadixMessage())
+					}
+				}
+			},
+		}
+	},
+})
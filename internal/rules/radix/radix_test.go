@@ -0,0 +1,168 @@
+package radix
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestRadixRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&RadixRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `parseInt("10", 10);`},
+			{Code: `parseInt("10", 2);`},
+			{Code: `parseInt("10", 36);`},
+			{Code: `parseInt("10", 0x10);`},
+			{Code: `parseInt("10", 1.6e1);`},
+			{Code: `parseInt("10", foo);`},
+			{Code: `Number.parseInt("10", foo);`},
+			{Code: `Number['parseInt']("10", 10);`},
+			{Code: `parseInt("10", 10);`, Options: []interface{}{"always"}},
+			{Code: `parseInt("10");`, Options: []interface{}{"as-needed"}},
+			{Code: `parseInt("10", 8);`, Options: []interface{}{"as-needed"}},
+			{Code: `parseInt("10", foo);`, Options: "as-needed"},
+			{Code: `parseInt`},
+			{Code: `Number.foo();`},
+			{Code: `Number[parseInt]();`},
+			{Code: `foo.parseInt();`},
+
+			// Shadowed functions
+			{Code: `function f(parseInt: (s: string) => number) { return parseInt("10"); }`},
+			{Code: `function f(Number: { parseInt(s: string): number }) { return Number.parseInt("10"); }`},
+			{Code: `function f(parseInt: (s: string, r: number) => number) { return parseInt("10", 10); }`, Options: []interface{}{"as-needed"}},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `parseInt();`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingParameters", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `parseInt();`,
+				Options: []interface{}{"as-needed"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingParameters", Line: 1, Column: 1},
+				},
+			},
+
+			// always
+			{
+				Code:   `parseInt("10");`,
+				Output: []string{`parseInt("10", 10);`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingRadix", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `parseInt("10",);`,
+				Output: []string{`parseInt("10", 10,);`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingRadix", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:   `Number.parseInt(foo);`,
+				Output: []string{`Number.parseInt(foo, 10);`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingRadix", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `parseInt((0, "10"));`,
+				Options: []interface{}{"always"},
+				Output:  []string{`parseInt((0, "10"), 10);`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingRadix", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `parseInt(...args);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "missingRadix", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `parseInt("10", null);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidRadix", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `parseInt("10", undefined);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidRadix", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `parseInt("10", true);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidRadix", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `parseInt("10", "foo");`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidRadix", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `parseInt("10", 1);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidRadix", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `parseInt("10", 37);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidRadix", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `parseInt("10", 10.5);`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidRadix", Line: 1, Column: 1},
+				},
+			},
+
+			// as-needed
+			{
+				Code:    `parseInt("10", 10);`,
+				Options: []interface{}{"as-needed"},
+				Output:  []string{`parseInt("10");`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "redundantRadix", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `Number.parseInt("10", 10,);`,
+				Options: "as-needed",
+				Output:  []string{`Number.parseInt("10",);`},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "redundantRadix", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `parseInt("10", /* decimal */ 10);`,
+				Options: []interface{}{"as-needed"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "redundantRadix", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `parseInt("10", 1);`,
+				Options: []interface{}{"as-needed"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidRadix", Line: 1, Column: 1},
+				},
+			},
+		},
+	)
+}
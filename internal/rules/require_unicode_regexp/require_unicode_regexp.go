@@ -0,0 +1,224 @@
+package require_unicode_regexp
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Surrogate halves are matched separately without the flag, but as one code point with it
+var surrogateEscapePattern = regexp.MustCompile(`(?i)\\uD[89A-F][0-9A-F]{2}`)
+
+// Options for require-unicode-regexp rule
+type Options struct {
+	RequireFlag string `json:"requireFlag"`
+}
+
+func parseOptions(options any) Options {
+	opts := Options{}
+
+	if options == nil {
+		return opts
+	}
+
+	// Parse options with dual-format support (handles both array and object formats)
+	var optsMap map[string]interface{}
+	var ok bool
+
+	// Handle array format: [{ option: value }]
+	if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+		optsMap, ok = optArray[0].(map[string]interface{})
+	} else {
+		// Handle direct object format: { option: value }
+		optsMap, ok = options.(map[string]interface{})
+	}
+
+	if ok {
+		if v, ok := optsMap["requireFlag"].(string); ok {
+			opts.RequireFlag = v
+		}
+	}
+	return opts
+}
+
+// Message builders
+func buildRequireFlagMessage(flag string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "require" + strings.ToUpper(flag) + "Flag",
+		Description: "Use the '" + flag + "' flag.",
+	}
+}
+
+func buildAddFlagMessage(flag string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "add" + strings.ToUpper(flag) + "Flag",
+		Description: "Add the '" + flag + "' flag.",
+	}
+}
+
+// getStaticString returns the value of a string literal or a template literal without substitutions
+func getStaticString(node *ast.Node) (string, bool) {
+	if node == nil {
+		return "", false
+	}
+	node = ast.SkipParentheses(node)
+	if node.Kind == ast.KindStringLiteral || node.Kind == ast.KindNoSubstitutionTemplateLiteral {
+		return node.Text(), true
+	}
+	return "", false
+}
+
+// hasAstralCharacters checks for characters outside the Basic Multilingual Plane
+func hasAstralCharacters(pattern string) bool {
+	for _, r := range pattern {
+		if r > 0xFFFF {
+			return true
+		}
+	}
+	return surrogateEscapePattern.MatchString(pattern)
+}
+
+// RequireUnicodeRegexpRule enforces the use of `u` or `v` flag on regular expressions
+var RequireUnicodeRegexpRule = rule.CreateRule(rule.Rule{
+	Name: "require-unicode-regexp",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+
+		flag := "u"
+		if opts.RequireFlag != "" {
+			flag = opts.RequireFlag
+		}
+
+		isFlagMissing := func(flags string) bool {
+			if opts.RequireFlag != "" {
+				return !strings.Contains(flags, opts.RequireFlag)
+			}
+			return !strings.ContainsAny(flags, "uv")
+		}
+
+		// getNewFlags adds the required flag, replacing `u` when `v` is required
+		getNewFlags := func(flags string) string {
+			if flag == "v" {
+				flags = strings.ReplaceAll(flags, "u", "")
+			}
+			return flags + flag
+		}
+
+		// canAddFlag checks that the pattern is still valid and matches the same way with the flag
+		canAddFlag := func(pattern string, flags string) bool {
+			// The flags can't be combined
+			if flag == "u" && strings.Contains(flags, "v") {
+				return false
+			}
+			if _, err := utils.ParseRegExp(pattern, getNewFlags(flags)); err != nil {
+				return false
+			}
+			return strings.ContainsAny(flags, "uv") || !hasAstralCharacters(pattern)
+		}
+
+		report := func(node *ast.Node, fixes []rule.RuleFix) {
+			message := buildRequireFlagMessage(flag)
+			if len(fixes) == 0 {
+				ctx.ReportNode(node, message)
+				return
+			}
+			ctx.ReportNodeWithSuggestions(node, message, rule.RuleSuggestion{
+				Message:  buildAddFlagMessage(flag),
+				FixesArr: fixes,
+			})
+		}
+
+		isGlobalRegExp := func(callee *ast.Node) bool {
+			if callee.Kind != ast.KindIdentifier || callee.Text() != "RegExp" {
+				return false
+			}
+			if ctx.TypeChecker == nil {
+				return true
+			}
+			symbol := ctx.TypeChecker.GetSymbolAtLocation(callee)
+			return symbol == nil || utils.IsSymbolFromDefaultLibrary(ctx.Program, symbol)
+		}
+
+		checkConstructor := func(node *ast.Node) {
+			if !isGlobalRegExp(ast.SkipParentheses(node.Expression())) {
+				return
+			}
+			args := node.Arguments()
+			for _, arg := range args {
+				if arg.Kind == ast.KindSpreadElement {
+					return
+				}
+			}
+
+			var patternNode, flagsNode *ast.Node
+			if len(args) > 0 {
+				patternNode = args[0]
+			}
+			if len(args) > 1 {
+				flagsNode = args[1]
+			}
+
+			flags := ""
+			if flagsNode != nil {
+				var ok bool
+				if flags, ok = getStaticString(flagsNode); !ok {
+					// Flags that aren't known statically may include the flag
+					return
+				}
+			}
+			if !isFlagMissing(flags) {
+				return
+			}
+
+			pattern, ok := getStaticString(patternNode)
+			if !ok || !canAddFlag(pattern, flags) {
+				report(node, nil)
+				return
+			}
+
+			if flagsNode == nil {
+				// Add the flags argument after the pattern, keeping a trailing comma
+				if token := ctx.GetTokenAfter(patternNode); token != nil && token.Kind == ast.KindCommaToken {
+					report(node, []rule.RuleFix{rule.RuleFixReplaceRange(core.NewTextRange(token.Range.End(), token.Range.End()), ` "`+flag+`",`)})
+				} else {
+					report(node, []rule.RuleFix{rule.RuleFixInsertAfter(patternNode, `, "`+flag+`"`)})
+				}
+				return
+			}
+
+			// Only rewrite flags whose source text is exactly their value
+			flagsNode = ast.SkipParentheses(flagsNode)
+			flagsRange := utils.TrimNodeTextRange(ctx.SourceFile, flagsNode)
+			flagsText := ctx.SourceFile.Text()[flagsRange.Pos():flagsRange.End()]
+			if flagsText[1:len(flagsText)-1] != flags {
+				report(node, nil)
+				return
+			}
+			quote := flagsText[:1]
+			report(node, []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, flagsNode, quote+getNewFlags(flags)+quote)})
+		}
+
+		return rule.RuleListeners{
+			ast.KindRegularExpressionLiteral: func(node *ast.Node) {
+				pattern, flags := utils.ExtractRegexLiteral(node)
+				if !isFlagMissing(flags) {
+					return
+				}
+				if !canAddFlag(pattern, flags) {
+					report(node, nil)
+					return
+				}
+
+				literalRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+				flagsStart := literalRange.End() - len(flags)
+				report(node, []rule.RuleFix{rule.RuleFixReplaceRange(core.NewTextRange(flagsStart, literalRange.End()), getNewFlags(flags))})
+			},
+			ast.KindCallExpression: checkConstructor,
+			ast.KindNewExpression:  checkConstructor,
+		}
+	},
+})
@@ -0,0 +1,211 @@
+package require_unicode_regexp
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestRequireUnicodeRegexpRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&RequireUnicodeRegexpRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `/foo/u`},
+			{Code: `/foo/gimuy`},
+			{Code: `/foo/v`},
+			{Code: `/foo/gimvy`},
+			{Code: `RegExp('', 'u')`},
+			{Code: `RegExp('', 'v')`},
+			{Code: `new RegExp('', 'u')`},
+			{Code: `new RegExp('', 'v')`},
+			{Code: `RegExp('', 'gimuy')`},
+			{Code: `new RegExp('', 'gimuy')`},
+			{Code: "new RegExp('', `u`)"},
+			{Code: `const flags = 'u'; new RegExp('', flags)`},
+			{Code: `function f(flags: string) { return new RegExp('', flags); }`},
+			{Code: `new RegExp('', ...args)`},
+			{Code: `function f(RegExp: any) { return new RegExp('foo'); }`},
+			{Code: `new foo.RegExp('foo')`},
+			{Code: `/foo/u`, Options: map[string]interface{}{"requireFlag": "u"}},
+			{Code: `/foo/v`, Options: map[string]interface{}{"requireFlag": "v"}},
+			{Code: `new RegExp('', 'v')`, Options: []interface{}{map[string]interface{}{"requireFlag": "v"}}},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `/\a/`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "requireUFlag", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `/foo/`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "requireUFlag", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addUFlag", Output: `/foo/u`},
+						},
+					},
+				},
+			},
+			{
+				Code: `/foo/gimy`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "requireUFlag", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addUFlag", Output: `/foo/gimyu`},
+						},
+					},
+				},
+			},
+
+			// RegExp constructor
+			{
+				Code: `RegExp('foo')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "requireUFlag", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addUFlag", Output: `RegExp('foo', "u")`},
+						},
+					},
+				},
+			},
+			{
+				Code: `RegExp('\\a')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "requireUFlag", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `RegExp('foo', '')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "requireUFlag", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addUFlag", Output: `RegExp('foo', 'u')`},
+						},
+					},
+				},
+			},
+			{
+				Code: `new RegExp('pattern', 'g')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "requireUFlag", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addUFlag", Output: `new RegExp('pattern', 'gu')`},
+						},
+					},
+				},
+			},
+			{
+				Code: "new RegExp(`pattern`, `g`)",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "requireUFlag", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addUFlag", Output: "new RegExp(`pattern`, `gu`)"},
+						},
+					},
+				},
+			},
+			{
+				Code: `new RegExp('foo',)`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "requireUFlag", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addUFlag", Output: `new RegExp('foo', "u",)`},
+						},
+					},
+				},
+			},
+			{
+				Code: `const r = new RegExp(pattern, 'g');`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "requireUFlag", Line: 1, Column: 11},
+				},
+			},
+			{
+				Code: `new RegExp`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "requireUFlag", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `new RegExp('[', 'g')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "requireUFlag", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `new RegExp('\\-', 'g')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "requireUFlag", Line: 1, Column: 1},
+				},
+			},
+
+			// No suggestion when astral characters would be matched differently
+			{
+				Code: `/^[😀]$/`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "requireUFlag", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `new RegExp('\\uD83D\\uDE00{2}')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "requireUFlag", Line: 1, Column: 1},
+				},
+			},
+
+			// requireFlag
+			{
+				Code:    `/foo/u`,
+				Options: map[string]interface{}{"requireFlag": "v"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "requireVFlag", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addVFlag", Output: `/foo/v`},
+						},
+					},
+				},
+			},
+			{
+				Code:    `/[(]/u`,
+				Options: map[string]interface{}{"requireFlag": "v"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "requireVFlag", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code:    `new RegExp('foo', 'gu')`,
+				Options: map[string]interface{}{"requireFlag": "v"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "requireVFlag", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "addVFlag", Output: `new RegExp('foo', 'gv')`},
+						},
+					},
+				},
+			},
+			{
+				Code:    `/foo/v`,
+				Options: map[string]interface{}{"requireFlag": "u"},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "requireUFlag", Line: 1, Column: 1},
+				},
+			},
+		},
+	)
+}
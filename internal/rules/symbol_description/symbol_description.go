@@ -0,0 +1,55 @@
+package symbol_description
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Message builders
+func buildExpectedMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "expected",
+		Description: "Expected Symbol to have a description.",
+	}
+}
+
+func buildAddDescriptionMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "addDescription",
+		Description: "Add a description to the Symbol.",
+	}
+}
+
+// SymbolDescriptionRule requires a description when creating symbols
+var SymbolDescriptionRule = rule.CreateRule(rule.Rule{
+	Name: "symbol-description",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		isGlobalSymbol := func(callee *ast.Node) bool {
+			if callee.Kind != ast.KindIdentifier || callee.Text() != "Symbol" {
+				return false
+			}
+			if ctx.TypeChecker == nil {
+				return true
+			}
+			symbol := ctx.TypeChecker.GetSymbolAtLocation(callee)
+			return symbol == nil || utils.IsSymbolFromDefaultLibrary(ctx.Program, symbol)
+		}
+
+		return rule.RuleListeners{
+			ast.KindCallExpression: func(node *ast.Node) {
+				if len(node.Arguments()) != 0 || !isGlobalSymbol(ast.SkipParentheses(node.Expression())) {
+					return
+				}
+
+				// The placeholder goes right before the closing parenthesis, after any comments
+				closeParen := node.End() - 1
+				ctx.ReportNodeWithSuggestions(node, buildExpectedMessage(), rule.RuleSuggestion{
+					Message:  buildAddDescriptionMessage(),
+					FixesArr: []rule.RuleFix{rule.RuleFixReplaceRange(core.NewTextRange(closeParen, closeParen), "'description'")},
+				})
+			},
+		}
+	},
+})
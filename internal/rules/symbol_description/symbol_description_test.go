@@ -0,0 +1,60 @@
+package symbol_description
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestSymbolDescriptionRule(t *testing.T) {
+	rule_tester.RunRuleTester(fixtures.GetRootDir(), "tsconfig.json", t, &SymbolDescriptionRule, []rule_tester.ValidTestCase{
+		{Code: `const foo = Symbol('some description');`},
+		{Code: `declare const bar: string; const foo = Symbol(bar);`},
+		{Code: `const foo = Symbol('');`},
+		{Code: `const foo = Symbol.for('key');`},
+		{Code: `function f(Symbol: () => symbol) { return Symbol(); }`},
+		{Code: `const foo = { Symbol() {} }.Symbol();`},
+	}, []rule_tester.InvalidTestCase{
+		{
+			Code: `const foo = Symbol();`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "expected",
+					Line:      1,
+					Column:    13,
+					EndColumn: 21,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{MessageId: "addDescription", Output: `const foo = Symbol('description');`},
+					},
+				},
+			},
+		},
+		{
+			Code: `const foo = (Symbol)();`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "expected",
+					Line:      1,
+					Column:    13,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{MessageId: "addDescription", Output: `const foo = (Symbol)('description');`},
+					},
+				},
+			},
+		},
+		{
+			Code: `const foo = Symbol(/* no description */);`,
+			Errors: []rule_tester.InvalidTestCaseError{
+				{
+					MessageId: "expected",
+					Line:      1,
+					Column:    13,
+					Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+						{MessageId: "addDescription", Output: `const foo = Symbol(/* no description */'description');`},
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,153 @@
+package valid_typeof
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Options for valid-typeof rule
+type Options struct {
+	RequireStringLiterals bool `json:"requireStringLiterals"`
+}
+
+func parseOptions(options any) Options {
+	opts := Options{}
+
+	if options == nil {
+		return opts
+	}
+
+	// Parse options with dual-format support (handles both array and object formats)
+	var optsMap map[string]interface{}
+	var ok bool
+
+	// Handle array format: [{ option: value }]
+	if optArray, isArray := options.([]interface{}); isArray && len(optArray) > 0 {
+		optsMap, ok = optArray[0].(map[string]interface{})
+	} else {
+		// Handle direct object format: { option: value }
+		optsMap, ok = options.(map[string]interface{})
+	}
+
+	if ok {
+		if v, ok := optsMap["requireStringLiterals"].(bool); ok {
+			opts.RequireStringLiterals = v
+		}
+	}
+	return opts
+}
+
+// Message builders
+func buildInvalidValueMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "invalidValue",
+		Description: "Invalid typeof comparison value.",
+	}
+}
+
+func buildNotStringMessage() rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "notString",
+		Description: "Typeof comparisons should be to string literals.",
+	}
+}
+
+func buildSuggestStringMessage(typeName string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "suggestString",
+		Description: "Use `\"" + typeName + "\"` instead of `" + typeName + "`.",
+	}
+}
+
+var validTypes = map[string]bool{
+	"symbol":    true,
+	"undefined": true,
+	"object":    true,
+	"boolean":   true,
+	"number":    true,
+	"string":    true,
+	"function":  true,
+	"bigint":    true,
+}
+
+func isEqualityOperator(kind ast.Kind) bool {
+	switch kind {
+	case ast.KindEqualsEqualsToken, ast.KindEqualsEqualsEqualsToken, ast.KindExclamationEqualsToken, ast.KindExclamationEqualsEqualsToken:
+		return true
+	}
+	return false
+}
+
+// isLiteral checks for literals whose value can be compared with the result of typeof
+func isLiteral(node *ast.Node) bool {
+	switch node.Kind {
+	case ast.KindStringLiteral, ast.KindNoSubstitutionTemplateLiteral, ast.KindNumericLiteral, ast.KindBigIntLiteral,
+		ast.KindRegularExpressionLiteral, ast.KindTrueKeyword, ast.KindFalseKeyword, ast.KindNullKeyword:
+		return true
+	}
+	return false
+}
+
+// ValidTypeofRule enforces comparing typeof expressions against valid strings
+var ValidTypeofRule = rule.CreateRule(rule.Rule{
+	Name: "valid-typeof",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+
+		isGlobalUndefined := func(node *ast.Node) bool {
+			if ctx.TypeChecker == nil {
+				return true
+			}
+			symbol := ctx.TypeChecker.GetSymbolAtLocation(node)
+			// `undefined` resolves to a synthesized symbol without declarations
+			return symbol == nil || len(symbol.Declarations) == 0 || utils.IsSymbolFromDefaultLibrary(ctx.Program, symbol)
+		}
+
+		return rule.RuleListeners{
+			ast.KindTypeOfExpression: func(node *ast.Node) {
+				// Walk up through parentheses: (typeof foo) === 'string'
+				operand := node
+				parent := node.Parent
+				for parent != nil && parent.Kind == ast.KindParenthesizedExpression {
+					operand = parent
+					parent = parent.Parent
+				}
+				if parent == nil || parent.Kind != ast.KindBinaryExpression {
+					return
+				}
+				binary := parent.AsBinaryExpression()
+				if !isEqualityOperator(binary.OperatorToken.Kind) {
+					return
+				}
+
+				sibling := binary.Left
+				if binary.Left == operand {
+					sibling = binary.Right
+				}
+				sibling = ast.SkipParentheses(sibling)
+
+				switch {
+				case isLiteral(sibling):
+					isString := sibling.Kind == ast.KindStringLiteral || sibling.Kind == ast.KindNoSubstitutionTemplateLiteral
+					if !isString || !validTypes[sibling.Text()] {
+						ctx.ReportNode(sibling, buildInvalidValueMessage())
+					}
+
+				case sibling.Kind == ast.KindIdentifier && sibling.Text() == "undefined" && isGlobalUndefined(sibling):
+					message := buildInvalidValueMessage()
+					if opts.RequireStringLiterals {
+						message = buildNotStringMessage()
+					}
+					ctx.ReportNodeWithSuggestions(sibling, message, rule.RuleSuggestion{
+						Message:  buildSuggestStringMessage("undefined"),
+						FixesArr: []rule.RuleFix{rule.RuleFixReplace(ctx.SourceFile, sibling, `"undefined"`)},
+					})
+
+				case opts.RequireStringLiterals && sibling.Kind != ast.KindTypeOfExpression:
+					ctx.ReportNode(sibling, buildNotStringMessage())
+				}
+			},
+		}
+	},
+})
@@ -0,0 +1,205 @@
+package valid_typeof
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestValidTypeofRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&ValidTypeofRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			{Code: `typeof foo === 'string'`},
+			{Code: `typeof foo === 'object'`},
+			{Code: `typeof foo === 'function'`},
+			{Code: `typeof foo === 'undefined'`},
+			{Code: `typeof foo === 'boolean'`},
+			{Code: `typeof foo === 'number'`},
+			{Code: `typeof foo === 'bigint'`},
+			{Code: `typeof foo === 'symbol'`},
+			{Code: `'string' === typeof foo`},
+			{Code: `'object' === typeof foo`},
+			{Code: `typeof foo === typeof bar`},
+			{Code: `typeof foo === baz`},
+			{Code: `typeof foo !== someType`},
+			{Code: `typeof bar != someType`},
+			{Code: `someType === typeof bar`},
+			{Code: `someType == typeof bar`},
+			{Code: `typeof foo == 'string'`},
+			{Code: `typeof(foo) === 'string'`},
+			{Code: `typeof(foo) !== 'string'`},
+			{Code: `typeof(foo) == 'string'`},
+			{Code: `typeof(foo) != 'string'`},
+			{Code: `(typeof foo) === 'string'`},
+			{Code: `var oneOf = ['string', 'number']; oneOf.includes(typeof foo)`},
+			{Code: `typeof foo < 'strnig'`},
+			{Code: `function f(undefined: any) { return typeof foo === undefined; }`},
+			{Code: `typeof foo === 'undefined'`, Options: map[string]interface{}{"requireStringLiterals": true}},
+			{Code: `typeof foo === 'object'`, Options: map[string]interface{}{"requireStringLiterals": true}},
+			{Code: `typeof foo === typeof bar`, Options: map[string]interface{}{"requireStringLiterals": true}},
+			{Code: `'string' === typeof foo`, Options: map[string]interface{}{"requireStringLiterals": true}},
+			{Code: "`string` === typeof foo", Options: map[string]interface{}{"requireStringLiterals": true}},
+			{Code: "typeof foo === `string`", Options: map[string]interface{}{"requireStringLiterals": true}},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code: `typeof foo === 'strnig'`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidValue", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code: `'strnig' === typeof foo`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidValue", Line: 1, Column: 1},
+				},
+			},
+			{
+				Code: `if (typeof bar === 'umdefined') {}`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidValue", Line: 1, Column: 20},
+				},
+			},
+			{
+				Code: `typeof foo !== 'strnig'`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidValue", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code: `typeof foo != 'strnig'`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidValue", Line: 1, Column: 15},
+				},
+			},
+			{
+				Code: `typeof foo == 'strnig'`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidValue", Line: 1, Column: 15},
+				},
+			},
+			{
+				Code: `typeof(foo) === 'strnig'`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidValue", Line: 1, Column: 17},
+				},
+			},
+			{
+				Code: `(typeof foo) === ('strnig')`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidValue", Line: 1, Column: 18},
+				},
+			},
+			{
+				Code: `typeof foo === 'Undefined'`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidValue", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code: "typeof foo === `strnig`",
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidValue", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code: `typeof foo === 5`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidValue", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code: `typeof foo === null`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidValue", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code: `typeof foo === true`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidValue", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code:    `typeof foo === 'strnig'`,
+				Options: map[string]interface{}{"requireStringLiterals": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "invalidValue", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code: `typeof foo === undefined`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "invalidValue", Line: 1, Column: 16,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestString", Output: `typeof foo === "undefined"`},
+						},
+					},
+				},
+			},
+			{
+				Code: `undefined === typeof foo`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "invalidValue", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestString", Output: `"undefined" === typeof foo`},
+						},
+					},
+				},
+			},
+			{
+				Code: `undefined == typeof foo`,
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "invalidValue", Line: 1, Column: 1,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestString", Output: `"undefined" == typeof foo`},
+						},
+					},
+				},
+			},
+			{
+				Code:    `typeof foo === undefined`,
+				Options: map[string]interface{}{"requireStringLiterals": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{
+						MessageId: "notString", Line: 1, Column: 16,
+						Suggestions: []rule_tester.InvalidTestCaseSuggestion{
+							{MessageId: "suggestString", Output: `typeof foo === "undefined"`},
+						},
+					},
+				},
+			},
+			{
+				Code:    `typeof foo === Object`,
+				Options: map[string]interface{}{"requireStringLiterals": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notString", Line: 1, Column: 16},
+				},
+			},
+			{
+				Code:    `if (typeof bar !== someType) {}`,
+				Options: map[string]interface{}{"requireStringLiterals": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notString", Line: 1, Column: 20},
+				},
+			},
+			{
+				Code:    `someType === typeof foo`,
+				Options: map[string]interface{}{"requireStringLiterals": true},
+				Errors: []rule_tester.InvalidTestCaseError{
+					{MessageId: "notString", Line: 1, Column: 1},
+				},
+			},
+		},
+	)
+}
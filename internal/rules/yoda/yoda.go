@@ -0,0 +1,308 @@
+package yoda
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/web-infra-dev/rslint/internal/rule"
+	"github.com/web-infra-dev/rslint/internal/utils"
+)
+
+// Options for yoda rule
+type Options struct {
+	Mode         string `json:"mode"`
+	ExceptRange  bool   `json:"exceptRange"`
+	OnlyEquality bool   `json:"onlyEquality"`
+}
+
+func parseOptions(options any) Options {
+	opts := Options{
+		Mode: "never",
+	}
+
+	if options == nil {
+		return opts
+	}
+
+	parseObject := func(optsMap map[string]interface{}) {
+		if v, ok := optsMap["exceptRange"].(bool); ok {
+			opts.ExceptRange = v
+		}
+		if v, ok := optsMap["onlyEquality"].(bool); ok {
+			opts.OnlyEquality = v
+		}
+	}
+
+	// Handle array format: ["always", { option: value }]
+	switch v := options.(type) {
+	case []interface{}:
+		for _, item := range v {
+			switch item := item.(type) {
+			case string:
+				opts.Mode = item
+			case map[string]interface{}:
+				parseObject(item)
+			}
+		}
+	case string:
+		opts.Mode = v
+	case map[string]interface{}:
+		// Handle direct object format: { mode: "always", option: value }
+		if mode, ok := v["mode"].(string); ok {
+			opts.Mode = mode
+		}
+		parseObject(v)
+	}
+	return opts
+}
+
+// Message builder
+func buildExpectedMessage(expectedSide string, operator string) rule.RuleMessage {
+	return rule.RuleMessage{
+		Id:          "expected",
+		Description: "Expected literal to be on the " + expectedSide + " side of " + operator + ".",
+	}
+}
+
+var operatorFlipMap = map[ast.Kind]string{
+	ast.KindEqualsEqualsEqualsToken:      "===",
+	ast.KindExclamationEqualsEqualsToken: "!==",
+	ast.KindEqualsEqualsToken:            "==",
+	ast.KindExclamationEqualsToken:       "!=",
+	ast.KindLessThanToken:                ">",
+	ast.KindGreaterThanToken:             "<",
+	ast.KindLessThanEqualsToken:          ">=",
+	ast.KindGreaterThanEqualsToken:       "<=",
+}
+
+func isEqualityOperator(kind ast.Kind) bool {
+	return kind == ast.KindEqualsEqualsToken || kind == ast.KindEqualsEqualsEqualsToken
+}
+
+func isRangeTestOperator(kind ast.Kind) bool {
+	return kind == ast.KindLessThanToken || kind == ast.KindLessThanEqualsToken
+}
+
+func isComparisonOperator(kind ast.Kind) bool {
+	_, ok := operatorFlipMap[kind]
+	return ok
+}
+
+// isNegativeNumericLiteral checks for `-1` style literals
+func isNegativeNumericLiteral(node *ast.Node) bool {
+	if node.Kind != ast.KindPrefixUnaryExpression {
+		return false
+	}
+	unary := node.AsPrefixUnaryExpression()
+	return unary.Operator == ast.KindMinusToken && unary.Operand.Kind == ast.KindNumericLiteral
+}
+
+// isLiteral checks whether node is a literal, a negative number or a template
+// literal without substitutions
+func isLiteral(node *ast.Node) bool {
+	node = ast.SkipParentheses(node)
+	switch node.Kind {
+	case ast.KindStringLiteral,
+		ast.KindNumericLiteral,
+		ast.KindBigIntLiteral,
+		ast.KindRegularExpressionLiteral,
+		ast.KindNoSubstitutionTemplateLiteral,
+		ast.KindTrueKeyword,
+		ast.KindFalseKeyword,
+		ast.KindNullKeyword:
+		return true
+	}
+	return isNegativeNumericLiteral(node)
+}
+
+// literalValue is a comparable value of a literal, either a number or a string
+type literalValue struct {
+	isString bool
+	str      string
+	num      float64
+}
+
+// getNormalizedLiteral returns the value of a literal node, or nil if the node
+// isn't a literal that can be compared
+func getNormalizedLiteral(node *ast.Node) *literalValue {
+	node = ast.SkipParentheses(node)
+	switch node.Kind {
+	case ast.KindStringLiteral, ast.KindNoSubstitutionTemplateLiteral:
+		return &literalValue{isString: true, str: node.Text()}
+	case ast.KindNumericLiteral:
+		if n, err := strconv.ParseFloat(node.Text(), 64); err == nil {
+			return &literalValue{num: n}
+		}
+	case ast.KindTrueKeyword:
+		return &literalValue{num: 1}
+	case ast.KindFalseKeyword, ast.KindNullKeyword:
+		return &literalValue{num: 0}
+	case ast.KindPrefixUnaryExpression:
+		if isNegativeNumericLiteral(node) {
+			if n, err := strconv.ParseFloat(node.AsPrefixUnaryExpression().Operand.Text(), 64); err == nil {
+				return &literalValue{num: -n}
+			}
+		}
+	}
+	return nil
+}
+
+// isLessThanOrEqual mirrors JavaScript's `<=` for the normalized literal values
+func isLessThanOrEqual(a, b *literalValue) bool {
+	if a.isString && b.isString {
+		return a.str <= b.str
+	}
+	toNumber := func(v *literalValue) (float64, bool) {
+		if !v.isString {
+			return v.num, true
+		}
+		s := strings.TrimSpace(v.str)
+		if s == "" {
+			return 0, true
+		}
+		n, err := strconv.ParseFloat(s, 64)
+		return n, err == nil
+	}
+	an, ok := toNumber(a)
+	if !ok {
+		return false
+	}
+	bn, ok := toNumber(b)
+	if !ok {
+		return false
+	}
+	return an <= bn
+}
+
+func getComparison(node *ast.Node) *ast.BinaryExpression {
+	node = ast.SkipParentheses(node)
+	if node.Kind != ast.KindBinaryExpression {
+		return nil
+	}
+	binary := node.AsBinaryExpression()
+	if !isRangeTestOperator(binary.OperatorToken.Kind) {
+		return nil
+	}
+	return binary
+}
+
+func isIdentifierPartChar(ch byte) bool {
+	return ch == '_' || ch == '$' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9')
+}
+
+// YodaRule requires or disallows "Yoda" conditions
+var YodaRule = rule.CreateRule(rule.Rule{
+	Name: "yoda",
+	Run: func(ctx rule.RuleContext, options any) rule.RuleListeners {
+		opts := parseOptions(options)
+		always := opts.Mode == "always"
+		text := ctx.SourceFile.Text()
+
+		// isParenWrapped checks whether the node is directly surrounded by
+		// parentheses, including those of statements like `if (...)`
+		isParenWrapped := func(node *ast.Node) bool {
+			r := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			before := strings.TrimRight(text[:r.Pos()], " \t\r\n")
+			after := strings.TrimLeft(text[r.End():], " \t\r\n")
+			return strings.HasSuffix(before, "(") && strings.HasPrefix(after, ")")
+		}
+
+		// isRangeTest checks for `0 <= x && x < 10` or `x < 0 || 10 <= x`
+		isRangeTest := func(node *ast.Node) bool {
+			if node == nil || node.Kind != ast.KindBinaryExpression {
+				return false
+			}
+			logical := node.AsBinaryExpression()
+			left := getComparison(logical.Left)
+			right := getComparison(logical.Right)
+			if left == nil || right == nil {
+				return false
+			}
+
+			isBetweenOrOutside := func(leftLiteral, rightLiteral *literalValue) bool {
+				if leftLiteral == nil && rightLiteral == nil {
+					return false
+				}
+				if leftLiteral == nil || rightLiteral == nil {
+					return true
+				}
+				return isLessThanOrEqual(leftLiteral, rightLiteral)
+			}
+
+			isBetweenTest := logical.OperatorToken.Kind == ast.KindAmpersandAmpersandToken &&
+				utils.IsSameReference(left.Right, right.Left) &&
+				isBetweenOrOutside(getNormalizedLiteral(left.Left), getNormalizedLiteral(right.Right))
+			isOutsideTest := logical.OperatorToken.Kind == ast.KindBarBarToken &&
+				utils.IsSameReference(left.Left, right.Right) &&
+				isBetweenOrOutside(getNormalizedLiteral(left.Right), getNormalizedLiteral(right.Left))
+
+			return (isBetweenTest || isOutsideTest) && isParenWrapped(node)
+		}
+
+		// getFlippedString swaps the operands and flips the operator, keeping
+		// the whitespace around the operator
+		getFlippedString := func(node *ast.Node) string {
+			binary := node.AsBinaryExpression()
+			nodeRange := utils.TrimNodeTextRange(ctx.SourceFile, node)
+			operatorRange := utils.TrimNodeTextRange(ctx.SourceFile, binary.OperatorToken)
+			rightRange := utils.TrimNodeTextRange(ctx.SourceFile, binary.Right)
+
+			leftText := text[nodeRange.Pos():binary.Left.End()]
+			textBeforeOperator := text[binary.Left.End():operatorRange.Pos()]
+			textAfterOperator := text[operatorRange.End():rightRange.Pos()]
+			rightText := text[rightRange.Pos():nodeRange.End()]
+
+			prefix := ""
+			suffix := ""
+			if nodeRange.Pos() > 0 && isIdentifierPartChar(text[nodeRange.Pos()-1]) && isIdentifierPartChar(rightText[0]) {
+				prefix = " "
+			}
+			if nodeRange.End() < len(text) && isIdentifierPartChar(text[nodeRange.End()]) && isIdentifierPartChar(leftText[len(leftText)-1]) {
+				suffix = " "
+			}
+
+			return prefix + rightText + textBeforeOperator + operatorFlipMap[binary.OperatorToken.Kind] + textAfterOperator + leftText + suffix
+		}
+
+		return rule.RuleListeners{
+			ast.KindBinaryExpression: func(node *ast.Node) {
+				binary := node.AsBinaryExpression()
+				operator := binary.OperatorToken.Kind
+				if !isComparisonOperator(operator) {
+					return
+				}
+				if opts.OnlyEquality && !isEqualityOperator(operator) {
+					return
+				}
+
+				expectedLiteral, expectedNonLiteral := binary.Right, binary.Left
+				expectedSide := "right"
+				if always {
+					expectedLiteral, expectedNonLiteral = binary.Left, binary.Right
+					expectedSide = "left"
+				}
+
+				// Report if the literal is on the unexpected side only
+				if !isLiteral(expectedNonLiteral) || isLiteral(expectedLiteral) {
+					return
+				}
+
+				if opts.ExceptRange {
+					parent := node.Parent
+					for parent != nil && parent.Kind == ast.KindParenthesizedExpression {
+						parent = parent.Parent
+					}
+					if isRangeTest(parent) {
+						return
+					}
+				}
+
+				operatorRange := utils.TrimNodeTextRange(ctx.SourceFile, binary.OperatorToken)
+				operatorText := text[operatorRange.Pos():operatorRange.End()]
+				ctx.ReportNodeWithFixes(node, buildExpectedMessage(expectedSide, operatorText),
+					rule.RuleFixReplace(ctx.SourceFile, node, getFlippedString(node)))
+			},
+		}
+	},
+})
@@ -0,0 +1,159 @@
+package yoda
+
+import (
+	"testing"
+
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"github.com/web-infra-dev/rslint/internal/rule_tester"
+)
+
+func TestYodaRule(t *testing.T) {
+	rule_tester.RunRuleTester(
+		fixtures.GetRootDir(),
+		"tsconfig.json",
+		t,
+		&YodaRule,
+		// Valid cases - ported from ESLint
+		[]rule_tester.ValidTestCase{
+			// "never" mode
+			{Code: `if (value === "red") {}`, Options: "never"},
+			{Code: `if (value === value) {}`, Options: "never"},
+			{Code: `if (value != 5) {}`, Options: "never"},
+			{Code: `if (5 & value) {}`, Options: "never"},
+			{Code: `if (5 === 5) {}`, Options: "never"},
+			{Code: "if (`red` === `red`) {}", Options: "never"},
+			{Code: "if (`${foo}` === `red`) {}", Options: "never"},
+			{Code: `if (value === -1) {}`},
+
+			// "always" mode
+			{Code: `if ("blue" === value) {}`, Options: "always"},
+			{Code: `if (value === value) {}`, Options: "always"},
+			{Code: `if (4 != value) {}`, Options: "always"},
+			{Code: `if (null !== value) {}`, Options: "always"},
+			{Code: `if ("red" <= value) {}`, Options: "always"},
+			{Code: "if (`red` <= value) {}", Options: "always"},
+			{Code: `if (-1 < value) {}`, Options: "always"},
+
+			// exceptRange
+			{Code: `if (0 < x && x <= 1) {}`, Options: []interface{}{"never", map[string]interface{}{"exceptRange": true}}},
+			{Code: `if (x < 0 || 1 <= x) {}`, Options: []interface{}{"never", map[string]interface{}{"exceptRange": true}}},
+			{Code: `if (0 <= x && x < 10) {}`, Options: []interface{}{"never", map[string]interface{}{"exceptRange": true}}},
+			{Code: `if (a.b < 0 || 1 <= a.b) {}`, Options: []interface{}{"never", map[string]interface{}{"exceptRange": true}}},
+			{Code: `if (a[b] < 0 || 1 <= a[b]) {}`, Options: []interface{}{"never", map[string]interface{}{"exceptRange": true}}},
+			{Code: `if ('a' <= x && x < 'b') {}`, Options: []interface{}{"never", map[string]interface{}{"exceptRange": true}}},
+			{Code: `if (-1 < x && x < 0) {}`, Options: []interface{}{"never", map[string]interface{}{"exceptRange": true}}},
+			{Code: `var a = (0 < x && x < 1);`, Options: []interface{}{"never", map[string]interface{}{"exceptRange": true}}},
+
+			// onlyEquality
+			{Code: `if (x <= 'foo' || 'bar' < x) {}`, Options: []interface{}{"always", map[string]interface{}{"onlyEquality": true}}},
+			{Code: `if (0 < x) {}`, Options: []interface{}{"never", map[string]interface{}{"onlyEquality": true}}},
+		},
+		// Invalid cases - ported from ESLint
+		[]rule_tester.InvalidTestCase{
+			{
+				Code:   `if ("red" == value) {}`,
+				Output: []string{`if (value == "red") {}`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+			{
+				Code:    `if (true === value) {}`,
+				Options: "never",
+				Output:  []string{`if (value === true) {}`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+			{
+				Code:   `if (5 != value) {}`,
+				Output: []string{`if (value != 5) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+			{
+				Code:   `if (null !== value) {}`,
+				Output: []string{`if (value !== null) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+			{
+				Code:   `if ("red" <= value) {}`,
+				Output: []string{`if (value >= "red") {}`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+			{
+				Code:   "if (`red` <= value) {}",
+				Output: []string{"if (value >= `red`) {}"},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+			{
+				Code:   `if (-1 < value) {}`,
+				Output: []string{`if (value > -1) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+			{
+				Code:   `if (5 >= value) {}`,
+				Output: []string{`if (value <= 5) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+			{
+				Code:    `if (value == "red") {}`,
+				Options: "always",
+				Output:  []string{`if ("red" == value) {}`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+			{
+				Code:    `if (value < -1) {}`,
+				Options: "always",
+				Output:  []string{`if (-1 > value) {}`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+			{
+				Code:    `if (value >= "red") {}`,
+				Options: []interface{}{"always"},
+				Output:  []string{`if ("red" <= value) {}`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+
+			// Whitespace around the operator is kept
+			{
+				Code:   `if (5  <=  value) {}`,
+				Output: []string{`if (value  >=  5) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+			{
+				Code:   `if ((5) === (value)) {}`,
+				Output: []string{`if ((value) === (5)) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+
+			// Range exception only applies with the option and when wrapped in parentheses
+			{
+				Code:   `if (0 <= x && x < 1) {}`,
+				Output: []string{`if (x >= 0 && x < 1) {}`},
+				Errors: []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+			{
+				Code:    `var a = 0 < x && x < 1;`,
+				Options: []interface{}{"never", map[string]interface{}{"exceptRange": true}},
+				Output:  []string{`var a = x > 0 && x < 1;`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 9}},
+			},
+			{
+				Code:    `if (1 <= x && x < 0) {}`,
+				Options: []interface{}{"never", map[string]interface{}{"exceptRange": true}},
+				Output:  []string{`if (x >= 1 && x < 0) {}`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+			{
+				Code:    `if (0 <= x && y < 1) {}`,
+				Options: []interface{}{"never", map[string]interface{}{"exceptRange": true}},
+				Output:  []string{`if (x >= 0 && y < 1) {}`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 5}},
+			},
+
+			// onlyEquality
+			{
+				Code:    `if (x <= 'foo' || x == 'bar') {}`,
+				Options: []interface{}{"always", map[string]interface{}{"onlyEquality": true}},
+				Output:  []string{`if (x <= 'foo' || 'bar' == x) {}`},
+				Errors:  []rule_tester.InvalidTestCaseError{{MessageId: "expected", Line: 1, Column: 19}},
+			},
+		},
+	)
+}
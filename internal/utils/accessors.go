@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/scanner"
+)
+
+// GetStaticPropertyName returns the name of a member whose key can be evaluated statically, including `['name']` and `[1e1]`
+func GetStaticPropertyName(member *ast.Node) (string, bool) {
+	name := member.Name()
+	if name == nil {
+		return "", false
+	}
+	if name.Kind == ast.KindComputedPropertyName {
+		// Only literal keys are static, `[a]` refers to the value of `a`
+		name = ast.SkipParentheses(name.Expression())
+		switch name.Kind {
+		case ast.KindStringLiteral, ast.KindNoSubstitutionTemplateLiteral, ast.KindNumericLiteral:
+			return name.Text(), true
+		}
+		return "", false
+	}
+	switch name.Kind {
+	case ast.KindIdentifier, ast.KindStringLiteral, ast.KindNoSubstitutionTemplateLiteral, ast.KindNumericLiteral:
+		// Numeric literal texts are already normalized by the scanner, so `1e1` and `10` share a name
+		return name.Text(), true
+	}
+	return "", false
+}
+
+// GetAccessorKey returns a key under which the getter and setter of the same property match
+func GetAccessorKey(sourceFile *ast.SourceFile, member *ast.Node) string {
+	if name, ok := GetStaticPropertyName(member); ok {
+		return "name:" + name
+	}
+	name := member.Name()
+	if name.Kind == ast.KindComputedPropertyName {
+		name = name.Expression()
+	}
+	// Dynamic keys match when they consist of the same tokens
+	nameRange := TrimNodeTextRange(sourceFile, name)
+	s := scanner.GetScannerForSourceFile(sourceFile, nameRange.Pos())
+	var texts []string
+	for s.Token() != ast.KindEndOfFile && s.TokenStart() < nameRange.End() {
+		texts = append(texts, s.TokenText())
+		s.Scan()
+	}
+	return "tokens:" + strings.Join(texts, " ")
+}
+
+// GetAccessorName describes an accessor, e.g. `static getter 'a'` or `private setter #a`
+func GetAccessorName(member *ast.Node) string {
+	var parts []string
+	if ast.HasStaticModifier(member) {
+		parts = append(parts, "static")
+	}
+	name := member.Name()
+	if name.Kind == ast.KindPrivateIdentifier {
+		parts = append(parts, "private")
+	}
+	if member.Kind == ast.KindGetAccessor {
+		parts = append(parts, "getter")
+	} else {
+		parts = append(parts, "setter")
+	}
+	if name.Kind == ast.KindPrivateIdentifier {
+		parts = append(parts, name.Text())
+	} else if staticName, ok := GetStaticPropertyName(member); ok {
+		parts = append(parts, "'"+staticName+"'")
+	}
+	return strings.Join(parts, " ")
+}
+
+// GetAccessorHeadRange returns the range from the start of the accessor to its parameter list
+func GetAccessorHeadRange(sourceFile *ast.SourceFile, member *ast.Node) core.TextRange {
+	memberRange := TrimNodeTextRange(sourceFile, member)
+	openParen := scanner.GetRangeOfTokenAtPosition(sourceFile, member.Name().End())
+	if openParen.Pos() < len(sourceFile.Text()) && sourceFile.Text()[openParen.Pos()] == '(' {
+		return memberRange.WithEnd(openParen.Pos())
+	}
+	return memberRange
+}
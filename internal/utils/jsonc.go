@@ -1,25 +1,137 @@
 package utils
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/tailscale/hujson"
 )
 
+// JSONCError describes where a JSONC document failed to parse or decode
+type JSONCError struct {
+	// Offset is the 0-based byte offset of the offending token, -1 if unknown
+	Offset int
+	// Line and Column are 1-based, 0 if unknown
+	Line    int
+	Column  int
+	Message string
+	// Err is the underlying hujson or encoding/json error
+	Err error
+}
+
+func (e *JSONCError) Error() string {
+	if e.Line == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+func (e *JSONCError) Unwrap() error {
+	return e.Err
+}
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// hujson reports locations as "hujson: line N, column M: message"
+var hujsonErrorPattern = regexp.MustCompile(`^hujson: line (\d+), column (\d+): (.*)$`)
+
 // ParseJSONC parses JSONC (JSON with Comments) using hujson library
+// Errors are returned as *JSONCError carrying the location of the offending token when known
 func ParseJSONC(data []byte, v interface{}) error {
+	// Editors on Windows may save config files with a UTF-8 BOM, which hujson rejects.
+	// It is replaced with spaces to keep byte offsets in errors pointing into the original data
+	if bytes.HasPrefix(data, utf8BOM) {
+		data = append([]byte("   "), data[len(utf8BOM):]...)
+	}
+
 	// Parse with hujson first to handle comments and trailing commas
 	ast, err := hujson.Parse(data)
 	if err != nil {
-		return err
+		return newJSONCErrorFromHujson(data, err)
 	}
 
-	// Standardize to valid JSON
+	// Standardize to valid JSON, comments and trailing commas are replaced
+	// with spaces so byte offsets still point into the original data
 	ast.Standardize()
 
 	// Convert back to bytes and parse with standard JSON
 	standardJSON := ast.Pack()
-	return json.Unmarshal(standardJSON, v)
+	if err := json.Unmarshal(standardJSON, v); err != nil {
+		return newJSONCErrorFromJSON(data, err)
+	}
+	return nil
+}
+
+func newJSONCErrorFromHujson(data []byte, err error) *JSONCError {
+	matches := hujsonErrorPattern.FindStringSubmatch(err.Error())
+	if matches == nil {
+		return &JSONCError{Offset: -1, Message: err.Error(), Err: err}
+	}
+	line, _ := strconv.Atoi(matches[1])
+	column, _ := strconv.Atoi(matches[2])
+	return &JSONCError{
+		Offset:  lineColumnToOffset(data, line, column),
+		Line:    line,
+		Column:  column,
+		Message: matches[3],
+		Err:     err,
+	}
+}
+
+func newJSONCErrorFromJSON(data []byte, err error) *JSONCError {
+	message := strings.TrimPrefix(err.Error(), "json: ")
+
+	// encoding/json reports the number of bytes read before the error
+	var offset int64 = -1
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &syntaxErr) {
+		offset = syntaxErr.Offset - 1
+	} else if errors.As(err, &typeErr) {
+		offset = typeErr.Offset - 1
+	}
+	if offset < 0 || offset > int64(len(data)) {
+		return &JSONCError{Offset: -1, Message: message, Err: err}
+	}
+
+	line, column := offsetToLineColumn(data, int(offset))
+	return &JSONCError{
+		Offset:  int(offset),
+		Line:    line,
+		Column:  column,
+		Message: message,
+		Err:     err,
+	}
+}
+
+// offsetToLineColumn converts a byte offset into a 1-based line and byte column
+func offsetToLineColumn(data []byte, offset int) (int, int) {
+	prefix := data[:offset]
+	line := 1 + bytes.Count(prefix, []byte("\n"))
+	column := 1 + offset - (bytes.LastIndexByte(prefix, '\n') + 1)
+	return line, column
+}
+
+// lineColumnToOffset converts a 1-based line and byte column into a byte offset
+func lineColumnToOffset(data []byte, line int, column int) int {
+	offset := 0
+	for i := 1; i < line; i++ {
+		next := bytes.IndexByte(data[offset:], '\n')
+		if next < 0 {
+			return -1
+		}
+		offset += next + 1
+	}
+	offset += column - 1
+	if offset < 0 || offset > len(data) {
+		return -1
+	}
+	return offset
 }
 
 // StripJSONComments removes comments from JSONC and returns clean JSON string
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 )
 
@@ -90,6 +91,41 @@ func TestParseJSONC(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "comment markers inside strings",
+			input: `{
+				"url": "https://example.com/*", // real comment
+				"glob": "src/**/*.ts" /* real comment */
+			}`,
+			expected: map[string]interface{}{
+				"url":  "https://example.com/*",
+				"glob": "src/**/*.ts",
+			},
+			wantErr: false,
+		},
+		{
+			name: "escaped quote before comment",
+			input: `{
+				"key": "say \"hi\" // not a comment" // comment
+			}`,
+			expected: map[string]interface{}{
+				"key": `say "hi" // not a comment`,
+			},
+			wantErr: false,
+		},
+		{
+			name:  "leading comment and UTF-8 BOM",
+			input: "\ufeff// rslint config\n{\n\t\"key\": \"value\",\n}",
+			expected: map[string]interface{}{
+				"key": "value",
+			},
+			wantErr: false,
+		},
+		{
+			name:    "unterminated block comment",
+			input:   `{"key": "value" /* comment }`,
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -159,3 +195,56 @@ func TestStripJSONComments(t *testing.T) {
 		})
 	}
 }
+
+func TestParseJSONCErrorLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantLine int
+	}{
+		{
+			name:     "double comma",
+			input:    "{\n  \"key\": \"value\",,\n  \"number\": 42\n}",
+			wantLine: 2,
+		},
+		{
+			name:     "missing colon after comment",
+			input:    "{\n  // comment\n  \"key\" \"value\"\n}",
+			wantLine: 3,
+		},
+		{
+			name:     "unterminated comment",
+			input:    "{\n  \"key\": \"value\"\n  /* comment\n}",
+			wantLine: 3,
+		},
+		{
+			name:     "type mismatch after trailing comma",
+			input:    "{\n  \"key\": \"value\",\n  \"number\": \"42\",\n}",
+			wantLine: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result struct {
+				Key    string `json:"key"`
+				Number int    `json:"number"`
+			}
+			err := ParseJSONC([]byte(tt.input), &result)
+
+			var jsoncErr *JSONCError
+			if !errors.As(err, &jsoncErr) {
+				t.Fatalf("ParseJSONC() error = %v, want *JSONCError", err)
+			}
+			if jsoncErr.Line < tt.wantLine {
+				t.Errorf("ParseJSONC() error line = %d, want at least %d (%v)", jsoncErr.Line, tt.wantLine, err)
+			}
+			if jsoncErr.Offset >= 0 {
+				line, column := offsetToLineColumn([]byte(tt.input), jsoncErr.Offset)
+				if line != jsoncErr.Line || column != jsoncErr.Column {
+					t.Errorf("ParseJSONC() error offset %d maps to %d:%d, want %d:%d", jsoncErr.Offset, line, column, jsoncErr.Line, jsoncErr.Column)
+				}
+			}
+		})
+	}
+}
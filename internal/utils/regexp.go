@@ -0,0 +1,486 @@
+package utils
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/microsoft/typescript-go/shim/ast"
+)
+
+// RegExpNodeKind is the kind of a RegExpNode
+type RegExpNodeKind int
+
+const (
+	RegExpNodePattern RegExpNodeKind = iota
+	RegExpNodeAlternative
+	// (?:...) and modifier groups like (?i:...)
+	RegExpNodeGroup
+	// (...) and (?<name>...)
+	RegExpNodeCapturingGroup
+	// (?=...), (?!...), (?<=...) and (?<!...)
+	RegExpNodeLookaround
+	RegExpNodeQuantifier
+	RegExpNodeCharacterClass
+	RegExpNodeBackreference
+	// Any other atom, e.g. characters, escapes, `.`, `^`, `$` and `\b`
+	RegExpNodeCharacter
+)
+
+// RegExpNode is a node of a regular expression pattern parsed by ParseRegExp.
+// Start and End are byte offsets into the pattern.
+type RegExpNode struct {
+	Kind   RegExpNodeKind
+	Start  int
+	End    int
+	Raw    string
+	Parent *RegExpNode
+	// Children holds the alternatives of a pattern, group or lookaround,
+	// the elements of an alternative, or the element of a quantifier
+	Children []*RegExpNode
+
+	// Name of a named capturing group or named backreference
+	Name string
+	// Index is the 1-based number of a capturing group or numeric backreference
+	Index      int
+	Lookbehind bool
+	Negate     bool
+	// Resolved lists the capturing groups a backreference refers to
+	Resolved []*RegExpNode
+}
+
+// ExtractRegexLiteral splits a regular expression literal into its pattern and flags
+func ExtractRegexLiteral(node *ast.Node) (pattern string, flags string) {
+	text := node.Text()
+	end := strings.LastIndexByte(text, '/')
+	if end <= 0 {
+		return "", ""
+	}
+	return text[1:end], text[end+1:]
+}
+
+// ForEachRegExpNode calls callback for node and all of its descendants in pattern order
+func ForEachRegExpNode(node *RegExpNode, callback func(node *RegExpNode)) {
+	callback(node)
+	for _, child := range node.Children {
+		ForEachRegExpNode(child, callback)
+	}
+}
+
+type regExpParser struct {
+	pattern        string
+	pos            int
+	unicode        bool
+	unicodeSets    bool
+	groupCount     int
+	hasNamedGroups bool
+	groups         []*RegExpNode
+	backreferences []*RegExpNode
+}
+
+// ParseRegExp parses pattern with the syntax selected by flags and returns the
+// root node, or an error if the pattern is not a valid regular expression.
+// Only the structure is validated; e.g. invalid unicode property names are accepted.
+func ParseRegExp(pattern string, flags string) (*RegExpNode, error) {
+	p := &regExpParser{
+		pattern:     pattern,
+		unicode:     strings.ContainsAny(flags, "uv"),
+		unicodeSets: strings.Contains(flags, "v"),
+	}
+	p.countGroups()
+
+	root := &RegExpNode{Kind: RegExpNodePattern}
+	if err := p.parseDisjunction(root); err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.pattern) {
+		return nil, errors.New("unmatched ')'")
+	}
+	p.finish(root)
+
+	for _, backreference := range p.backreferences {
+		for _, group := range p.groups {
+			if (backreference.Name != "" && group.Name == backreference.Name) ||
+				(backreference.Name == "" && group.Index == backreference.Index) {
+				backreference.Resolved = append(backreference.Resolved, group)
+			}
+		}
+		if len(backreference.Resolved) == 0 {
+			return nil, errors.New("invalid named capture referenced")
+		}
+	}
+	return root, nil
+}
+
+// countGroups finds the number of capturing groups up front, since that decides
+// whether escapes like `\2` and `\k<a>` are backreferences
+func (p *regExpParser) countGroups() {
+	inClass := 0
+	for i := 0; i < len(p.pattern); i++ {
+		switch p.pattern[i] {
+		case '\\':
+			i++
+		case '[':
+			if inClass == 0 || p.unicodeSets {
+				inClass++
+			}
+		case ']':
+			if inClass > 0 {
+				inClass--
+			}
+		case '(':
+			if inClass > 0 {
+				continue
+			}
+			rest := p.pattern[i+1:]
+			if !strings.HasPrefix(rest, "?") {
+				p.groupCount++
+			} else if strings.HasPrefix(rest, "?<") && !strings.HasPrefix(rest, "?<=") && !strings.HasPrefix(rest, "?<!") {
+				p.groupCount++
+				p.hasNamedGroups = true
+			}
+		}
+	}
+}
+
+// finish fills in the raw text and parents of node and its descendants
+func (p *regExpParser) finish(node *RegExpNode) {
+	if node.Kind == RegExpNodePattern {
+		node.End = len(p.pattern)
+	}
+	node.Raw = p.pattern[node.Start:node.End]
+	for _, child := range node.Children {
+		child.Parent = node
+		p.finish(child)
+	}
+}
+
+func (p *regExpParser) peek(prefix string) bool {
+	return strings.HasPrefix(p.pattern[p.pos:], prefix)
+}
+
+func (p *regExpParser) parseDisjunction(parent *RegExpNode) error {
+	for {
+		alternative := &RegExpNode{Kind: RegExpNodeAlternative, Start: p.pos}
+		if err := p.parseAlternative(alternative); err != nil {
+			return err
+		}
+		alternative.End = p.pos
+		parent.Children = append(parent.Children, alternative)
+
+		if !p.peek("|") {
+			return nil
+		}
+		p.pos++
+	}
+}
+
+func (p *regExpParser) parseAlternative(alternative *RegExpNode) error {
+	for p.pos < len(p.pattern) {
+		switch p.pattern[p.pos] {
+		case '|', ')':
+			return nil
+		case '*', '+', '?':
+			p.pos++
+			if err := p.applyQuantifier(alternative); err != nil {
+				return err
+			}
+			continue
+		case '{':
+			if p.parseBraceQuantifier() {
+				if err := p.applyQuantifier(alternative); err != nil {
+					return err
+				}
+				continue
+			}
+			if p.unicode {
+				return errors.New("lone quantifier brackets")
+			}
+		}
+
+		element, err := p.parseAtom()
+		if err != nil {
+			return err
+		}
+		alternative.Children = append(alternative.Children, element)
+	}
+	return nil
+}
+
+// parseBraceQuantifier consumes a `{n}`, `{n,}` or `{n,m}` quantifier if there is one
+func (p *regExpParser) parseBraceQuantifier() bool {
+	end := strings.IndexByte(p.pattern[p.pos:], '}')
+	if end < 0 {
+		return false
+	}
+	body := p.pattern[p.pos+1 : p.pos+end]
+	minText, maxText, hasComma := strings.Cut(body, ",")
+	if !isDecimalDigits(minText) || (hasComma && maxText != "" && !isDecimalDigits(maxText)) {
+		return false
+	}
+	p.pos += end + 1
+	return true
+}
+
+func isDecimalDigits(text string) bool {
+	if text == "" {
+		return false
+	}
+	for i := 0; i < len(text); i++ {
+		if text[i] < '0' || text[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// applyQuantifier wraps the last element of alternative in a quantifier ending at the current position
+func (p *regExpParser) applyQuantifier(alternative *RegExpNode) error {
+	if len(alternative.Children) == 0 {
+		return errors.New("nothing to repeat")
+	}
+	last := alternative.Children[len(alternative.Children)-1]
+	if last.Kind == RegExpNodeQuantifier || (last.Kind == RegExpNodeLookaround && (last.Lookbehind || p.unicode)) {
+		return errors.New("nothing to repeat")
+	}
+
+	// Lazy quantifiers
+	if p.peek("?") {
+		p.pos++
+	}
+	alternative.Children[len(alternative.Children)-1] = &RegExpNode{
+		Kind:     RegExpNodeQuantifier,
+		Start:    last.Start,
+		End:      p.pos,
+		Children: []*RegExpNode{last},
+	}
+	return nil
+}
+
+func (p *regExpParser) parseAtom() (*RegExpNode, error) {
+	start := p.pos
+	switch p.pattern[p.pos] {
+	case '(':
+		return p.parseGroup()
+	case '[':
+		return p.parseCharacterClass()
+	case '\\':
+		return p.parseEscape()
+	case ']', '}':
+		if p.unicode {
+			return nil, errors.New("lone brackets")
+		}
+	}
+
+	_, size := utf8.DecodeRuneInString(p.pattern[p.pos:])
+	p.pos += size
+	return &RegExpNode{Kind: RegExpNodeCharacter, Start: start, End: p.pos}, nil
+}
+
+func (p *regExpParser) parseGroup() (*RegExpNode, error) {
+	node := &RegExpNode{Kind: RegExpNodeCapturingGroup, Start: p.pos}
+	p.pos++
+
+	switch {
+	case p.peek("?:"):
+		node.Kind = RegExpNodeGroup
+		p.pos += 2
+	case p.peek("?="), p.peek("?!"):
+		node.Kind = RegExpNodeLookaround
+		node.Negate = p.peek("?!")
+		p.pos += 2
+	case p.peek("?<="), p.peek("?<!"):
+		node.Kind = RegExpNodeLookaround
+		node.Lookbehind = true
+		node.Negate = p.peek("?<!")
+		p.pos += 3
+	case p.peek("?<"):
+		p.pos += 2
+		name, err := p.parseGroupName()
+		if err != nil {
+			return nil, err
+		}
+		node.Name = name
+	case p.peek("?"):
+		// Modifiers like (?i:...) or (?-s:...)
+		end := p.pos + 1
+		for end < len(p.pattern) && strings.IndexByte("ims-", p.pattern[end]) >= 0 {
+			end++
+		}
+		if end == p.pos+1 || end >= len(p.pattern) || p.pattern[end] != ':' {
+			return nil, errors.New("invalid group")
+		}
+		node.Kind = RegExpNodeGroup
+		p.pos = end + 1
+	}
+
+	if node.Kind == RegExpNodeCapturingGroup {
+		p.groups = append(p.groups, node)
+		node.Index = len(p.groups)
+	}
+
+	if err := p.parseDisjunction(node); err != nil {
+		return nil, err
+	}
+	if !p.peek(")") {
+		return nil, errors.New("unterminated group")
+	}
+	p.pos++
+	node.End = p.pos
+	return node, nil
+}
+
+// parseGroupName consumes `name>` of a named group or backreference
+func (p *regExpParser) parseGroupName() (string, error) {
+	end := strings.IndexByte(p.pattern[p.pos:], '>')
+	if end <= 0 {
+		return "", errors.New("invalid capture group name")
+	}
+	name := p.pattern[p.pos : p.pos+end]
+	p.pos += end + 1
+	return name, nil
+}
+
+func (p *regExpParser) parseCharacterClass() (*RegExpNode, error) {
+	node := &RegExpNode{Kind: RegExpNodeCharacterClass, Start: p.pos}
+	p.pos++
+
+	// Classes only nest in unicodeSets mode
+	depth := 1
+	for p.pos < len(p.pattern) {
+		switch p.pattern[p.pos] {
+		case '\\':
+			p.pos++
+			if p.unicode && (p.peek("u{") || p.peek("p{") || p.peek("P{") || (p.unicodeSets && p.peek("q{"))) {
+				// Braced escapes, including class string disjunctions like \q{abc|d}
+				end := strings.IndexByte(p.pattern[p.pos:], '}')
+				if end < 0 {
+					return nil, errors.New("invalid escape")
+				}
+				p.pos += end
+			} else if p.unicode && p.pos < len(p.pattern) && !p.isValidUnicodeEscape(p.pattern[p.pos], true) {
+				return nil, errors.New("invalid escape")
+			}
+		case '(', ')', '{', '}', '/', '|':
+			if p.unicodeSets {
+				return nil, errors.New("invalid set operation in character class")
+			}
+		case '[':
+			if p.unicodeSets {
+				depth++
+			}
+		case ']':
+			depth--
+			if depth == 0 {
+				p.pos++
+				node.End = p.pos
+				return node, nil
+			}
+		}
+		p.pos++
+	}
+	return nil, errors.New("unterminated character class")
+}
+
+func (p *regExpParser) parseEscape() (*RegExpNode, error) {
+	node := &RegExpNode{Kind: RegExpNodeCharacter, Start: p.pos}
+	p.pos++
+	if p.pos >= len(p.pattern) {
+		return nil, errors.New("\\ at end of pattern")
+	}
+
+	c := p.pattern[p.pos]
+	switch {
+	case c >= '1' && c <= '9':
+		end := p.pos
+		for end < len(p.pattern) && p.pattern[end] >= '0' && p.pattern[end] <= '9' {
+			end++
+		}
+		index, err := strconv.Atoi(p.pattern[p.pos:end])
+		if err == nil && index <= p.groupCount {
+			node.Kind = RegExpNodeBackreference
+			node.Index = index
+			p.backreferences = append(p.backreferences, node)
+			p.pos = end
+		} else if p.unicode {
+			return nil, errors.New("invalid escape")
+		} else {
+			// A legacy octal or identity escape
+			p.pos++
+		}
+	case c == 'k' && (p.unicode || p.hasNamedGroups):
+		p.pos++
+		if !p.peek("<") {
+			return nil, errors.New("invalid named reference")
+		}
+		p.pos++
+		name, err := p.parseGroupName()
+		if err != nil {
+			return nil, err
+		}
+		node.Kind = RegExpNodeBackreference
+		node.Name = name
+		p.backreferences = append(p.backreferences, node)
+	case (c == 'u' || c == 'p' || c == 'P') && p.unicode && strings.HasPrefix(p.pattern[p.pos+1:], "{"):
+		end := strings.IndexByte(p.pattern[p.pos:], '}')
+		if end < 0 {
+			return nil, errors.New("invalid escape")
+		}
+		p.pos += end + 1
+	case c == 'u' || c == 'x':
+		digits := 4
+		if c == 'x' {
+			digits = 2
+		}
+		p.pos++
+		for i := 0; i < digits; i++ {
+			if p.pos >= len(p.pattern) || !isHexDigit(p.pattern[p.pos]) {
+				if p.unicode {
+					return nil, errors.New("invalid escape")
+				}
+				break
+			}
+			p.pos++
+		}
+	case c == 'c':
+		p.pos++
+		if p.pos < len(p.pattern) && isASCIILetter(p.pattern[p.pos]) {
+			p.pos++
+		} else if p.unicode {
+			return nil, errors.New("invalid unicode escape")
+		}
+	default:
+		if p.unicode && !p.isValidUnicodeEscape(c, false) {
+			return nil, errors.New("invalid escape")
+		}
+		_, size := utf8.DecodeRuneInString(p.pattern[p.pos:])
+		p.pos += size
+	}
+
+	node.End = p.pos
+	return node, nil
+}
+
+// isValidUnicodeEscape checks whether `\c` is allowed in unicode mode, where
+// only syntax characters and known escapes may be escaped
+func (p *regExpParser) isValidUnicodeEscape(c byte, inClass bool) bool {
+	if strings.IndexByte(`^$\\.*+?()[]{}|/`, c) >= 0 || strings.IndexByte("fnrtvdDsSwWbB0", c) >= 0 {
+		return true
+	}
+	if inClass {
+		if p.unicodeSets && strings.IndexByte("&-!#%,:;<=>@`~", c) >= 0 {
+			return true
+		}
+		return c == '-' || strings.IndexByte("uxcpP", c) >= 0
+	}
+	return false
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
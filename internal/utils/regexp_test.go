@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestParseRegExp(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		flags   string
+		wantErr bool
+	}{
+		{name: "characters", pattern: `abc`},
+		{name: "alternatives", pattern: `a|b|`},
+		{name: "groups", pattern: `(a)(?:b)(?<name>c)(?=d)(?!e)(?<=f)(?<!g)`},
+		{name: "modifiers", pattern: `(?i:a)(?-s:b)`},
+		{name: "quantifiers", pattern: `a*b+?c?d{2}e{2,}f{2,3}?`},
+		{name: "literal brace", pattern: `a{`},
+		{name: "character classes", pattern: `[a-z\]()|][^\d]`},
+		{name: "nested classes", pattern: `[[a-z]--[aeiou]]`, flags: "v"},
+		{name: "escapes", pattern: `\d\w\s\b\x41A\u{1F600}\p{L}\cA\0`, flags: "u"},
+		{name: "legacy octal escape", pattern: `\1`},
+		{name: "identity k escape", pattern: `\k`},
+		{name: "unterminated group", pattern: `(a`, wantErr: true},
+		{name: "unmatched paren", pattern: `a)`, wantErr: true},
+		{name: "unterminated class", pattern: `[a`, wantErr: true},
+		{name: "nothing to repeat", pattern: `*a`, wantErr: true},
+		{name: "double quantifier", pattern: `a**`, wantErr: true},
+		{name: "quantified lookbehind", pattern: `(?<=a)*`, wantErr: true},
+		{name: "trailing backslash", pattern: `a\`, wantErr: true},
+		{name: "lone brace in unicode mode", pattern: `a{`, flags: "u", wantErr: true},
+		{name: "invalid escape in unicode mode", pattern: `\1`, flags: "u", wantErr: true},
+		{name: "undefined group name", pattern: `(?<a>x)\k<b>`, wantErr: true},
+		{name: "identity escape", pattern: `\a\-`},
+		{name: "identity escape in unicode mode", pattern: `\a`, flags: "u", wantErr: true},
+		{name: "escaped dash outside class in unicode mode", pattern: `\-`, flags: "u", wantErr: true},
+		{name: "escaped dash in class in unicode mode", pattern: `[\-\]]`, flags: "u"},
+		{name: "short unicode escape in unicode mode", pattern: `\u12`, flags: "u", wantErr: true},
+		{name: "braced escapes in set class", pattern: `[\p{L}\q{abc|d}\u{61}]`, flags: "v"},
+		{name: "unescaped paren in set class", pattern: `[(]`, flags: "v", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := ParseRegExp(tt.pattern, tt.flags)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRegExp(%q, %q) error = %v, wantErr %v", tt.pattern, tt.flags, err, tt.wantErr)
+			}
+			if err == nil && root.Raw != tt.pattern {
+				t.Errorf("expected root raw %q, got %q", tt.pattern, root.Raw)
+			}
+		})
+	}
+}
+
+func TestParseRegExpStructure(t *testing.T) {
+	root, err := ParseRegExp(`(a)|(?<b>c+)\1\k<b>`, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var groups, backreferences []*RegExpNode
+	ForEachRegExpNode(root, func(node *RegExpNode) {
+		switch node.Kind {
+		case RegExpNodeCapturingGroup:
+			groups = append(groups, node)
+		case RegExpNodeBackreference:
+			backreferences = append(backreferences, node)
+		}
+	})
+
+	if len(root.Children) != 2 {
+		t.Fatalf("expected 2 alternatives, got %d", len(root.Children))
+	}
+	if len(groups) != 2 || groups[0].Raw != "(a)" || groups[1].Raw != "(?<b>c+)" || groups[1].Name != "b" || groups[1].Index != 2 {
+		t.Fatalf("unexpected groups: %+v", groups)
+	}
+	if groups[0].Parent != root.Children[0] {
+		t.Errorf("expected the first group to be in the first alternative")
+	}
+	if len(backreferences) != 2 {
+		t.Fatalf("expected 2 backreferences, got %d", len(backreferences))
+	}
+	if backreferences[0].Raw != `\1` || len(backreferences[0].Resolved) != 1 || backreferences[0].Resolved[0] != groups[0] {
+		t.Errorf("expected \\1 to resolve to the first group")
+	}
+	if backreferences[1].Raw != `\k<b>` || len(backreferences[1].Resolved) != 1 || backreferences[1].Resolved[0] != groups[1] {
+		t.Errorf("expected \\k<b> to resolve to the named group")
+	}
+
+	quantifier := groups[1].Children[0].Children[0]
+	if quantifier.Kind != RegExpNodeQuantifier || quantifier.Raw != "c+" || quantifier.Children[0].Raw != "c" {
+		t.Errorf("unexpected quantifier: %+v", quantifier)
+	}
+}
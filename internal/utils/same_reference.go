@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"github.com/microsoft/typescript-go/shim/ast"
+)
+
+// GetStaticMemberKey returns the key of a non-computed or literal-computed member access, e.g. `a.b` or `a['b']`
+func GetStaticMemberKey(node *ast.Node) (string, bool) {
+	switch node.Kind {
+	case ast.KindPropertyAccessExpression:
+		return node.AsPropertyAccessExpression().Name().Text(), true
+	case ast.KindElementAccessExpression:
+		argument := ast.SkipParentheses(node.AsElementAccessExpression().ArgumentExpression)
+		switch argument.Kind {
+		case ast.KindStringLiteral, ast.KindNumericLiteral, ast.KindNoSubstitutionTemplateLiteral:
+			return argument.Text(), true
+		}
+	}
+	return "", false
+}
+
+// IsSameReference checks whether two nodes reference the same variable or property, like ESLint's
+// astUtils.isSameReference. `a.b`, `a['b']` and `a?.b` are the same reference.
+func IsSameReference(left *ast.Node, right *ast.Node) bool {
+	left = ast.SkipParentheses(left)
+	right = ast.SkipParentheses(right)
+
+	switch left.Kind {
+	case ast.KindIdentifier, ast.KindPrivateIdentifier:
+		return right.Kind == left.Kind && left.Text() == right.Text()
+	case ast.KindThisKeyword, ast.KindSuperKeyword:
+		return right.Kind == left.Kind
+	case ast.KindStringLiteral, ast.KindNumericLiteral, ast.KindNoSubstitutionTemplateLiteral:
+		switch right.Kind {
+		case ast.KindStringLiteral, ast.KindNumericLiteral, ast.KindNoSubstitutionTemplateLiteral:
+			// Numeric literal texts are normalized by the scanner, so `1e1` and `10` are the same
+			return (left.Kind == ast.KindNumericLiteral) == (right.Kind == ast.KindNumericLiteral) && left.Text() == right.Text()
+		}
+		return false
+	case ast.KindPropertyAccessExpression, ast.KindElementAccessExpression:
+		if right.Kind != ast.KindPropertyAccessExpression && right.Kind != ast.KindElementAccessExpression {
+			return false
+		}
+		if !IsSameReference(left.Expression(), right.Expression()) {
+			return false
+		}
+		// `a.#b` can't be written as an element access, so `a['#b']` is a different property
+		if isPrivateMemberAccess(left) || isPrivateMemberAccess(right) {
+			return isPrivateMemberAccess(left) && isPrivateMemberAccess(right) && left.Name().Text() == right.Name().Text()
+		}
+		leftKey, leftStatic := GetStaticMemberKey(left)
+		rightKey, rightStatic := GetStaticMemberKey(right)
+		if leftStatic || rightStatic {
+			return leftStatic && rightStatic && leftKey == rightKey
+		}
+		return IsSameReference(left.AsElementAccessExpression().ArgumentExpression, right.AsElementAccessExpression().ArgumentExpression)
+	}
+	return false
+}
+
+func isPrivateMemberAccess(node *ast.Node) bool {
+	return node.Kind == ast.KindPropertyAccessExpression && node.AsPropertyAccessExpression().Name().Kind == ast.KindPrivateIdentifier
+}
@@ -39,11 +39,28 @@ func GetCommentsInRange(sourceFile *ast.SourceFile, inRange core.TextRange) iter
 	}
 }
 
+// HasCommentsInRange checks whether any comment lies entirely within inRange
 func HasCommentsInRange(sourceFile *ast.SourceFile, inRange core.TextRange) bool {
-	for range GetCommentsInRange(sourceFile, inRange) {
-		return true
+	// Only the tokens of the smallest node spanning the range can have comments in it
+	node := sourceFile.AsNode()
+	for found := true; found; {
+		found = false
+		node.ForEachChild(func(child *ast.Node) bool {
+			if child.Pos() <= inRange.Pos() && inRange.End() <= child.End() {
+				node = child
+				found = true
+			}
+			return found
+		})
 	}
-	return false
+
+	hasComments := false
+	ForEachComment(node, func(comment *ast.CommentRange) {
+		if comment.Pos() >= inRange.Pos() && comment.End() <= inRange.End() {
+			hasComments = true
+		}
+	}, sourceFile)
+	return hasComments
 }
 
 func TypeRecurser(t *checker.Type, predicate func(t *checker.Type) /* should stop */ bool) bool {
@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/microsoft/typescript-go/shim/core"
+	"github.com/microsoft/typescript-go/shim/tspath"
+	"github.com/web-infra-dev/rslint/internal/plugins/typescript/rules/fixtures"
+	"gotest.tools/v3/assert"
+)
+
+func TestHasCommentsInRange(t *testing.T) {
+	rootDir := fixtures.GetRootDir()
+
+	cases := []struct {
+		name     string
+		code     string
+		from     string // the range starts at the first occurrence of from
+		to       string // and ends after the last occurrence of to
+		expected bool
+	}{
+		{name: "no comments", code: "a = b + c;", from: "b", to: "c", expected: false},
+		{name: "comment inside", code: "a = b /* c */ + d;", from: "b", to: "d", expected: true},
+		{name: "comment at range start", code: "a = /* c */ b;", from: "/*", to: "b", expected: true},
+		{name: "comment at range end", code: "foo(a /* c */);", from: "a", to: "*/", expected: true},
+		{name: "comment crossing range start", code: "a = /* c */ b;", from: "c */", to: "b", expected: false},
+		{name: "comment crossing range end", code: "foo(a /* c */);", from: "a", to: "/* c", expected: false},
+		{name: "comment before range", code: "a = b + /* c */ d;", from: "d", to: "d", expected: false},
+		{name: "comment after range", code: "a = b /* c */ + d;", from: "a", to: "b", expected: false},
+		{name: "comment in trivia before first token", code: "/* c */ a + b;", from: "a", to: "b", expected: false},
+		{name: "range including leading trivia", code: "/* c */ a + b;", from: "/*", to: "b", expected: true},
+		{name: "line comment", code: "a = b // c\n  + d;", from: "b", to: "d", expected: true},
+		{name: "sibling arguments", code: "foo(a, /* c */ b, d);", from: "a", to: "d", expected: true},
+		{name: "sibling arguments after comment", code: "foo(a, /* c */ b, d);", from: "b", to: "d", expected: false},
+		{name: "sibling statements", code: "a;\n// c\nb;", from: "a", to: "b;", expected: true},
+		{name: "comment in nested node", code: "foo(a, bar(/* c */), d);", from: "a", to: "d", expected: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			filePath := tspath.ResolvePath(rootDir, "file.ts")
+			fs := NewOverlayVFSForFile(filePath, tc.code)
+			program, err := CreateProgram(true, fs, rootDir, "tsconfig.json", CreateCompilerHost(rootDir, fs))
+			assert.NilError(t, err, "couldn't create program")
+			sourceFile := program.GetSourceFile(filePath)
+
+			start := strings.Index(tc.code, tc.from)
+			end := strings.LastIndex(tc.code, tc.to) + len(tc.to)
+			actual := HasCommentsInRange(sourceFile, core.NewTextRange(start, end))
+			if actual != tc.expected {
+				t.Errorf("HasCommentsInRange(%q, [%d, %d)) = %v, want %v", tc.code, start, end, actual, tc.expected)
+			}
+		})
+	}
+}